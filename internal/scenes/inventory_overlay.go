@@ -0,0 +1,263 @@
+package scenes
+
+import (
+	"fmt"
+	"log"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/config"
+	"arpg/pkg/entities"
+	"arpg/pkg/globals"
+	"arpg/pkg/items"
+)
+
+// Inventory overlay layout in pixels
+const (
+	inventoryCellSize  = 40
+	inventoryPadding   = 10
+	equipSlotWidth     = 120
+	equipSlotHeight    = 30
+	tooltipWidth       = 240
+	tooltipLineHeight  = 18
+	inventoryTitleSize = 20
+)
+
+// InventoryOverlay draws the player's inventory grid and equipment over the
+// world. Clicking an inventory item equips it and clicking an equipment
+// slot returns its item to the inventory.
+type InventoryOverlay struct {
+	config *config.Config
+	player *entities.Player
+	open   bool
+}
+
+// NewInventoryOverlay creates a closed inventory overlay
+func NewInventoryOverlay(cfg *config.Config) *InventoryOverlay {
+	return &InventoryOverlay{config: cfg}
+}
+
+// SetPlayer sets whose inventory is shown
+func (io *InventoryOverlay) SetPlayer(player *entities.Player) {
+	io.player = player
+}
+
+// IsOpen reports whether the overlay is showing
+func (io *InventoryOverlay) IsOpen() bool {
+	return io.open
+}
+
+// Toggle opens or closes the overlay
+func (io *InventoryOverlay) Toggle() {
+	io.open = !io.open
+}
+
+// Close hides the overlay
+func (io *InventoryOverlay) Close() {
+	io.open = false
+}
+
+// HandleInput equips or unequips the item under the mouse when clicked
+func (io *InventoryOverlay) HandleInput() {
+	if !io.open || io.player == nil || !globals.InputSystem.IsMouseLeftPressed() {
+		return
+	}
+	mouse := globals.InputSystem.GetMousePosition()
+
+	if item := io.inventoryItemAt(mouse); item != nil {
+		if !item.Definition.Equippable() {
+			return
+		}
+		if err := io.player.EquipItem(item.UID); err != nil {
+			log.Printf("Cannot equip %s: %v", item.Name(), err)
+		}
+		return
+	}
+
+	for i, slot := range items.AllEquipSlots {
+		if rl.CheckCollisionPointRec(mouse, io.slotRect(i)) && io.player.Equipment.Get(slot) != nil {
+			if err := io.player.UnequipItem(slot); err != nil {
+				log.Printf("Cannot unequip %s: %v", slot, err)
+			}
+			return
+		}
+	}
+}
+
+// Render draws the equipment slots, the inventory grid and a tooltip for
+// the item under the mouse
+func (io *InventoryOverlay) Render() {
+	if !io.open || io.player == nil {
+		return
+	}
+	inventory := io.player.Inventory
+
+	panel := io.panelRect()
+	rl.DrawRectangleRec(panel, rl.Fade(rl.Black, 0.8))
+	rl.DrawText("Inventory (I to close)", int32(panel.X)+inventoryPadding, int32(panel.Y)+inventoryPadding,
+		inventoryTitleSize, rl.White)
+
+	for i, slot := range items.AllEquipSlots {
+		rect := io.slotRect(i)
+		rl.DrawRectangleLinesEx(rect, 1, rl.Gray)
+		label := string(slot)
+		color := rl.DarkGray
+		if item := io.player.Equipment.Get(slot); item != nil {
+			label = item.Name()
+			color = rarityColor(item.GetRarity())
+		}
+		rl.DrawText(label, int32(rect.X)+5, int32(rect.Y)+8, 14, color)
+	}
+
+	for y := 0; y < inventory.Height; y++ {
+		for x := 0; x < inventory.Width; x++ {
+			rl.DrawRectangleLinesEx(io.cellRect(x, y, 1, 1), 1, rl.DarkGray)
+		}
+	}
+	for _, placement := range inventory.Placements() {
+		definition := placement.Item.Definition
+		rect := io.cellRect(placement.X, placement.Y, max(definition.Width, 1), max(definition.Height, 1))
+		rl.DrawRectangleRec(rect, rl.Fade(rl.DarkBlue, 0.6))
+		rl.DrawRectangleLinesEx(rect, 1, rarityColor(placement.Item.GetRarity()))
+		rl.DrawText(placement.Item.Name(), int32(rect.X)+3, int32(rect.Y)+3, 10, rl.White)
+		if placement.Item.Quantity > 1 {
+			rl.DrawText(fmt.Sprintf("x%d", placement.Item.Quantity),
+				int32(rect.X)+3, int32(rect.Y+rect.Height)-13, 10, rl.Gold)
+		}
+	}
+
+	mouse := globals.InputSystem.GetMousePosition()
+	if item := io.hoveredItem(mouse); item != nil {
+		io.drawTooltip(item, mouse)
+	}
+}
+
+// drawTooltip lists an item's name, base type, slot, item level and every
+// modifier next to the mouse
+func (io *InventoryOverlay) drawTooltip(item *items.Item, mouse rl.Vector2) {
+	lines := []string{item.Name()}
+	if item.Name() != item.Definition.Name {
+		lines = append(lines, item.Definition.Name)
+	}
+	if item.Definition.Equippable() {
+		lines = append(lines, fmt.Sprintf("%s %s", item.GetRarity(), item.Definition.Slot))
+		if item.ItemLevel > 0 {
+			lines = append(lines, fmt.Sprintf("Item level %d", item.ItemLevel))
+		}
+	}
+	for _, modifier := range item.Modifiers() {
+		lines = append(lines, formatItemModifier(modifier))
+	}
+
+	height := float32(len(lines)*tooltipLineHeight + inventoryPadding)
+	rect := rl.NewRectangle(mouse.X+15, mouse.Y+15, tooltipWidth, height)
+	rl.DrawRectangleRec(rect, rl.Fade(rl.Black, 0.9))
+	rl.DrawRectangleLinesEx(rect, 1, rarityColor(item.GetRarity()))
+	for i, line := range lines {
+		color := rl.LightGray
+		if i == 0 {
+			color = rarityColor(item.GetRarity())
+		}
+		rl.DrawText(line, int32(rect.X)+5, int32(rect.Y)+5+int32(i*tooltipLineHeight), 14, color)
+	}
+}
+
+// hoveredItem returns the inventory or equipped item under the mouse
+func (io *InventoryOverlay) hoveredItem(mouse rl.Vector2) *items.Item {
+	if item := io.inventoryItemAt(mouse); item != nil {
+		return item
+	}
+	for i, slot := range items.AllEquipSlots {
+		if rl.CheckCollisionPointRec(mouse, io.slotRect(i)) {
+			return io.player.Equipment.Get(slot)
+		}
+	}
+	return nil
+}
+
+// inventoryItemAt returns the inventory item covering the screen point
+func (io *InventoryOverlay) inventoryItemAt(point rl.Vector2) *items.Item {
+	origin := io.gridOrigin()
+	if point.X < origin.X || point.Y < origin.Y {
+		return nil
+	}
+	x := int((point.X - origin.X) / inventoryCellSize)
+	y := int((point.Y - origin.Y) / inventoryCellSize)
+	return io.player.Inventory.ItemAt(x, y)
+}
+
+// panelRect is centred on screen and sized to fit the equipment column and grid
+func (io *InventoryOverlay) panelRect() rl.Rectangle {
+	inventory := io.player.Inventory
+	gridHeight := inventory.Height * inventoryCellSize
+	slotsHeight := len(items.AllEquipSlots) * (equipSlotHeight + 4)
+	width := float32(equipSlotWidth + inventory.Width*inventoryCellSize + inventoryPadding*3)
+	height := float32(max(gridHeight, slotsHeight) + inventoryTitleSize + inventoryPadding*3)
+	return rl.NewRectangle(
+		(float32(io.config.Window.Width)-width)/2,
+		(float32(io.config.Window.Height)-height)/2,
+		width,
+		height,
+	)
+}
+
+func (io *InventoryOverlay) slotRect(index int) rl.Rectangle {
+	panel := io.panelRect()
+	return rl.NewRectangle(
+		panel.X+inventoryPadding,
+		panel.Y+inventoryTitleSize+inventoryPadding*2+float32(index*(equipSlotHeight+4)),
+		equipSlotWidth,
+		equipSlotHeight,
+	)
+}
+
+func (io *InventoryOverlay) gridOrigin() rl.Vector2 {
+	panel := io.panelRect()
+	return rl.Vector2{
+		X: panel.X + equipSlotWidth + inventoryPadding*2,
+		Y: panel.Y + inventoryTitleSize + inventoryPadding*2,
+	}
+}
+
+func (io *InventoryOverlay) cellRect(x, y, width, height int) rl.Rectangle {
+	origin := io.gridOrigin()
+	return rl.NewRectangle(
+		origin.X+float32(x*inventoryCellSize),
+		origin.Y+float32(y*inventoryCellSize),
+		float32(width*inventoryCellSize),
+		float32(height*inventoryCellSize),
+	)
+}
+
+// rarityColor is the colour item names and borders use for each rarity
+func rarityColor(rarity items.Rarity) rl.Color {
+	switch rarity {
+	case items.RarityMagic:
+		return rl.SkyBlue
+	case items.RarityRare:
+		return rl.Yellow
+	case items.RarityUnique:
+		return rl.Orange
+	default:
+		return rl.RayWhite
+	}
+}
+
+// formatItemModifier describes a modifier for tooltips, e.g. "+5 damage"
+// or "10% increased move_speed"
+func formatItemModifier(modifier items.StatModifier) string {
+	switch modifier.Kind {
+	case items.ModifierIncreased:
+		if modifier.Value < 0 {
+			return fmt.Sprintf("%.0f%% reduced %s", -modifier.Value*100, modifier.Stat)
+		}
+		return fmt.Sprintf("%.0f%% increased %s", modifier.Value*100, modifier.Stat)
+	case items.ModifierMore:
+		if modifier.Value < 0 {
+			return fmt.Sprintf("%.0f%% less %s", -modifier.Value*100, modifier.Stat)
+		}
+		return fmt.Sprintf("%.0f%% more %s", modifier.Value*100, modifier.Stat)
+	default:
+		return fmt.Sprintf("%+g %s", modifier.Value, modifier.Stat)
+	}
+}
@@ -3,6 +3,9 @@ package scenes
 import (
 	"fmt"
 	"log"
+	"math"
+	"math/rand"
+	"time"
 
 	rl "github.com/gen2brain/raylib-go/raylib"
 
@@ -11,8 +14,51 @@ import (
 	"arpg/pkg/entities"
 	"arpg/pkg/events"
 	"arpg/pkg/globals"
+	"arpg/pkg/items"
+	"arpg/pkg/navigation"
 	"arpg/pkg/rendering"
 	"arpg/pkg/scenes"
+	"arpg/pkg/terrain"
+)
+
+const (
+	enemyArchetypesFile = "scenes/enemies.json"
+	levelCurveFile      = "scenes/progression.json"
+	itemCatalogFile     = "scenes/items.json"
+	lootTablesFile      = "scenes/loot.json"
+	affixesFile         = "scenes/affixes.json"
+	weaponsFile         = "scenes/weapons.json"
+)
+
+// dodgeCameraKick is how far the camera lags behind the start of a dodge
+const dodgeCameraKick = 0.4
+
+// bossPhaseCameraKick shakes the camera when a boss changes phase
+const bossPhaseCameraKick = 0.6
+
+// skillEffectDuration is how long an area skill's ring stays on screen
+const skillEffectDuration = 0.4
+
+// skillSlotLabels name the input bound to each skill bar slot
+var skillSlotLabels = [entities.SkillSlotCount]string{"RMB", "Q", "E", "F"}
+
+// skillEffect is a fading ring drawn where an area skill or explosion went off
+type skillEffect struct {
+	position  rl.Vector3
+	radius    float32
+	remaining float32
+}
+
+// lootScatterRadius spreads multiple drops from one enemy around its position
+const lootScatterRadius = 0.6
+
+// Navigation grid settings. The grid covers the ground plane, or the terrain
+// when the scene has one, and obstacles are grown by roughly an enemy's
+// radius.
+const (
+	navGridSize  = 50.0
+	navCellSize  = 0.5
+	navClearance = 0.6
 )
 
 type WorldScene struct {
@@ -22,18 +68,34 @@ type WorldScene struct {
 	camera       *camera.Camera
 	sceneBuilder *scenes.SceneBuilder
 	eventBus     *events.EventBus
+	inventory    *InventoryOverlay
 
 	// Game entities
-	player        *entities.Player
-	enemies       []*entities.Enemy
-	bullets       []*entities.Bullet
-	obstacles     []*entities.Obstacle
-	healthPickups []*entities.HealthPickup
+	world  *entities.World
+	player *entities.Player
+
+	// Loot
+	itemGenerator *items.Generator
+	lootTables    *items.LootTables
+	lootRNG       *rand.Rand
+
+	// Sends escalating enemy waves, nil for scenes without waves
+	waves *entities.WaveDirector
+
+	// Heightmap ground, nil for scenes on flat ground
+	terrain *terrain.Heightmap
+
+	// Short-lived visuals for area skills and explosions
+	skillEffects []skillEffect
+
+	// Red vignette flared by player damage
+	hurtIndicator *rendering.HurtIndicator
 
 	// Scene state
 	shouldTransition bool
 	nextScene        string
 	paused           bool
+	showCharacter    bool // Character panel for spending attribute points
 }
 
 func NewGameScene(cfg *config.Config) *WorldScene {
@@ -44,6 +106,8 @@ func NewGameScene(cfg *config.Config) *WorldScene {
 		paused:           false,
 		sceneBuilder:     scenes.NewSceneBuilder(),
 		eventBus:         events.NewEventBus(),
+		inventory:        NewInventoryOverlay(cfg),
+		hurtIndicator:    rendering.NewHurtIndicator(0),
 	}
 
 	globals.InitInput()
@@ -51,6 +115,12 @@ func NewGameScene(cfg *config.Config) *WorldScene {
 	globals.InitTriggers()
 	gs.camera = camera.NewCamera(cfg)
 
+	gs.world = entities.NewWorld(globals.Collision, globals.Triggers)
+	gs.world.AddDefaultSystems(gs.camera)
+	gs.world.AddSystem("waves", entities.OrderTick, entities.SystemFunc(gs.updateWaves))
+	gs.world.SetEventBus(gs.eventBus)
+	gs.world.OnDespawn(gs.onEntityDespawned)
+
 	return gs
 }
 
@@ -66,10 +136,54 @@ func (gs *WorldScene) Initialize() error {
 		return fmt.Errorf("failed to subscribe to player damaged events: %w", err)
 	}
 
+	if err := gs.eventBus.Subscribe(events.EventTypePlayerDamaged, gs.hurtIndicator); err != nil {
+		return fmt.Errorf("failed to subscribe hurt indicator to player damaged events: %w", err)
+	}
+
 	if err := gs.eventBus.Subscribe(events.EventTypeGameOver, gs); err != nil {
 		return fmt.Errorf("failed to subscribe to game over events: %w", err)
 	}
 
+	if err := gs.eventBus.Subscribe(events.EventTypePlayerLevelUp, gs); err != nil {
+		return fmt.Errorf("failed to subscribe to player level up events: %w", err)
+	}
+
+	if err := gs.eventBus.Subscribe(events.EventTypeSkillCast, gs); err != nil {
+		return fmt.Errorf("failed to subscribe to skill cast events: %w", err)
+	}
+
+	if err := gs.eventBus.Subscribe(events.EventTypeSkillCastFailed, gs); err != nil {
+		return fmt.Errorf("failed to subscribe to skill cast failed events: %w", err)
+	}
+
+	if err := gs.eventBus.Subscribe(events.EventTypeDodgeStarted, gs); err != nil {
+		return fmt.Errorf("failed to subscribe to dodge events: %w", err)
+	}
+
+	if err := gs.eventBus.Subscribe(events.EventTypeExplosion, gs); err != nil {
+		return fmt.Errorf("failed to subscribe to explosion events: %w", err)
+	}
+
+	if err := gs.eventBus.Subscribe(events.EventTypePickupCollected, gs); err != nil {
+		return fmt.Errorf("failed to subscribe to pickup events: %w", err)
+	}
+
+	if err := gs.eventBus.Subscribe(events.EventTypeWaveStarted, gs); err != nil {
+		return fmt.Errorf("failed to subscribe to wave started events: %w", err)
+	}
+
+	if err := gs.eventBus.Subscribe(events.EventTypeWaveCompleted, gs); err != nil {
+		return fmt.Errorf("failed to subscribe to wave completed events: %w", err)
+	}
+
+	if err := gs.eventBus.Subscribe(events.EventTypeBossPhaseChanged, gs); err != nil {
+		return fmt.Errorf("failed to subscribe to boss phase events: %w", err)
+	}
+
+	if err := gs.eventBus.Subscribe(events.EventTypeBossDefeated, gs); err != nil {
+		return fmt.Errorf("failed to subscribe to boss defeated events: %w", err)
+	}
+
 	return gs.InitializeFromJSON("scenes/game_scene.json")
 }
 
@@ -85,8 +199,9 @@ func (gs *WorldScene) OnNotify(event events.Event) error {
 	case events.EventTypePlayerDamaged:
 		damageData, ok := event.Data.(events.PlayerDamagedEvent)
 		if ok {
-			log.Printf("Player took %.1f damage from %s, health: %.1f",
-				damageData.Damage, damageData.Source, damageData.NewHealth)
+			log.Printf("Player took %.1f %s damage (%.1f mitigated) from %s, health: %.1f",
+				damageData.Damage, damageData.Breakdown.DamageType, damageData.Breakdown.Mitigated,
+				damageData.Source, damageData.NewHealth)
 		}
 
 	case events.EventTypeGameOver:
@@ -94,14 +209,121 @@ func (gs *WorldScene) OnNotify(event events.Event) error {
 		if ok {
 			log.Printf("Game over: %s", gameOverData.Reason)
 		}
+
+	case events.EventTypePlayerLevelUp:
+		levelUpData, ok := event.Data.(events.PlayerLevelUpEvent)
+		if ok {
+			log.Printf("Player reached level %d with %d attribute points to spend",
+				levelUpData.NewLevel, levelUpData.AttributePoints)
+		}
+
+	case events.EventTypeSkillCastFailed:
+		failedData, ok := event.Data.(events.SkillCastFailedEvent)
+		if ok {
+			log.Printf("Skill in slot %d failed to cast: %s", failedData.Slot, failedData.Reason)
+		}
+
+	case events.EventTypeExplosion:
+		explosionData, ok := event.Data.(events.ExplosionEvent)
+		if ok {
+			gs.skillEffects = append(gs.skillEffects, skillEffect{
+				position:  explosionData.Position,
+				radius:    explosionData.Radius,
+				remaining: skillEffectDuration,
+			})
+		}
+
+	case events.EventTypePickupCollected:
+		pickupData, ok := event.Data.(events.PickupEvent)
+		if ok {
+			log.Printf("Player collected %s pickup %s (%.1f)",
+				pickupData.PickupType, pickupData.PickupID, pickupData.Amount)
+		}
+
+	case events.EventTypeWaveStarted:
+		waveData, ok := event.Data.(events.WaveEvent)
+		if ok {
+			log.Printf("Wave %d started with %d enemies", waveData.Wave, waveData.Enemies)
+		}
+
+	case events.EventTypeWaveCompleted:
+		waveData, ok := event.Data.(events.WaveEvent)
+		if ok {
+			log.Printf("Wave %d complete, next wave in %.0fs", waveData.Wave, waveData.NextWaveIn)
+		}
+
+	case events.EventTypeBossPhaseChanged:
+		phaseData, ok := event.Data.(events.BossPhaseEvent)
+		if ok {
+			log.Printf("%s enters phase %d: %s", phaseData.Name, phaseData.Phase+1, phaseData.PhaseName)
+			gs.camera.Kick(rl.Vector3{Y: bossPhaseCameraKick})
+		}
+
+	case events.EventTypeBossDefeated:
+		bossData, ok := event.Data.(events.BossDefeatedEvent)
+		if ok {
+			log.Printf("%s defeated by %s", bossData.Name, bossData.Killer)
+		}
+
+	case events.EventTypeDodgeStarted:
+		dodgeData, ok := event.Data.(events.DodgeEvent)
+		if ok {
+			gs.camera.Kick(rl.Vector3Scale(dodgeData.Direction, -dodgeCameraKick))
+		}
+
+	case events.EventTypeSkillCast:
+		castData, ok := event.Data.(events.SkillCastEvent)
+		if ok && castData.Radius > 0 {
+			gs.skillEffects = append(gs.skillEffects, skillEffect{
+				position:  castData.Target,
+				radius:    castData.Radius,
+				remaining: skillEffectDuration,
+			})
+		}
 	}
 
 	return nil
 }
 
 func (gs *WorldScene) InitializeFromJSON(jsonFile string) error {
-	globals.Collision.ClearAll()
-	globals.Triggers.ClearAll()
+	gs.world.Clear()
+
+	archetypes, err := scenes.LoadArchetypesFromJSON(enemyArchetypesFile)
+	if err != nil {
+		return fmt.Errorf("failed to load enemy archetypes: %w", err)
+	}
+	gs.sceneBuilder.SetArchetypes(archetypes)
+
+	itemCatalog, err := scenes.LoadItemCatalogFromJSON(itemCatalogFile)
+	if err != nil {
+		return fmt.Errorf("failed to load items: %w", err)
+	}
+	gs.sceneBuilder.SetItems(itemCatalog)
+
+	itemGenerator, err := scenes.LoadItemGeneratorFromJSON(affixesFile, itemCatalog)
+	if err != nil {
+		return fmt.Errorf("failed to load affixes: %w", err)
+	}
+	gs.itemGenerator = itemGenerator
+
+	lootTables, err := scenes.LoadLootTablesFromJSON(lootTablesFile, itemCatalog)
+	if err != nil {
+		return fmt.Errorf("failed to load loot tables: %w", err)
+	}
+	gs.sceneBuilder.SetLootTables(lootTables)
+	gs.lootTables = lootTables
+	gs.lootRNG = rand.New(rand.NewSource(gs.lootSeed()))
+
+	weapons, err := scenes.LoadWeaponsFromJSON(weaponsFile)
+	if err != nil {
+		return fmt.Errorf("failed to load weapons: %w", err)
+	}
+	gs.sceneBuilder.SetWeapons(weapons)
+
+	levelCurve, err := scenes.LoadLevelCurveFromJSON(levelCurveFile)
+	if err != nil {
+		return fmt.Errorf("failed to load level curve: %w", err)
+	}
 
 	sceneData, err := scenes.LoadSceneFromJSON(jsonFile)
 	if err != nil {
@@ -116,46 +338,186 @@ func (gs *WorldScene) InitializeFromJSON(jsonFile string) error {
 		return err
 	}
 
-	gs.player = gs.sceneBuilder.BuildPlayer(sceneData.Player, gs.eventBus)
-	gs.enemies = gs.sceneBuilder.BuildEnemies(sceneData.Entities.Enemies)
-	gs.obstacles = gs.sceneBuilder.BuildObstacles(sceneData.Entities.Obstacles)
-	gs.healthPickups = gs.sceneBuilder.BuildHealthPickups(sceneData.Entities.HealthPickups)
-	gs.bullets = make([]*entities.Bullet, 0)
+	// Terrain goes in first so entities are placed on it
+	gs.terrain = nil
+	gs.sceneBuilder.SetGround(nil)
+	if sceneData.Terrain != nil {
+		heightmap, err := sceneData.Terrain.Load()
+		if err != nil {
+			return fmt.Errorf("failed to load terrain: %w", err)
+		}
+		gs.terrain = heightmap
+		gs.world.SetGround(heightmap)
+		gs.sceneBuilder.SetGround(heightmap)
+	}
+	gs.camera.SetTerrain(gs.terrain)
 
-	globals.Collision.RegisterCollidable(gs.player)
-	globals.Triggers.RegisterCollidable(gs.player) // Player can activate triggers
-	for _, enemy := range gs.enemies {
-		globals.Collision.RegisterCollidable(enemy)
+	gs.player = gs.sceneBuilder.BuildPlayer(sceneData.Player, gs.eventBus)
+	gs.player.SetLevelCurve(levelCurve)
+	gs.applyGameplayConfig()
+	gs.inventory.SetPlayer(gs.player)
+	if err := gs.world.Spawn(gs.player); err != nil {
+		return err
+	}
+	if err := spawnAll(gs.world, gs.sceneBuilder.BuildEnemies(sceneData.Entities.Enemies, gs.eventBus)); err != nil {
+		return err
+	}
+	if err := spawnAll(gs.world, gs.sceneBuilder.BuildObstacles(sceneData.Entities.Obstacles)); err != nil {
+		return err
+	}
+	if err := spawnAll(gs.world, gs.sceneBuilder.BuildHealthPickups(sceneData.Entities.HealthPickups)); err != nil {
+		return err
+	}
+	pickupTypes, err := sceneData.PickupTypes.ToRegistry()
+	if err != nil {
+		return err
 	}
-	for _, obstacle := range gs.obstacles {
-		globals.Collision.RegisterCollidable(obstacle)
+	pickups, err := gs.sceneBuilder.BuildPickups(sceneData.Entities.Pickups, pickupTypes)
+	if err != nil {
+		return err
+	}
+	if err := spawnAll(gs.world, pickups); err != nil {
+		return err
+	}
+	if err := spawnAll(gs.world, gs.sceneBuilder.BuildHazards(sceneData.Entities.Hazards)); err != nil {
+		return err
+	}
+	enemyFactory := gs.sceneBuilder.EnemyFactory(gs.eventBus)
+	if err := spawnAll(gs.world, gs.sceneBuilder.BuildBosses(sceneData.Entities.Bosses, gs.eventBus, enemyFactory)); err != nil {
+		return err
+	}
+	if err := spawnAll(gs.world, gs.sceneBuilder.BuildSpawners(sceneData.Entities.Spawners, enemyFactory)); err != nil {
+		return err
 	}
-	for _, pickup := range gs.healthPickups {
-		globals.Triggers.RegisterTrigger(pickup)
+	gs.waves = nil
+	if sceneData.Waves != nil {
+		waveRNG := rand.New(rand.NewSource(gs.lootSeed()))
+		gs.waves = entities.NewWaveDirector(sceneData.Waves.ToConfig(), enemyFactory, waveRNG, gs.eventBus)
 	}
+	gs.world.SetNavGrid(gs.buildNavGrid())
 
 	gs.camera.Initialize(gs.player)
 
 	gs.shouldTransition = false
 	gs.nextScene = ""
 	gs.paused = false
+	gs.showCharacter = false
+	gs.skillEffects = nil
+	gs.hurtIndicator.Reset()
+	gs.hurtIndicator.SetMaxHealth(gs.player.MaxHealth)
+	gs.inventory.Close()
 
 	log.Printf("Scene loaded successfully: %s", sceneData.Metadata.Name)
 	return nil
 }
 
-func (gs *WorldScene) Update(deltaTime float32) error {
-	if gs.paused {
-		return nil
+// buildNavGrid blocks out every obstacle in the world and any terrain too
+// steep to walk for enemy pathfinding. With terrain the grid spans the
+// terrain's extent rather than the default ground plane.
+func (gs *WorldScene) buildNavGrid() *navigation.Grid {
+	obstacles := entities.OfType[*entities.Obstacle](gs.world)
+	boxes := make([]rl.BoundingBox, 0, len(obstacles))
+	for _, obstacle := range obstacles {
+		boxes = append(boxes, obstacle.GetBoundingBox())
+	}
+	if gs.terrain == nil {
+		return navigation.NewGridFromObstacles(navGridSize, navCellSize, boxes, navClearance)
 	}
 
-	gs.updateEntities(deltaTime)
+	size := gs.terrain.Size
+	grid := navigation.NewGrid(
+		-size.X/2,
+		-size.Z/2,
+		int(math.Ceil(float64(size.X/navCellSize))),
+		int(math.Ceil(float64(size.Z/navCellSize))),
+		navCellSize,
+	)
+	for _, box := range boxes {
+		grid.BlockBox(box, navClearance)
+	}
+
+	if gs.terrain.MaxSlope > 0 {
+		for x := range grid.Width {
+			for z := range grid.Height {
+				cell := navigation.Cell{X: x, Z: z}
+				center := grid.CellToWorld(cell)
+				if gs.terrain.SlopeAt(center.X, center.Z) > gs.terrain.MaxSlope {
+					grid.SetBlocked(cell, true)
+				}
+			}
+		}
+	}
+	return grid
+}
 
-	globals.Collision.Update()
-	globals.Triggers.Update()
+// updateWaves runs the wave director, if the scene has one
+func (gs *WorldScene) updateWaves(w *entities.World, deltaTime float32) {
+	if gs.waves != nil {
+		gs.waves.Update(w, deltaTime)
+	}
+}
 
-	gs.cleanupEntities()
+// allEnemiesDefeated reports whether no enemies are alive and none are still
+// to come from spawners or waves
+func (gs *WorldScene) allEnemiesDefeated(aliveEnemies int) bool {
+	if aliveEnemies > 0 || len(entities.OfType[*entities.Spawner](gs.world)) > 0 {
+		return false
+	}
+	return gs.waves == nil || gs.waves.Finished()
+}
+
+// spawnAll adds every entity built from scene data to the world
+func spawnAll[T entities.Entity](world *entities.World, list []T) error {
+	for _, entity := range list {
+		if err := world.Spawn(entity); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyGameplayConfig sets the player's projectile speed, lifetime, base
+// damage, post-hit invulnerability and movement curves from the gameplay
+// config, keeping the defaults for unset values
+func (gs *WorldScene) applyGameplayConfig() {
+	gameplay := gs.config.Gameplay
+	if gameplay.BulletSpeed > 0 {
+		gs.player.BulletSpeed = gameplay.BulletSpeed
+	}
+	if gameplay.BulletLife > 0 {
+		gs.player.BulletLifetime = gameplay.BulletLife
+	}
+	if gameplay.BulletDamage > 0 {
+		gs.player.SetBaseStat(entities.StatDamage, gameplay.BulletDamage)
+	}
+	if gameplay.HurtInvulnerability > 0 {
+		gs.player.HurtInvulnerability = gameplay.HurtInvulnerability
+	}
+	if gameplay.MoveAcceleration > 0 {
+		gs.player.MoveAcceleration = gameplay.MoveAcceleration
+	}
+	if gameplay.MoveDeceleration > 0 {
+		gs.player.MoveDeceleration = gameplay.MoveDeceleration
+	}
+}
+
+// FindEntity looks up a runtime entity by its ID
+func (gs *WorldScene) FindEntity(id string) (entities.Entity, bool) {
+	return gs.world.Get(id)
+}
+
+func (gs *WorldScene) Update(deltaTime float32) error {
+	// The world holds still while the inventory is open so clicks on it
+	// do not fire the player's weapon
+	if gs.paused || gs.inventory.IsOpen() {
+		return nil
+	}
+
+	gs.world.Update(deltaTime)
 	gs.camera.Update(gs.player)
+	gs.updateSkillEffects(deltaTime)
+	gs.hurtIndicator.SetMaxHealth(gs.player.MaxHealth)
+	gs.hurtIndicator.Update(deltaTime)
 
 	return nil
 }
@@ -165,18 +527,23 @@ func (gs *WorldScene) Render(renderer *rendering.Renderer) error {
 
 	renderer.BeginMode3D(gs.camera)
 
-	renderer.DrawGround()
-	renderer.DrawObstacles(gs.obstacles)
-	renderer.DrawPlayer(gs.player)
-	renderer.DrawEnemies(gs.enemies)
-	renderer.DrawBullets(gs.bullets)
-	renderer.DrawHealthPickups(gs.healthPickups)
-	renderer.DrawGrid()
+	if gs.terrain != nil {
+		renderer.DrawTerrain(gs.terrain)
+	} else {
+		renderer.DrawGround()
+	}
+	renderer.DrawWorld(gs.world)
+	gs.drawSkillEffects()
+	if gs.terrain == nil {
+		renderer.DrawGrid() // The flat grid would cut through hills
+	}
 
 	renderer.EndMode3D()
 
-	renderer.DrawEnemyHealthBars(gs.enemies, gs.camera)
+	renderer.DrawEnemyHealthBars(entities.OfType[*entities.Enemy](gs.world), gs.camera)
+	gs.hurtIndicator.Draw(gs.config.Window.Width, gs.config.Window.Height)
 	gs.drawGameUI()
+	gs.inventory.Render()
 
 	renderer.EndFrame()
 	return nil
@@ -185,8 +552,12 @@ func (gs *WorldScene) Render(renderer *rendering.Renderer) error {
 func (gs *WorldScene) drawGameUI() {
 	rl.DrawText("WASD to move", 10, 10, 20, rl.DarkGray)
 	rl.DrawText("Mouse to aim", 10, 35, 20, rl.DarkGray)
-	rl.DrawText("Left click to shoot", 10, 60, 20, rl.DarkGray)
+	rl.DrawText("Hold left click to shoot", 10, 60, 20, rl.DarkGray)
 	rl.DrawText("ESC to return to menu", 10, 85, 20, rl.DarkGray)
+	rl.DrawText("I for inventory, C for character", 10, 135, 20, rl.DarkGray)
+	rl.DrawText("Number keys to switch weapons", 10, 160, 20, rl.DarkGray)
+	rl.DrawText("Right click, Q, E and F cast skills", 10, 185, 20, rl.DarkGray)
+	rl.DrawText("Shift to dodge", 10, 210, 20, rl.DarkGray)
 
 	if gs.config.Debug.ShowFPS {
 		rl.DrawFPS(10, 110)
@@ -195,17 +566,31 @@ func (gs *WorldScene) drawGameUI() {
 	healthText := "Health: " + floatToString(gs.player.Health, 0)
 	rl.DrawText(healthText, 10, gs.config.Window.Height-60, 20, rl.Red)
 
+	gs.drawExperienceBar()
+	gs.drawWaveStatus()
+	gs.drawBossBar()
+	gs.drawWeaponStatus()
+	gs.drawSkillBar()
+	if gs.showCharacter {
+		gs.drawCharacterPanel()
+	}
+
 	aliveEnemies := 0
-	for _, enemy := range gs.enemies {
+	for _, enemy := range entities.OfType[*entities.Enemy](gs.world) {
 		if enemy.IsAlive() {
 			aliveEnemies++
 		}
 	}
+	for _, boss := range entities.OfType[*entities.Boss](gs.world) {
+		if boss.IsAlive() {
+			aliveEnemies++
+		}
+	}
 	enemyText := "Enemies: " + intToString(aliveEnemies)
 	rl.DrawText(enemyText, 10, gs.config.Window.Height-35, 20, rl.Blue)
 
 	activeBullets := 0
-	for _, bullet := range gs.bullets {
+	for _, bullet := range entities.OfType[*entities.Bullet](gs.world) {
 		if bullet.Active {
 			activeBullets++
 		}
@@ -233,7 +618,7 @@ func (gs *WorldScene) drawGameUI() {
 		rl.DrawText(gameOverText, gameOverX, gameOverY, gameOverFontSize, rl.Red)
 	}
 
-	if aliveEnemies == 0 {
+	if gs.allEnemiesDefeated(aliveEnemies) {
 		victoryText := "VICTORY! - Press R to restart or ESC for menu"
 		victoryFontSize := int32(24)
 		victoryWidth := rl.MeasureText(victoryText, victoryFontSize)
@@ -244,6 +629,197 @@ func (gs *WorldScene) drawGameUI() {
 	}
 }
 
+// drawExperienceBar draws the player's level, progress to the next level and
+// a reminder when attribute points are waiting to be spent
+func (gs *WorldScene) drawExperienceBar() {
+	const barWidth, barHeight = 200, 10
+	barX := int32(10)
+	barY := gs.config.Window.Height - 90
+
+	levelText := "Level " + intToString(gs.player.Level)
+	rl.DrawText(levelText, barX, barY-22, 20, rl.DarkPurple)
+
+	progress := float32(1)
+	if needed := gs.player.LevelCurve().XPToNextLevel(gs.player.Level); needed > 0 {
+		progress = gs.player.XP / needed
+	}
+	rl.DrawRectangle(barX, barY, barWidth, barHeight, rl.LightGray)
+	rl.DrawRectangle(barX, barY, int32(progress*barWidth), barHeight, rl.Purple)
+	rl.DrawRectangleLines(barX, barY, barWidth, barHeight, rl.DarkGray)
+
+	if gs.player.AttributePoints > 0 {
+		pointsText := intToString(gs.player.AttributePoints) + " attribute points - press C"
+		rl.DrawText(pointsText, barX+barWidth+10, barY-4, 20, rl.Gold)
+	}
+}
+
+// drawWaveStatus shows the current wave and either the enemies left in it
+// or the countdown to the next one
+func (gs *WorldScene) drawWaveStatus() {
+	if gs.waves == nil {
+		return
+	}
+
+	text := "Wave " + intToString(gs.waves.Wave())
+	if total := gs.waves.TotalWaves(); total > 0 {
+		text += "/" + intToString(total)
+	}
+	switch {
+	case gs.waves.Finished():
+		text += " - all waves cleared"
+	case gs.waves.InProgress():
+		text += " - " + intToString(gs.waves.Remaining()) + " enemies left"
+	default:
+		text += fmt.Sprintf(" - next wave in %.0fs", math.Ceil(float64(gs.waves.TimeUntilNextWave())))
+	}
+
+	fontSize := int32(24)
+	width := rl.MeasureText(text, fontSize)
+	rl.DrawText(text, (gs.config.Window.Width-width)/2, 10, fontSize, rl.Maroon)
+}
+
+// drawBossBar draws the health of the boss the player is fighting across
+// the top of the screen, with its name and current phase
+func (gs *WorldScene) drawBossBar() {
+	var boss *entities.Boss
+	for _, candidate := range entities.OfType[*entities.Boss](gs.world) {
+		if candidate.IsEngaged() {
+			boss = candidate
+			break
+		}
+	}
+	if boss == nil {
+		return
+	}
+
+	barWidth := gs.config.Window.Width / 2
+	const barHeight = 16
+	x := (gs.config.Window.Width - barWidth) / 2
+	y := int32(45)
+
+	title := boss.Name
+	if phase := boss.CurrentPhase(); phase != nil && phase.Name != "" {
+		title += " - " + phase.Name
+	}
+	rl.DrawText(title, x, y-22, 20, rl.Maroon)
+	rl.DrawRectangle(x, y, barWidth, barHeight, rl.Fade(rl.Black, 0.6))
+	rl.DrawRectangle(x, y, int32(boss.GetHealthPercent()*float32(barWidth)), barHeight, rl.Red)
+	for _, phase := range boss.Phases[1:] {
+		markX := x + int32(phase.HealthThreshold*float32(barWidth))
+		rl.DrawLine(markX, y, markX, y+barHeight, rl.White)
+	}
+	rl.DrawRectangleLines(x, y, barWidth, barHeight, rl.DarkGray)
+}
+
+// drawWeaponStatus shows the active weapon, its ammunition and reload progress
+func (gs *WorldScene) drawWeaponStatus() {
+	weapon := gs.player.ActiveWeapon()
+	if weapon == nil {
+		return
+	}
+	x := gs.config.Window.Width - 260
+	y := gs.config.Window.Height - 60
+
+	text := fmt.Sprintf("%d) %s", gs.player.ActiveWeaponIndex()+1, weapon.Definition.Name)
+	if weapon.Definition.MagazineSize > 0 {
+		text += fmt.Sprintf("  %d/%d", weapon.Ammo, weapon.Definition.MagazineSize)
+	}
+	rl.DrawText(text, x, y, 20, rl.DarkGray)
+	rl.DrawText(fmt.Sprintf("Gold: %d", gs.player.Gold), x, y-25, 20, rl.Gold)
+
+	if weapon.IsReloading() {
+		const barWidth, barHeight = 200, 8
+		rl.DrawRectangle(x, y+25, barWidth, barHeight, rl.LightGray)
+		rl.DrawRectangle(x, y+25, int32(weapon.ReloadProgress()*barWidth), barHeight, rl.Orange)
+		rl.DrawText("Reloading", x+barWidth+5, y+20, 16, rl.Orange)
+	}
+}
+
+// drawSkillBar draws the mana bar, each skill slot with its key and
+// cooldown, and the progress of any cast
+func (gs *WorldScene) drawSkillBar() {
+	const slotSize, slotGap = 56, 6
+	barWidth := int32(entities.SkillSlotCount*(slotSize+slotGap) - slotGap)
+	x := (gs.config.Window.Width - barWidth) / 2
+	y := gs.config.Window.Height - slotSize - 30
+
+	manaPercent := float32(0)
+	if gs.player.MaxMana > 0 {
+		manaPercent = gs.player.Mana / gs.player.MaxMana
+	}
+	rl.DrawRectangle(x, y-16, barWidth, 10, rl.LightGray)
+	rl.DrawRectangle(x, y-16, int32(manaPercent*float32(barWidth)), 10, rl.Blue)
+	rl.DrawRectangleLines(x, y-16, barWidth, 10, rl.DarkGray)
+
+	for slot, skill := range gs.player.Skills {
+		slotX := x + int32(slot*(slotSize+slotGap))
+		rl.DrawRectangle(slotX, y, slotSize, slotSize, rl.Fade(rl.Black, 0.6))
+		rl.DrawRectangleLines(slotX, y, slotSize, slotSize, rl.Gray)
+		rl.DrawText(skillSlotLabels[slot], slotX+4, y+4, 12, rl.LightGray)
+		if skill == nil {
+			continue
+		}
+
+		rl.DrawText(skill.Definition.Name, slotX+4, y+22, 10, rl.White)
+		if skill.Definition.Cooldown > 0 && skill.Cooldown() > 0 {
+			shade := int32(skill.Cooldown() / skill.Definition.Cooldown * slotSize)
+			rl.DrawRectangle(slotX, y+slotSize-shade, slotSize, shade, rl.Fade(rl.Black, 0.6))
+			rl.DrawText(fmt.Sprintf("%.1f", skill.Cooldown()), slotX+4, y+38, 14, rl.White)
+		} else if gs.player.Mana < skill.Definition.ManaCost {
+			rl.DrawRectangleLines(slotX+1, y+1, slotSize-2, slotSize-2, rl.Blue)
+		}
+	}
+
+	if gs.player.IsCasting() {
+		rl.DrawRectangle(x, y-30, int32(gs.player.CastProgress()*float32(barWidth)), 8, rl.Gold)
+		rl.DrawRectangleLines(x, y-30, barWidth, 8, rl.DarkGray)
+	}
+}
+
+// updateSkillEffects fades area skill rings and drops finished ones
+func (gs *WorldScene) updateSkillEffects(deltaTime float32) {
+	kept := gs.skillEffects[:0]
+	for _, effect := range gs.skillEffects {
+		effect.remaining -= deltaTime
+		if effect.remaining > 0 {
+			kept = append(kept, effect)
+		}
+	}
+	gs.skillEffects = kept
+}
+
+// drawSkillEffects draws a fading ring on the ground for each area skill
+func (gs *WorldScene) drawSkillEffects() {
+	for _, effect := range gs.skillEffects {
+		alpha := effect.remaining / skillEffectDuration
+		rl.DrawCylinder(effect.position, effect.radius, effect.radius, 0.05, 32, rl.Fade(rl.SkyBlue, 0.5*alpha))
+	}
+}
+
+// drawCharacterPanel lists the player's attributes and derived stats
+func (gs *WorldScene) drawCharacterPanel() {
+	const panelWidth, panelHeight = 320, 275
+	panelX := gs.config.Window.Width - panelWidth - 10
+	panelY := int32(10)
+
+	rl.DrawRectangle(panelX, panelY, panelWidth, panelHeight, rl.Fade(rl.Black, 0.7))
+	rl.DrawText("Character (C to close)", panelX+10, panelY+10, 20, rl.White)
+	rl.DrawText("Points: "+intToString(gs.player.AttributePoints), panelX+10, panelY+35, 20, rl.Gold)
+
+	y := panelY + 65
+	for i, attribute := range entities.AllAttributes {
+		line := fmt.Sprintf("%d) %s: %d", i+1, attribute, gs.player.Attributes.Get(attribute))
+		rl.DrawText(line, panelX+10, y, 20, rl.RayWhite)
+		y += 25
+	}
+
+	y += 10
+	rl.DrawText("Max health: "+floatToString(gs.player.MaxHealth, 0), panelX+10, y, 18, rl.LightGray)
+	rl.DrawText("Damage: "+floatToString(gs.player.BulletDamage, 2), panelX+10, y+20, 18, rl.LightGray)
+	rl.DrawText("Crit chance: "+floatToString(gs.player.CritChance*100, 0)+"%", panelX+10, y+40, 18, rl.LightGray)
+	rl.DrawText("Max mana: "+floatToString(gs.player.MaxMana, 0), panelX+10, y+60, 18, rl.LightGray)
+}
+
 func (gs *WorldScene) HandleInput(deltaTime float32) error {
 	// Handle pause
 	if globals.InputSystem.IsPausePressed() {
@@ -264,13 +840,37 @@ func (gs *WorldScene) HandleInput(deltaTime float32) error {
 		gs.config.Debug.ShowFPS = !gs.config.Debug.ShowFPS
 	}
 
+	if globals.InputSystem.IsInventoryPressed() {
+		gs.inventory.Toggle()
+	}
+	gs.inventory.HandleInput()
+
+	if globals.InputSystem.IsCharacterPressed() {
+		gs.showCharacter = !gs.showCharacter
+	}
+	// Number keys spend attribute points while the character panel is open
+	// and switch weapons otherwise
+	if gs.showCharacter {
+		for i, attribute := range entities.AllAttributes {
+			if globals.InputSystem.IsNumberPressed(i + 1) {
+				if err := gs.player.SpendAttributePoint(attribute); err != nil {
+					log.Printf("Cannot spend attribute point: %v", err)
+				}
+			}
+		}
+	} else {
+		for i := range gs.player.Weapons {
+			if globals.InputSystem.IsNumberPressed(i + 1) {
+				gs.player.SwitchWeapon(i)
+			}
+		}
+	}
+
 	return nil
 }
 
 func (gs *WorldScene) Cleanup() error {
-	gs.enemies = nil
-	gs.bullets = nil
-	gs.obstacles = nil
+	gs.world.Clear()
 	gs.player = nil
 
 	return nil
@@ -288,68 +888,111 @@ func (gs *WorldScene) GetNextScene() string {
 	return gs.nextScene
 }
 
+// spawnBulletFromEvent queues the bullet so the world spawns it after
+// collisions have resolved for this tick
 func (gs *WorldScene) spawnBulletFromEvent(data events.BulletSpawnEvent) {
-	bullet := entities.NewBullet(
-		data.Position,
-		data.Direction,
-		data.Speed,
-		data.Lifetime,
-		data.Damage,
-	)
+	gs.world.HandleAction(entities.NewSpawnBulletAction(entities.SpawnBulletDataFromEvent(data)))
+}
 
-	gs.bullets = append(gs.bullets, bullet)
+// onEntityDespawned publishes events for entities removed from the world
+func (gs *WorldScene) onEntityDespawned(entity entities.Entity) {
+	switch e := entity.(type) {
+	case *entities.Enemy:
+		gs.onEnemyDespawned(e)
 
-	globals.Collision.RegisterCollidable(bullet)
-}
+	case *entities.Boss:
+		gs.onEnemyDespawned(&e.Enemy)
 
-func (gs *WorldScene) updateEntities(deltaTime float32) {
-	for _, enemy := range gs.enemies {
-		enemy.Update(deltaTime, gs.player)
+	case *entities.Obstacle:
+		if !e.IsDestroyed() {
+			return
+		}
+		destroyer := e.LastDamageSource
+		if destroyer == "" {
+			destroyer = "environment"
+		}
+		destroyedEvent := events.NewObstacleDestroyedEvent(e.ID, e.Position, destroyer)
+		if err := gs.eventBus.Notify(destroyedEvent); err != nil {
+			log.Printf("Error notifying obstacle destroyed: %v", err)
+		}
+		gs.world.SetNavGrid(gs.buildNavGrid())
+		gs.dropLoot(e.ID, e.LootTable, e.Position, gs.obstacleItemLevel())
 	}
+}
 
-	for _, bullet := range gs.bullets {
-		bullet.Update(deltaTime)
+// onEnemyDespawned publishes the kill, awards XP to the player and drops
+// loot for an enemy or boss. Enemies removed while still alive, such as by
+// a despawn action, were not killed and earn nothing.
+func (gs *WorldScene) onEnemyDespawned(e *entities.Enemy) {
+	if e.Health > 0 {
+		return
+	}
+	killer := e.LastDamageSource
+	if killer == "" {
+		killer = "environment"
+	}
+	killedEvent := events.NewEnemyKilledEvent(e.ID, e.Position, killer)
+	if err := gs.eventBus.Notify(killedEvent); err != nil {
+		log.Printf("Error notifying enemy killed: %v", err)
+	}
+	if killer == entities.PlayerID && gs.player != nil {
+		gs.player.GainXP(gs.player.LevelCurve().KillXP(e.XPValue, e.Level))
 	}
+	gs.dropLoot(e.ID, e.LootTable, e.Position, e.Level)
+}
 
-	for _, pickup := range gs.healthPickups {
-		pickup.Update(deltaTime)
+// obstacleItemLevel is the item level of loot from destroyed obstacles,
+// which follows the player since obstacles have no level of their own
+func (gs *WorldScene) obstacleItemLevel() int {
+	if gs.player == nil {
+		return 1
 	}
+	return gs.player.Level
+}
 
-	if gs.player.IsAlive() {
-		gs.player.Update(deltaTime, gs.obstacles, gs.camera)
+// lootSeed returns the configured loot seed, or a fresh one when unset
+func (gs *WorldScene) lootSeed() int64 {
+	if gs.config.Gameplay.LootSeed != 0 {
+		return gs.config.Gameplay.LootSeed
 	}
+	return time.Now().UnixNano()
 }
 
-func (gs *WorldScene) cleanupEntities() {
-	activeBullets := make([]*entities.Bullet, 0, len(gs.bullets))
-	for _, bullet := range gs.bullets {
-		if !bullet.IsExpired() {
-			activeBullets = append(activeBullets, bullet)
-		} else {
-			globals.Collision.UnregisterCollidable(bullet)
-		}
+// dropLoot rolls the loot table of a dead enemy or destroyed obstacle and
+// spawns its drops around origin. Dropped equipment rolls a rarity and
+// affixes at itemLevel.
+func (gs *WorldScene) dropLoot(sourceID, lootTable string, origin rl.Vector3, itemLevel int) {
+	if lootTable == "" || gs.lootTables == nil {
+		return
 	}
-	gs.bullets = activeBullets
 
-	activeEnemies := make([]*entities.Enemy, 0, len(gs.enemies))
-	for _, enemy := range gs.enemies {
-		if enemy.IsAlive() {
-			activeEnemies = append(activeEnemies, enemy)
-		} else {
-			globals.Collision.UnregisterCollidable(enemy)
-		}
+	drops, err := gs.lootTables.Roll(lootTable, gs.lootRNG)
+	if err != nil {
+		log.Printf("Failed to roll loot for %s: %v", sourceID, err)
+		return
 	}
-	gs.enemies = activeEnemies
 
-	activePickups := make([]*entities.HealthPickup, 0, len(gs.healthPickups))
-	for _, pickup := range gs.healthPickups {
-		if pickup.IsActive() {
-			activePickups = append(activePickups, pickup)
-		} else {
-			globals.Triggers.UnregisterTrigger(pickup)
+	for i, drop := range drops {
+		item, err := gs.itemGenerator.Generate(drop.ItemID, drop.Quantity, itemLevel, gs.lootRNG)
+		if err != nil {
+			log.Printf("Failed to create dropped item: %v", err)
+			continue
+		}
+
+		position := origin
+		position.Y += 0.3
+		if len(drops) > 1 {
+			angle := 2 * math.Pi * float64(i) / float64(len(drops))
+			position.X += lootScatterRadius * float32(math.Cos(angle))
+			position.Z += lootScatterRadius * float32(math.Sin(angle))
+		}
+
+		itemDrop := entities.NewItemDrop(position, item)
+		itemDrop.ID = gs.world.NextID("drop")
+		if err := gs.world.Spawn(itemDrop); err != nil {
+			log.Printf("Failed to spawn item drop: %v", err)
 		}
 	}
-	gs.healthPickups = activePickups
 }
 
 func floatToString(f float32, decimals int) string {
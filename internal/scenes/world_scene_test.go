@@ -0,0 +1,119 @@
+package scenes
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/config"
+	"arpg/pkg/entities"
+	"arpg/pkg/events"
+	"arpg/pkg/terrain"
+)
+
+// killRecorder counts the enemy killed events it is notified of
+type killRecorder struct {
+	kills int
+}
+
+func (r *killRecorder) OnNotify(event events.Event) error {
+	r.kills++
+	return nil
+}
+
+func TestDespawningLiveEnemyIsNotAKill(t *testing.T) {
+	// given
+	// ... a scene recording actions with a living enemy
+	gs := NewGameScene(config.Default())
+	gs.player = entities.NewPlayer(5.0, gs.eventBus)
+	enemy := entities.NewEnemy(rl.Vector3{X: 5}, 50, 2)
+	enemy.ID = "enemy_1"
+	enemy.XPValue = 10
+	enemy.LastDamageSource = entities.PlayerID
+	for _, entity := range []entities.Entity{gs.player, enemy} {
+		if err := gs.world.Spawn(entity); err != nil {
+			t.Fatalf("Unexpected spawn error: %v", err)
+		}
+	}
+	recorder := &killRecorder{}
+	if err := gs.eventBus.Subscribe(events.EventTypeEnemyKilled, recorder); err != nil {
+		t.Fatalf("Unexpected subscribe error: %v", err)
+	}
+	gs.world.Actions().SetRecording(true)
+	// when
+	// ... the enemy is despawned through the queue, restored by undo and
+	// ... despawned again
+	gs.world.HandleAction(entities.NewDespawnAction("enemy_1"))
+	gs.world.FlushActions()
+	if undone, err := gs.world.Undo(); !undone || err != nil {
+		t.Fatalf("Expected the despawn to be undone, got %t and %v", undone, err)
+	}
+	gs.world.HandleAction(entities.NewDespawnAction("enemy_1"))
+	gs.world.FlushActions()
+	// then
+	// ... should publish no kills and award no XP
+	// ... and leave the enemy out of the world
+	if recorder.kills != 0 {
+		t.Fatalf("Expected no kill events, got %d", recorder.kills)
+	}
+	if gs.player.XP != 0 {
+		t.Fatalf("Expected no XP, got %f", gs.player.XP)
+	}
+	if _, exists := gs.world.Get("enemy_1"); exists {
+		t.Fatal("Expected the enemy to be despawned")
+	}
+}
+
+func TestDespawningDeadEnemyIsAKill(t *testing.T) {
+	// given
+	// ... a scene with an enemy the player has killed
+	gs := NewGameScene(config.Default())
+	gs.player = entities.NewPlayer(5.0, gs.eventBus)
+	enemy := entities.NewEnemy(rl.Vector3{X: 5}, 50, 2)
+	enemy.ID = "enemy_1"
+	enemy.XPValue = 10
+	enemy.LastDamageSource = entities.PlayerID
+	for _, entity := range []entities.Entity{gs.player, enemy} {
+		if err := gs.world.Spawn(entity); err != nil {
+			t.Fatalf("Unexpected spawn error: %v", err)
+		}
+	}
+	recorder := &killRecorder{}
+	if err := gs.eventBus.Subscribe(events.EventTypeEnemyKilled, recorder); err != nil {
+		t.Fatalf("Unexpected subscribe error: %v", err)
+	}
+	enemy.TakeDamage(entities.NewDamage(enemy.MaxHealth, entities.DamagePhysical, entities.PlayerID))
+	// when
+	// ... cleanup removes it
+	gs.world.Despawn("enemy_1")
+	// then
+	// ... should publish the kill and award XP
+	if recorder.kills != 1 {
+		t.Fatalf("Expected one kill event, got %d", recorder.kills)
+	}
+	if gs.player.XP <= 0 {
+		t.Fatalf("Expected XP for the kill, got %f", gs.player.XP)
+	}
+}
+
+func TestNavGridSpansTheTerrain(t *testing.T) {
+	// given
+	// ... a scene on flat terrain 100 units wide and 60 deep
+	gs := NewGameScene(config.Default())
+	heightmap, err := terrain.New(2, 2, []float32{0, 0, 0, 0}, rl.Vector3{X: 100, Y: 4, Z: 60})
+	if err != nil {
+		t.Fatalf("Unexpected heightmap error: %v", err)
+	}
+	gs.terrain = heightmap
+	// when
+	// ... the navigation grid is built
+	grid := gs.buildNavGrid()
+	// then
+	// ... should cover the terrain rather than the default ground plane
+	if grid.OriginX != -50 || grid.OriginZ != -30 {
+		t.Fatalf("Expected grid origin (-50, -30), got (%f, %f)", grid.OriginX, grid.OriginZ)
+	}
+	if grid.Width != 200 || grid.Height != 120 {
+		t.Fatalf("Expected a 200x120 grid, got %dx%d", grid.Width, grid.Height)
+	}
+}
@@ -5,12 +5,19 @@ import (
 
 	"arpg/pkg/config"
 	"arpg/pkg/entities"
+	"arpg/pkg/terrain"
 )
 
+// kickDecay is the fraction of a camera kick that remains after each update
+const kickDecay = 0.85
+
 type Camera struct {
 	camera rl.Camera3D
 	offset rl.Vector3
+	kick   rl.Vector3 // Temporary displacement that settles back to zero
 	config *config.Config
+
+	terrain *terrain.Heightmap // Ground mouse picks land on, flat at Y = 0 when nil
 }
 
 func NewCamera(cfg *config.Config) *Camera {
@@ -37,21 +44,38 @@ func (c *Camera) Initialize(player *entities.Player) {
 func (c *Camera) Update(player *entities.Player) {
 	// Update camera position to follow the player
 	c.camera.Position = rl.Vector3{
-		X: player.Position.X + c.offset.X,
-		Y: player.Position.Y + c.offset.Y,
-		Z: player.Position.Z + c.offset.Z,
+		X: player.Position.X + c.offset.X + c.kick.X,
+		Y: player.Position.Y + c.offset.Y + c.kick.Y,
+		Z: player.Position.Z + c.offset.Z + c.kick.Z,
 	}
-	c.camera.Target = player.Position
+	c.camera.Target = rl.Vector3Add(player.Position, c.kick)
+	c.kick = rl.Vector3Scale(c.kick, kickDecay)
+}
+
+// Kick displaces the camera by impulse, settling back over a few frames
+func (c *Camera) Kick(impulse rl.Vector3) {
+	c.kick = rl.Vector3Add(c.kick, impulse)
 }
 
 func (c *Camera) GetRaylibCamera() rl.Camera3D {
 	return c.camera
 }
 
+// SetTerrain sets the ground mouse picks land on, or nil for flat ground
+func (c *Camera) SetTerrain(heightmap *terrain.Heightmap) {
+	c.terrain = heightmap
+}
+
 func (c *Camera) GetWorldPositionFromMouse(mousePos rl.Vector2) rl.Vector3 {
 	// Cast a ray from the camera through the mouse position
 	ray := rl.GetMouseRay(mousePos, c.camera)
 
+	if c.terrain != nil {
+		if point, hit := c.terrain.Raycast(ray); hit {
+			return point
+		}
+	}
+
 	// Find intersection with ground plane (Y = 0)
 	if ray.Direction.Y != 0 {
 		t := -ray.Position.Y / ray.Direction.Y
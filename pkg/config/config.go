@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"os"
 	"path/filepath"
+
+	"arpg/pkg/entities"
 )
 
 // Config holds all game configuration
@@ -42,12 +44,16 @@ type AudioConfig struct {
 
 // GameplayConfig contains gameplay-related settings
 type GameplayConfig struct {
-	PlayerSpeed   float32 `json:"player_speed"`
-	BulletSpeed   float32 `json:"bullet_speed"`
-	BulletLife    float32 `json:"bullet_lifetime"`
-	EnemySpeed    float32 `json:"enemy_speed"`
-	EnemyHealth   float32 `json:"enemy_health"`
-	BulletDamage  float32 `json:"bullet_damage"`
+	PlayerSpeed         float32 `json:"player_speed"`
+	BulletSpeed         float32 `json:"bullet_speed"`
+	BulletLife          float32 `json:"bullet_lifetime"`
+	EnemySpeed          float32 `json:"enemy_speed"`
+	EnemyHealth         float32 `json:"enemy_health"`
+	BulletDamage        float32 `json:"bullet_damage"`
+	LootSeed            int64   `json:"loot_seed"`            // Seeds loot rolls; 0 picks a new seed each run
+	HurtInvulnerability float32 `json:"hurt_invulnerability"` // Seconds the player ignores hits after taking damage
+	MoveAcceleration    float32 `json:"move_acceleration"`    // How fast the player reaches top speed, in units/s²
+	MoveDeceleration    float32 `json:"move_deceleration"`    // How fast the player stops once input is released, in units/s²
 }
 
 // DebugConfig contains debug-related settings
@@ -80,12 +86,15 @@ func Default() *Config {
 			MusicVolume:  0.7,
 		},
 		Gameplay: GameplayConfig{
-			PlayerSpeed:  5.0,
-			BulletSpeed:  15.0,
-			BulletLife:   10.0,
-			EnemySpeed:   2.0,
-			EnemyHealth:  100.0,
-			BulletDamage: 25.0,
+			PlayerSpeed:         5.0,
+			BulletSpeed:         15.0,
+			BulletLife:          10.0,
+			EnemySpeed:          2.0,
+			EnemyHealth:         100.0,
+			BulletDamage:        25.0,
+			HurtInvulnerability: entities.DefaultHurtInvulnerability,
+			MoveAcceleration:    entities.DefaultMoveAcceleration,
+			MoveDeceleration:    entities.DefaultMoveDeceleration,
 		},
 		Debug: DebugConfig{
 			ShowFPS:        true,
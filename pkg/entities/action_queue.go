@@ -0,0 +1,279 @@
+package entities
+
+import (
+	"fmt"
+	"slices"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/events"
+)
+
+// maxActionHistory is how many applied actions are kept for undo while
+// recording. The oldest are dropped beyond it.
+const maxActionHistory = 256
+
+// appliedAction pairs an applied action with the action that reverses it
+type appliedAction struct {
+	action Action
+	undo   *Action
+}
+
+// ActionQueue collects actions requested during a tick so the world can
+// apply them together at a safe point
+type ActionQueue struct {
+	pending   []Action
+	history   []appliedAction
+	recording bool
+}
+
+// NewActionQueue creates an empty action queue
+func NewActionQueue() *ActionQueue {
+	return &ActionQueue{
+		pending: make([]Action, 0),
+	}
+}
+
+// Enqueue adds an action to be applied on the next flush
+func (q *ActionQueue) Enqueue(action Action) {
+	q.pending = append(q.pending, action)
+}
+
+// Pending returns the number of actions waiting to be applied
+func (q *ActionQueue) Pending() int {
+	return len(q.pending)
+}
+
+// SetRecording turns undo history on or off. History is cleared when
+// recording stops and whenever an entity is killed, and keeps only the
+// latest maxActionHistory actions.
+func (q *ActionQueue) SetRecording(recording bool) {
+	q.recording = recording
+	if !recording {
+		q.history = nil
+	}
+}
+
+// CanUndo reports whether there is a recorded action to undo
+func (q *ActionQueue) CanUndo() bool {
+	return len(q.history) > 0
+}
+
+// record adds an applied action and its inverse to the undo history
+func (q *ActionQueue) record(action Action, undo *Action) {
+	q.history = append(q.history, appliedAction{action: action, undo: undo})
+	if excess := len(q.history) - maxActionHistory; excess > 0 {
+		q.history = slices.Delete(q.history, 0, excess)
+	}
+}
+
+// clear drops pending actions and undo history, keeping the recording mode
+func (q *ActionQueue) clear() {
+	q.pending = q.pending[:0]
+	q.history = nil
+}
+
+// HandleAction queues an action requested by an entity
+func (w *World) HandleAction(action Action) {
+	w.actions.Enqueue(action)
+}
+
+// Actions returns the world's action queue
+func (w *World) Actions() *ActionQueue {
+	return w.actions
+}
+
+// OnAction registers a callback invoked after an action is applied, for
+// recording replays or sending actions over the network
+func (w *World) OnAction(hook func(Action)) {
+	w.onAction = append(w.onAction, hook)
+}
+
+// SetEventBus sets the bus actions publish to, such as sounds to play and
+// the explosions of spawned bullets
+func (w *World) SetEventBus(bus events.Subject) {
+	w.eventBus = bus
+}
+
+// FlushActions applies every queued action in order. Actions queued while
+// flushing are applied in the same flush.
+func (w *World) FlushActions() {
+	for i := 0; i < len(w.actions.pending); i++ {
+		action := w.actions.pending[i]
+		undo, err := w.ApplyAction(action)
+		if err != nil {
+			fmt.Printf("Error applying %s action: %v\n", action.Type, err)
+			continue
+		}
+		if w.actions.recording {
+			w.actions.record(action, undo)
+		}
+		for _, hook := range w.onAction {
+			hook(action)
+		}
+	}
+	w.actions.pending = w.actions.pending[:0]
+}
+
+// Undo reverses the most recently recorded action. It returns false when
+// there is nothing to undo.
+func (w *World) Undo() (bool, error) {
+	history := w.actions.history
+	for len(history) > 0 {
+		last := history[len(history)-1]
+		history = history[:len(history)-1]
+		w.actions.history = history
+		if last.undo == nil {
+			continue // Sounds and other fire-and-forget actions have no inverse
+		}
+		if _, err := w.ApplyAction(*last.undo); err != nil {
+			return false, fmt.Errorf("failed to undo %s action: %w", last.action.Type, err)
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// ApplyAction performs an action immediately and returns the action that
+// reverses it, or nil if it cannot be reversed
+func (w *World) ApplyAction(action Action) (*Action, error) {
+	switch data := action.Data.(type) {
+	case SpawnBulletData:
+		bullet := w.newBulletFromData(data)
+		if err := w.Spawn(bullet); err != nil {
+			return nil, err
+		}
+		undo := NewDespawnAction(bullet.ID)
+		return &undo, nil
+
+	case DespawnData:
+		entity, exists := w.Get(data.EntityID)
+		if !exists {
+			return nil, fmt.Errorf("entity %s not found", data.EntityID)
+		}
+		killed := w.wasKilled(data.EntityID)
+		w.Despawn(data.EntityID)
+		if killed {
+			return nil, nil // Undo stops at a kill, see Despawn
+		}
+		return &Action{Type: ActionTypeRestore, Data: RestoreData{Entity: entity}}, nil
+
+	case ApplyDamageData:
+		entity, exists := w.Get(data.TargetID)
+		if !exists {
+			return nil, fmt.Errorf("entity %s not found", data.TargetID)
+		}
+		target, ok := entity.(Damageable)
+		if !ok {
+			return nil, fmt.Errorf("entity %s cannot take damage", data.TargetID)
+		}
+		if !target.IsAlive() {
+			return nil, nil // Another hit this tick already finished it off
+		}
+		// Undoing restores the entity as well as its health, since a lethal
+		// hit gets it cleaned up from the world
+		var undo *Action
+		if health := w.components[data.TargetID].Health; health != nil {
+			restored := health.Health
+			undo = &Action{Type: ActionTypeRestore, Data: RestoreData{Entity: entity, Health: &restored}}
+		}
+		info := NewDamage(data.Amount, data.DamageType, data.SourceID)
+		info.Critical = data.Critical
+		if transform := w.components[data.TargetID].Transform; transform != nil {
+			info.Position = transform.Position
+		}
+		target.TakeDamage(info)
+		return undo, nil
+
+	case PlaySoundData:
+		if w.eventBus != nil {
+			if err := w.eventBus.Notify(events.NewPlaySoundEvent(data.Sound, data.Position, data.Volume)); err != nil {
+				fmt.Printf("Error notifying play sound: %v\n", err)
+			}
+		}
+		return nil, nil
+
+	case SetHealthData:
+		components, exists := w.components[data.EntityID]
+		if !exists || components.Health == nil {
+			return nil, fmt.Errorf("entity %s has no health", data.EntityID)
+		}
+		undo := NewSetHealthAction(data.EntityID, components.Health.Health)
+		components.Health.Health = min(data.Health, components.Health.MaxHealth)
+		return &undo, nil
+
+	case RestoreData:
+		id := data.Entity.GetID()
+		var undo *Action
+		if _, exists := w.Get(id); !exists {
+			if err := w.Spawn(data.Entity); err != nil {
+				return nil, err
+			}
+			despawn := NewDespawnAction(id)
+			undo = &despawn
+		}
+		if data.Health != nil {
+			health := w.components[id].Health
+			if health == nil {
+				return undo, fmt.Errorf("entity %s has no health", id)
+			}
+			if undo == nil {
+				setHealth := NewSetHealthAction(id, health.Health)
+				undo = &setHealth
+			}
+			health.Health = min(*data.Health, health.MaxHealth)
+			if entity, ok := data.Entity.(revivable); ok && health.Health > 0 {
+				entity.revive()
+			}
+		}
+		return undo, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported %s action data %T", action.Type, action.Data)
+	}
+}
+
+// wasKilled reports whether the entity with the given ID has health and
+// has lost all of it
+func (w *World) wasKilled(id string) bool {
+	health := w.components[id].Health
+	return health != nil && health.Health <= 0
+}
+
+// revivable is implemented by entities that deactivate when killed, so
+// undoing the killing blow can bring them back
+type revivable interface {
+	revive()
+}
+
+// newBulletFromData builds a bullet from spawn data with a fresh ID
+func (w *World) newBulletFromData(data SpawnBulletData) *Bullet {
+	bullet := NewBullet(data.Position, data.Direction, data.Speed, data.Lifetime, data.Damage)
+	bullet.ID = w.NextID("bullet")
+	bullet.OwnerID = data.OwnerID
+	bullet.Faction = data.Faction
+	if data.DamageType != "" {
+		bullet.DamageType = data.DamageType
+	}
+	bullet.Critical = data.Critical
+	bullet.Modifiers = data.Modifiers
+	bullet.SetActionHandler(w)
+	if w.eventBus != nil {
+		bullet.SetEventBus(w.eventBus)
+	}
+	for _, spec := range data.StatusEffects {
+		bullet.StatusEffects = append(bullet.StatusEffects, StatusEffectFromSpec(spec, data.OwnerID))
+	}
+	if bullet.Faction == FactionEnemy {
+		bullet.Color = rl.Orange
+	}
+	return bullet
+}
+
+// NewActionSystem applies the actions queued during the tick, after
+// collisions have resolved and before inactive entities are cleaned up
+func NewActionSystem() System {
+	return SystemFunc(func(w *World, deltaTime float32) {
+		w.FlushActions()
+	})
+}
@@ -0,0 +1,343 @@
+package entities
+
+import (
+	"encoding/json"
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/events"
+)
+
+func TestQueuedActionsApplyAtTheActionSystem(t *testing.T) {
+	// given
+	// ... a world running the action system and a queued enemy bullet
+	world := testWorld()
+	world.AddSystem("actions", OrderActions, NewActionSystem())
+	world.HandleAction(NewSpawnBulletAction(SpawnBulletData{
+		Direction: rl.Vector3{X: 1},
+		Speed:     10,
+		Lifetime:  1,
+		Damage:    5,
+		OwnerID:   "enemy_1",
+		Faction:   FactionEnemy,
+	}))
+	// then
+	// ... should not spawn anything before the world updates
+	if world.Len() != 0 || world.Actions().Pending() != 1 {
+		t.Fatalf("Expected 1 pending action and no entities, got %d entities", world.Len())
+	}
+	// when
+	// ... the world is updated
+	world.Update(0.016)
+	// then
+	// ... should spawn the bullet and empty the queue
+	bullets := OfType[*Bullet](world)
+	if len(bullets) != 1 || world.Actions().Pending() != 0 {
+		t.Fatalf("Expected 1 bullet and an empty queue, got %d bullets", len(bullets))
+	}
+	if bullets[0].OwnerID != "enemy_1" || bullets[0].Faction != FactionEnemy {
+		t.Fatalf("Expected enemy bullet owned by enemy_1, got %+v", bullets[0])
+	}
+}
+
+func TestUndoReversesRecordedActions(t *testing.T) {
+	// given
+	// ... a recording world with an enemy and a crate
+	world := testWorld()
+	world.Actions().SetRecording(true)
+	enemy := NewEnemy(rl.Vector3{X: 5}, 100, 2)
+	enemy.ID = "enemy_1"
+	crate := NewBoxObstacle(rl.Vector3{X: 2}, rl.Vector3{X: 1, Y: 1, Z: 1}, rl.Brown)
+	crate.ID = "crate"
+	for _, entity := range []Entity{enemy, crate} {
+		if err := world.Spawn(entity); err != nil {
+			t.Fatalf("Unexpected spawn error: %v", err)
+		}
+	}
+	// when
+	// ... the enemy is hit, the crate removed and a sound played
+	world.HandleAction(NewApplyDamageAction("enemy_1", NewDamage(30, DamagePhysical, "player")))
+	world.HandleAction(NewDespawnAction("crate"))
+	world.HandleAction(NewPlaySoundAction("crate_break", crate.Position, 1))
+	world.FlushActions()
+	// then
+	// ... should apply every action
+	if enemy.Health >= 100 {
+		t.Fatalf("Expected enemy to be damaged, got health %f", enemy.Health)
+	}
+	if _, exists := world.Get("crate"); exists {
+		t.Fatal("Expected crate to be despawned")
+	}
+	// when
+	// ... the last two actions are undone
+	// ... should skip the sound and restore the crate, then restore health
+	for i := 0; i < 2; i++ {
+		undone, err := world.Undo()
+		if !undone || err != nil {
+			t.Fatalf("Expected undo %d to succeed, got %v", i, err)
+		}
+	}
+	// then
+	// ... should bring back the crate and the enemy's health
+	if _, exists := world.Get("crate"); !exists {
+		t.Fatal("Expected crate to be restored")
+	}
+	if enemy.Health != 100 {
+		t.Fatalf("Expected enemy health restored to 100, got %f", enemy.Health)
+	}
+	if world.Actions().CanUndo() {
+		t.Fatal("Expected undo history to be empty")
+	}
+}
+
+func TestActionsRoundTripThroughJSON(t *testing.T) {
+	// given
+	// ... a spawn, a hit and a sound action
+	actions := []Action{
+		NewSpawnBulletAction(SpawnBulletData{
+			Position:  rl.Vector3{X: 1, Y: 2, Z: 3},
+			Direction: rl.Vector3{Z: 1},
+			Speed:     20,
+			Damage:    12,
+			Faction:   FactionPlayer,
+			Modifiers: ProjectileModifiers{Pierce: 2},
+		}),
+		NewApplyDamageAction("enemy_1", NewDamage(8, DamageFire, "player")),
+		NewPlaySoundAction("shot", rl.Vector3{X: 4}, 0.5),
+	}
+	// when
+	// ... they are encoded and decoded
+	encoded, err := json.Marshal(actions)
+	if err != nil {
+		t.Fatalf("Unexpected encode error: %v", err)
+	}
+	var decoded []Action
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatalf("Unexpected decode error: %v", err)
+	}
+	// then
+	// ... should get back the same actions
+	if len(decoded) != len(actions) {
+		t.Fatalf("Expected %d actions, got %d", len(actions), len(decoded))
+	}
+	for i := range actions {
+		if decoded[i].Type != actions[i].Type {
+			t.Fatalf("Expected action %d to be %s, got %s", i, actions[i].Type, decoded[i].Type)
+		}
+	}
+	spawn, ok := decoded[0].Data.(SpawnBulletData)
+	if !ok || spawn.Position.Z != 3 || spawn.Modifiers.Pierce != 2 || spawn.Faction != FactionPlayer {
+		t.Fatalf("Expected spawn data to survive, got %+v", decoded[0].Data)
+	}
+	hit, ok := decoded[1].Data.(ApplyDamageData)
+	if !ok || hit.TargetID != "enemy_1" || hit.DamageType != DamageFire || hit.Amount != 8 {
+		t.Fatalf("Expected damage data to survive, got %+v", decoded[1].Data)
+	}
+}
+
+func TestRestoreActionCannotBeSerialized(t *testing.T) {
+	// given
+	// ... a restore action produced by undoing a despawn
+	action := Action{Type: ActionTypeRestore, Data: RestoreData{Entity: NewPlayer(5.0, nil)}}
+	// when
+	// ... it is encoded
+	_, err := json.Marshal(action)
+	// then
+	// ... should fail
+	if err == nil {
+		t.Fatal("Expected restore action to fail to encode")
+	}
+}
+
+func TestPlaySoundActionNotifiesEventBus(t *testing.T) {
+	// given
+	// ... a world publishing to a mock event bus and an action hook
+	world := testWorld()
+	bus := &MockEventBus{}
+	world.SetEventBus(bus)
+	var applied []Action
+	world.OnAction(func(action Action) {
+		applied = append(applied, action)
+	})
+	// when
+	// ... a sound action is flushed
+	world.HandleAction(NewPlaySoundAction("explosion", rl.Vector3{X: 2}, 0.8))
+	world.FlushActions()
+	// then
+	// ... should publish the sound and report the applied action
+	if len(bus.events) != 1 || bus.events[0].Type != events.EventTypePlaySound {
+		t.Fatalf("Expected 1 play sound event, got %+v", bus.events)
+	}
+	sound := bus.events[0].Data.(events.PlaySoundEvent)
+	if sound.Sound != "explosion" || sound.Volume != 0.8 {
+		t.Fatalf("Expected explosion sound at 0.8 volume, got %+v", sound)
+	}
+	if len(applied) != 1 {
+		t.Fatalf("Expected 1 applied action, got %d", len(applied))
+	}
+}
+
+func TestSpawnedBulletQueuesItsHits(t *testing.T) {
+	// given
+	// ... a player bullet spawned through the queue and an enemy
+	world := testWorld()
+	enemy := NewEnemy(rl.Vector3{X: 5}, 50, 2)
+	enemy.ID = "enemy_1"
+	if err := world.Spawn(enemy); err != nil {
+		t.Fatalf("Unexpected spawn error: %v", err)
+	}
+	world.HandleAction(NewSpawnBulletAction(SpawnBulletData{
+		Direction: rl.Vector3{X: 1},
+		Speed:     10,
+		Lifetime:  1,
+		Damage:    5,
+		OwnerID:   PlayerID,
+		Faction:   FactionPlayer,
+	}))
+	world.FlushActions()
+	bullet := OfType[*Bullet](world)[0]
+	// when
+	// ... the bullet touches the enemy
+	bullet.OnCollision(enemy)
+	// then
+	// ... should queue the hit rather than deal it
+	if enemy.Health != 50 || world.Actions().Pending() != 1 {
+		t.Fatalf("Expected 1 queued hit and an unharmed enemy, got health %f", enemy.Health)
+	}
+	// when
+	// ... the queue is flushed
+	world.FlushActions()
+	// then
+	// ... should damage the enemy
+	if enemy.Health != 45 {
+		t.Fatalf("Expected enemy health 45, got %f", enemy.Health)
+	}
+}
+
+func TestUndoRevivesEnemyFromLethalHit(t *testing.T) {
+	// given
+	// ... a recording world where a lethal hit has landed
+	world := testWorld()
+	world.Actions().SetRecording(true)
+	enemy := NewEnemy(rl.Vector3{X: 5}, 50, 2)
+	enemy.ID = "enemy_1"
+	if err := world.Spawn(enemy); err != nil {
+		t.Fatalf("Unexpected spawn error: %v", err)
+	}
+	world.HandleAction(NewApplyDamageAction("enemy_1", NewDamage(80, DamagePhysical, PlayerID)))
+	world.FlushActions()
+	// when
+	// ... the hit is undone before cleanup removes the enemy
+	undone, err := world.Undo()
+	// then
+	// ... should bring the enemy back to life at full health
+	if !undone || err != nil {
+		t.Fatalf("Expected the hit to be undone, got %t and %v", undone, err)
+	}
+	if enemy.Health != 50 || !enemy.IsAlive() {
+		t.Fatalf("Expected a living enemy at health 50, got %f (alive %t)", enemy.Health, enemy.IsAlive())
+	}
+}
+
+func TestUndoStopsAtAKill(t *testing.T) {
+	// given
+	// ... a recording world running the action and cleanup systems
+	world := testWorld()
+	world.AddSystem("actions", OrderActions, NewActionSystem())
+	world.AddSystem("cleanup", OrderCleanup, NewCleanupSystem())
+	world.Actions().SetRecording(true)
+	enemy := NewEnemy(rl.Vector3{X: 5}, 50, 2)
+	enemy.ID = "enemy_1"
+	if err := world.Spawn(enemy); err != nil {
+		t.Fatalf("Unexpected spawn error: %v", err)
+	}
+	// when
+	// ... a lethal hit lands and cleanup removes the enemy
+	world.HandleAction(NewApplyDamageAction("enemy_1", NewDamage(80, DamagePhysical, PlayerID)))
+	world.Update(0.016)
+	undone, err := world.Undo()
+	// then
+	// ... should leave nothing to undo, since the kill paid out its rewards
+	if undone || err != nil {
+		t.Fatalf("Expected nothing to undo after the kill, got %t and %v", undone, err)
+	}
+	if _, exists := world.Get("enemy_1"); exists {
+		t.Fatal("Expected the killed enemy to stay despawned")
+	}
+}
+
+func TestActionHistoryIsCapped(t *testing.T) {
+	// given
+	// ... a recording world
+	world := testWorld()
+	world.Actions().SetRecording(true)
+	// when
+	// ... more actions are applied than the history keeps
+	for range maxActionHistory + 10 {
+		world.HandleAction(NewPlaySoundAction("shot", rl.Vector3{}, 1))
+	}
+	world.FlushActions()
+	// then
+	// ... should keep only the latest
+	if len(world.Actions().history) != maxActionHistory {
+		t.Fatalf("Expected %d recorded actions, got %d", maxActionHistory, len(world.Actions().history))
+	}
+}
+
+func TestProjectileModifiersUseSnakeCaseJSON(t *testing.T) {
+	// given
+	// ... modifiers that pierce and home
+	modifiers := ProjectileModifiers{Pierce: 2, HomingTurnRate: 1.5}
+	// when
+	// ... they are encoded
+	encoded, err := json.Marshal(modifiers)
+	// then
+	// ... should use snake case names and leave out unset modifiers
+	if err != nil {
+		t.Fatalf("Unexpected encode error: %v", err)
+	}
+	if want := `{"pierce":2,"homing_turn_rate":1.5}`; string(encoded) != want {
+		t.Fatalf("Expected %s, got %s", want, encoded)
+	}
+}
+
+func TestUndoReversesExplosionDamage(t *testing.T) {
+	// given
+	// ... a recording world with an enemy next to an exploding player bullet
+	world := testWorld()
+	world.Actions().SetRecording(true)
+	enemy := NewEnemy(rl.Vector3{X: 1}, 50, 2)
+	enemy.ID = "enemy_1"
+	if err := world.Spawn(enemy); err != nil {
+		t.Fatalf("Unexpected spawn error: %v", err)
+	}
+	world.HandleAction(NewSpawnBulletAction(SpawnBulletData{
+		Direction: rl.Vector3{X: 1},
+		Speed:     10,
+		Lifetime:  1,
+		Damage:    10,
+		OwnerID:   PlayerID,
+		Faction:   FactionPlayer,
+		Modifiers: ProjectileModifiers{ExplosionRadius: 2},
+	}))
+	world.FlushActions()
+	bullet := OfType[*Bullet](world)[0]
+	// when
+	// ... the bullet explodes and its hits are applied
+	bullet.Update(bullet.Lifetime)
+	world.FlushActions()
+	if enemy.Health != 40 {
+		t.Fatalf("Expected the explosion to leave the enemy at 40, got %f", enemy.Health)
+	}
+	// ... and the explosion is undone
+	undone, err := world.Undo()
+	// then
+	// ... should give the enemy its health back
+	if !undone || err != nil {
+		t.Fatalf("Expected the explosion to be undone, got %t and %v", undone, err)
+	}
+	if enemy.Health != 50 {
+		t.Fatalf("Expected enemy health restored to 50, got %f", enemy.Health)
+	}
+}
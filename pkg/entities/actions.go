@@ -1,7 +1,12 @@
 package entities
 
 import (
+	"encoding/json"
+	"fmt"
+
 	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/events"
 )
 
 // ActionType represents different types of actions entities can request
@@ -9,8 +14,34 @@ type ActionType int
 
 const (
 	ActionTypeSpawnBullet ActionType = iota
+	ActionTypeDespawn
+	ActionTypeApplyDamage
+	ActionTypePlaySound
+	ActionTypeSetHealth
+	// ActionTypeRestore puts a despawned or damaged entity back into the
+	// world. It is only produced when undoing a despawn or a hit and cannot
+	// be serialized.
+	ActionTypeRestore
 )
 
+// actionTypeNames are the serialized names of each action type
+var actionTypeNames = map[ActionType]string{
+	ActionTypeSpawnBullet: "spawn_bullet",
+	ActionTypeDespawn:     "despawn",
+	ActionTypeApplyDamage: "apply_damage",
+	ActionTypePlaySound:   "play_sound",
+	ActionTypeSetHealth:   "set_health",
+	ActionTypeRestore:     "restore",
+}
+
+// String returns the serialized name of the action type
+func (t ActionType) String() string {
+	if name, ok := actionTypeNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("action_type(%d)", int(t))
+}
+
 // Action represents an action that an entity wants to perform
 type Action struct {
 	Type ActionType
@@ -19,15 +50,175 @@ type Action struct {
 
 // SpawnBulletData contains data for spawning a bullet
 type SpawnBulletData struct {
-	Position  rl.Vector3
-	Direction rl.Vector3
-	Speed     float32
-	Lifetime  float32
-	Damage    float32
+	Position      rl.Vector3                `json:"position"`
+	Direction     rl.Vector3                `json:"direction"`
+	Speed         float32                   `json:"speed"`
+	Lifetime      float32                   `json:"lifetime"`
+	Damage        float32                   `json:"damage"`
+	OwnerID       string                    `json:"owner_id,omitempty"`
+	Faction       Faction                   `json:"faction,omitempty"`
+	DamageType    DamageType                `json:"damage_type,omitempty"`
+	Critical      bool                      `json:"critical,omitempty"`
+	StatusEffects []events.StatusEffectSpec `json:"status_effects,omitempty"`
+	Modifiers     ProjectileModifiers       `json:"modifiers"`
+}
+
+// DespawnData names the entity to remove from the world
+type DespawnData struct {
+	EntityID string `json:"entity_id"`
+}
+
+// ApplyDamageData describes a hit dealt to an entity
+type ApplyDamageData struct {
+	TargetID   string     `json:"target_id"`
+	Amount     float32    `json:"amount"`
+	DamageType DamageType `json:"damage_type,omitempty"`
+	SourceID   string     `json:"source_id,omitempty"`
+	Critical   bool       `json:"critical,omitempty"`
+}
+
+// PlaySoundData describes a sound to play, optionally at a position
+type PlaySoundData struct {
+	Sound    string     `json:"sound"`
+	Position rl.Vector3 `json:"position"`
+	Volume   float32    `json:"volume"`
+}
+
+// SetHealthData sets an entity's health directly, used to undo damage
+type SetHealthData struct {
+	EntityID string  `json:"entity_id"`
+	Health   float32 `json:"health"`
+}
+
+// RestoreData holds an entity removed by a despawn or hurt by a hit so it
+// can be undone. The entity is spawned again if it has left the world.
+// Killed entities are never restored: despawning one pays out XP and loot,
+// so undo history is cleared at that point.
+type RestoreData struct {
+	Entity Entity
+	Health *float32 // Health to restore, nil to leave it unchanged
+}
+
+// NewSpawnBulletAction creates an action spawning a bullet
+func NewSpawnBulletAction(data SpawnBulletData) Action {
+	return Action{Type: ActionTypeSpawnBullet, Data: data}
+}
+
+// NewDespawnAction creates an action removing an entity from the world
+func NewDespawnAction(entityID string) Action {
+	return Action{Type: ActionTypeDespawn, Data: DespawnData{EntityID: entityID}}
 }
 
-// ActionHandler interface for handling entity actions
+// NewApplyDamageAction creates an action dealing a hit to an entity
+func NewApplyDamageAction(targetID string, info DamageInfo) Action {
+	return Action{
+		Type: ActionTypeApplyDamage,
+		Data: ApplyDamageData{
+			TargetID:   targetID,
+			Amount:     info.Amount,
+			DamageType: info.Type,
+			SourceID:   info.SourceID,
+			Critical:   info.Critical,
+		},
+	}
+}
+
+// NewPlaySoundAction creates an action playing a sound at position
+func NewPlaySoundAction(sound string, position rl.Vector3, volume float32) Action {
+	return Action{
+		Type: ActionTypePlaySound,
+		Data: PlaySoundData{Sound: sound, Position: position, Volume: volume},
+	}
+}
+
+// NewSetHealthAction creates an action setting an entity's health
+func NewSetHealthAction(entityID string, health float32) Action {
+	return Action{Type: ActionTypeSetHealth, Data: SetHealthData{EntityID: entityID, Health: health}}
+}
+
+// SpawnBulletDataFromEvent converts a bullet spawn event into action data
+func SpawnBulletDataFromEvent(event events.BulletSpawnEvent) SpawnBulletData {
+	return SpawnBulletData{
+		Position:      event.Position,
+		Direction:     event.Direction,
+		Speed:         event.Speed,
+		Lifetime:      event.Lifetime,
+		Damage:        event.Damage,
+		OwnerID:       event.OwnerID,
+		Faction:       Faction(event.Faction),
+		DamageType:    DamageType(event.DamageType),
+		Critical:      event.Critical,
+		StatusEffects: event.StatusEffects,
+		Modifiers:     event.Modifiers,
+	}
+}
+
+// actionJSON is the serialized form of an Action
+type actionJSON struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+// MarshalJSON encodes the action as its type name and data, for networking
+// and replays
+func (a Action) MarshalJSON() ([]byte, error) {
+	if a.Type == ActionTypeRestore {
+		return nil, fmt.Errorf("action %s cannot be serialized", a.Type)
+	}
+	if _, ok := actionTypeNames[a.Type]; !ok {
+		return nil, fmt.Errorf("unknown action type %d", int(a.Type))
+	}
+
+	data, err := json.Marshal(a.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s action: %w", a.Type, err)
+	}
+	return json.Marshal(actionJSON{Type: a.Type.String(), Data: data})
+}
+
+// UnmarshalJSON decodes an action written by MarshalJSON
+func (a *Action) UnmarshalJSON(b []byte) error {
+	var raw actionJSON
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	var data interface{}
+	var err error
+	switch raw.Type {
+	case ActionTypeSpawnBullet.String():
+		data, err = decodeActionData[SpawnBulletData](raw.Data)
+		a.Type = ActionTypeSpawnBullet
+	case ActionTypeDespawn.String():
+		data, err = decodeActionData[DespawnData](raw.Data)
+		a.Type = ActionTypeDespawn
+	case ActionTypeApplyDamage.String():
+		data, err = decodeActionData[ApplyDamageData](raw.Data)
+		a.Type = ActionTypeApplyDamage
+	case ActionTypePlaySound.String():
+		data, err = decodeActionData[PlaySoundData](raw.Data)
+		a.Type = ActionTypePlaySound
+	case ActionTypeSetHealth.String():
+		data, err = decodeActionData[SetHealthData](raw.Data)
+		a.Type = ActionTypeSetHealth
+	default:
+		return fmt.Errorf("unknown action type %q", raw.Type)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to decode %s action: %w", raw.Type, err)
+	}
+	a.Data = data
+	return nil
+}
+
+func decodeActionData[T any](raw json.RawMessage) (T, error) {
+	var data T
+	err := json.Unmarshal(raw, &data)
+	return data, err
+}
+
+// ActionHandler accepts actions requested by entities, such as the hits of
+// a bullet spawned into the world
 type ActionHandler interface {
 	HandleAction(action Action)
 }
-
@@ -0,0 +1,449 @@
+package entities
+
+import (
+	"fmt"
+	"math"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/events"
+	"arpg/pkg/globals"
+)
+
+// BossAttackType names an attack pattern a boss phase can use
+type BossAttackType string
+
+const (
+	BossAttackCharge BossAttackType = "charge" // Rushes along a line, hitting the player once
+	BossAttackBurst  BossAttackType = "burst"  // Fires projectiles in a ring
+	BossAttackSummon BossAttackType = "summon" // Calls adds around the boss
+)
+
+// IsKnownBossAttack reports whether attackType is a supported pattern
+func IsKnownBossAttack(attackType BossAttackType) bool {
+	switch attackType {
+	case BossAttackCharge, BossAttackBurst, BossAttackSummon:
+		return true
+	}
+	return false
+}
+
+// bossPhasePause is how long a boss holds its attacks after changing phase
+const bossPhasePause = 1.0
+
+// BossAttack describes one attack in a phase's rotation. Speed, Range,
+// Radius and Count are read according to the attack type.
+type BossAttack struct {
+	Type      BossAttackType
+	Telegraph float32 // Seconds the ground warning shows before the attack
+	Cooldown  float32 // Seconds after the attack before the next telegraph
+	Damage    float32 // 0 uses the boss's damage
+	Speed     float32 // Charge or projectile speed
+	Range     float32 // Charge distance or projectile reach
+	Radius    float32 // Charge width, burst warning radius or summon spread
+	Count     int     // Projectiles in a burst or adds summoned
+	Archetype string  // Archetype of summoned adds
+}
+
+// Validate checks the attack has the values its type needs
+func (a BossAttack) Validate() error {
+	if !IsKnownBossAttack(a.Type) {
+		return fmt.Errorf("unknown attack %q", a.Type)
+	}
+	if a.Telegraph < 0 || a.Cooldown < 0 || a.Damage < 0 {
+		return fmt.Errorf("%s telegraph, cooldown and damage cannot be negative", a.Type)
+	}
+	switch a.Type {
+	case BossAttackCharge:
+		if a.Speed <= 0 || a.Range <= 0 {
+			return fmt.Errorf("charge speed and range must be positive")
+		}
+	case BossAttackBurst:
+		if a.Speed <= 0 || a.Range <= 0 || a.Count <= 0 {
+			return fmt.Errorf("burst speed, range and count must be positive")
+		}
+	case BossAttackSummon:
+		if a.Archetype == "" || a.Count <= 0 {
+			return fmt.Errorf("summon needs an archetype and a positive count")
+		}
+	}
+	return nil
+}
+
+// BossPhase is a stage of a boss fight. A phase begins once the boss's
+// health fraction drops to its threshold and cycles through its attacks.
+type BossPhase struct {
+	Name            string
+	HealthThreshold float32
+	Attacks         []BossAttack
+}
+
+// ValidateBossPhases checks phases start at full health, have attacks and
+// are ordered by falling health threshold
+func ValidateBossPhases(phases []BossPhase) error {
+	if len(phases) == 0 {
+		return fmt.Errorf("at least one phase is required")
+	}
+	if phases[0].HealthThreshold != 1 {
+		return fmt.Errorf("first phase must start at health threshold 1, got %f", phases[0].HealthThreshold)
+	}
+	for i, phase := range phases {
+		if i > 0 && (phase.HealthThreshold >= phases[i-1].HealthThreshold || phase.HealthThreshold <= 0) {
+			return fmt.Errorf("phase %s: threshold must be in (0, %f), got %f",
+				phase.Name, phases[i-1].HealthThreshold, phase.HealthThreshold)
+		}
+		if len(phase.Attacks) == 0 {
+			return fmt.Errorf("phase %s: at least one attack is required", phase.Name)
+		}
+		for _, attack := range phase.Attacks {
+			if err := attack.Validate(); err != nil {
+				return fmt.Errorf("phase %s: %w", phase.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// BossTelegraph is the ground warning shown before a boss attack lands
+type BossTelegraph struct {
+	Attack    BossAttackType
+	Origin    rl.Vector3
+	Direction rl.Vector3   // Charge direction
+	Length    float32      // Charge distance
+	Radius    float32      // Charge width, burst radius or size of each summon mark
+	Points    []rl.Vector3 // Where adds will appear
+	Remaining float32
+	Duration  float32
+}
+
+// Progress returns how far the telegraph is toward its attack, from 0 to 1
+func (t *BossTelegraph) Progress() float32 {
+	if t.Duration <= 0 {
+		return 1
+	}
+	return 1 - t.Remaining/t.Duration
+}
+
+// bossCharge tracks a charge in progress
+type bossCharge struct {
+	direction rl.Vector3
+	speed     float32
+	remaining float32
+	damage    float32
+	hit       bool
+}
+
+// Boss is an enemy whose attacks come from health-threshold phases. Between
+// attacks it chases and strikes like a regular enemy.
+type Boss struct {
+	Enemy
+	Name   string
+	Phases []BossPhase
+
+	phase          int
+	attackIndex    int
+	attackCooldown float32
+	telegraph      *BossTelegraph
+	charge         *bossCharge
+	summoner       EnemyFactory
+	defeated       bool
+}
+
+// NewBoss wraps enemy as a boss. Bosses never leash or flee, so a fight
+// cannot reset their phase.
+func NewBoss(enemy *Enemy, name string, phases []BossPhase) *Boss {
+	boss := &Boss{
+		Enemy:  *enemy,
+		Name:   name,
+		Phases: phases,
+	}
+	boss.LeashDistance = 0
+	boss.FleeHealthPercent = 0
+	return boss
+}
+
+// SetSummoner sets the factory used to build summoned adds
+func (b *Boss) SetSummoner(factory EnemyFactory) {
+	b.summoner = factory
+}
+
+// Phase returns the index of the current phase
+func (b *Boss) Phase() int {
+	return b.phase
+}
+
+// CurrentPhase returns the current phase, or nil for a boss without phases
+func (b *Boss) CurrentPhase() *BossPhase {
+	if b.phase >= len(b.Phases) {
+		return nil
+	}
+	return &b.Phases[b.phase]
+}
+
+// Telegraph returns the warning for the attack about to land, or nil
+func (b *Boss) Telegraph() *BossTelegraph {
+	return b.telegraph
+}
+
+// IsCharging reports whether the boss is mid-charge
+func (b *Boss) IsCharging() bool {
+	return b.charge != nil
+}
+
+// IsEngaged reports whether the boss is fighting the player
+func (b *Boss) IsEngaged() bool {
+	return b.IsAlive() && (b.State == AIStateChase || b.State == AIStateAttack)
+}
+
+// Think runs the regular enemy AI between attacks and the phase's attack
+// rotation while engaged
+func (b *Boss) Think(w *World, deltaTime float32) {
+	b.navGrid = w.NavGrid()
+	player := w.Player()
+
+	if b.telegraph == nil && b.charge == nil {
+		b.Enemy.Update(deltaTime, player)
+	} else {
+		b.Velocity = rl.Vector3{}
+	}
+	if !b.IsAlive() || player == nil || !b.CanAct() {
+		return
+	}
+
+	switch {
+	case b.charge != nil:
+		b.updateCharge(w, deltaTime, player)
+	case b.telegraph != nil:
+		b.telegraph.Remaining -= deltaTime
+		if b.telegraph.Remaining <= 0 {
+			b.executeAttack(w, player)
+		}
+	default:
+		b.attackCooldown -= deltaTime
+		if b.attackCooldown <= 0 && b.IsEngaged() && player.IsAlive() {
+			b.beginTelegraph(player)
+		}
+	}
+}
+
+// currentAttack returns the next attack in the phase's rotation
+func (b *Boss) currentAttack() (BossAttack, bool) {
+	phase := b.CurrentPhase()
+	if phase == nil || len(phase.Attacks) == 0 {
+		return BossAttack{}, false
+	}
+	return phase.Attacks[b.attackIndex%len(phase.Attacks)], true
+}
+
+// beginTelegraph marks the ground where the next attack will land
+func (b *Boss) beginTelegraph(player *Player) {
+	attack, ok := b.currentAttack()
+	if !ok {
+		return
+	}
+
+	telegraph := &BossTelegraph{
+		Attack:    attack.Type,
+		Origin:    b.Position,
+		Radius:    attack.Radius,
+		Remaining: attack.Telegraph,
+		Duration:  attack.Telegraph,
+	}
+	switch attack.Type {
+	case BossAttackCharge:
+		telegraph.Direction = horizontalDirection(b.Position, player.Position)
+		telegraph.Length = attack.Range
+		telegraph.Radius = max(attack.Radius, b.Radius)
+	case BossAttackSummon:
+		for i := 0; i < attack.Count; i++ {
+			angle := 2 * math.Pi * float64(i) / float64(attack.Count)
+			telegraph.Points = append(telegraph.Points, rl.Vector3{
+				X: b.Position.X + attack.Radius*float32(math.Cos(angle)),
+				Y: b.Position.Y,
+				Z: b.Position.Z + attack.Radius*float32(math.Sin(angle)),
+			})
+		}
+		telegraph.Radius = b.Radius
+	}
+	b.telegraph = telegraph
+	b.Velocity = rl.Vector3{}
+}
+
+// executeAttack lands the telegraphed attack and moves the rotation on
+func (b *Boss) executeAttack(w *World, player *Player) {
+	attack, _ := b.currentAttack()
+	telegraph := b.telegraph
+	b.telegraph = nil
+
+	damage := attack.Damage
+	if damage <= 0 {
+		damage = b.Damage
+	}
+
+	switch attack.Type {
+	case BossAttackCharge:
+		b.charge = &bossCharge{
+			direction: telegraph.Direction,
+			speed:     attack.Speed,
+			remaining: attack.Range,
+			damage:    damage,
+		}
+	case BossAttackBurst:
+		b.fireBurst(attack, damage, player)
+	case BossAttackSummon:
+		b.summonAdds(w, attack, telegraph.Points)
+	}
+
+	b.attackIndex++
+	b.attackCooldown = attack.Cooldown
+}
+
+// updateCharge moves the boss along its charge, queueing one hit on the
+// player and stopping early at obstacles and slopes too steep to climb
+func (b *Boss) updateCharge(w *World, deltaTime float32, player *Player) {
+	step := min(b.charge.speed*deltaTime, b.charge.remaining)
+	next := rl.Vector3Add(b.Position, rl.Vector3Scale(b.charge.direction, step))
+	if w.collision != nil && len(w.collision.QuerySphere(next, b.Radius, "obstacle")) > 0 {
+		b.charge = nil
+		return
+	}
+	if w.ground != nil && !w.ground.CanClimb(b.Position, next) {
+		b.charge = nil // Slams into a slope too steep to run up
+		return
+	}
+	b.Position = next
+	b.charge.remaining -= step
+
+	if !b.charge.hit && player.IsAlive() &&
+		horizontalDistance(b.Position, player.Position) <= b.Radius+player.Radius {
+		info := b.attackDamage()
+		info.Amount = b.charge.damage
+		w.HandleAction(NewApplyDamageAction(player.GetID(), info))
+		b.charge.hit = true
+	}
+
+	if b.charge.remaining <= 0 {
+		b.charge = nil
+	}
+}
+
+// fireBurst publishes a ring of projectiles, the first aimed at the player
+func (b *Boss) fireBurst(attack BossAttack, damage float32, player *Player) {
+	if b.eventBus == nil {
+		return
+	}
+
+	aim := horizontalDirection(b.Position, player.Position)
+	start := math.Atan2(float64(aim.Z), float64(aim.X))
+	for i := 0; i < attack.Count; i++ {
+		angle := start + 2*math.Pi*float64(i)/float64(attack.Count)
+		direction := rl.Vector3{X: float32(math.Cos(angle)), Z: float32(math.Sin(angle))}
+		muzzle := rl.Vector3Add(b.Position, rl.Vector3Scale(direction, b.Radius+0.2))
+		muzzle.Y = b.Position.Y + projectileHeight
+
+		bulletEvent := events.NewBulletSpawnEventFromData(events.BulletSpawnEvent{
+			Position:   muzzle,
+			Direction:  direction,
+			Speed:      attack.Speed,
+			Lifetime:   attack.Range / attack.Speed,
+			Damage:     damage,
+			OwnerID:    b.ID,
+			Faction:    string(FactionEnemy),
+			DamageType: string(b.DamageType),
+		})
+		if err := b.eventBus.Notify(bulletEvent); err != nil {
+			fmt.Printf("Error notifying bullet spawn: %v\n", err)
+		}
+	}
+}
+
+// summonAdds spawns an add of the attack's archetype at each point
+func (b *Boss) summonAdds(w *World, attack BossAttack, points []rl.Vector3) {
+	if b.summoner == nil {
+		return
+	}
+	for _, point := range points {
+		add, err := b.summoner(attack.Archetype, point)
+		if err != nil {
+			fmt.Printf("Boss %s failed to summon: %v\n", b.ID, err)
+			continue
+		}
+		add.ID = w.NextID(b.ID + "_add")
+		if err := w.Spawn(add); err != nil {
+			fmt.Printf("Boss %s failed to summon: %v\n", b.ID, err)
+		}
+	}
+}
+
+// TakeDamage applies a hit, then moves to the next phase or reports the
+// boss defeated
+func (b *Boss) TakeDamage(info DamageInfo) DamageResult {
+	result := b.Enemy.TakeDamage(info)
+	if !b.IsAlive() {
+		b.defeat()
+		return result
+	}
+	b.updatePhase()
+	return result
+}
+
+// UpdateStatus ticks status effects so damage over time also drives phases
+func (b *Boss) UpdateStatus(deltaTime float32) {
+	updateStatus(b, &b.StatusComponent, b.eventBus, deltaTime)
+}
+
+// updatePhase enters the deepest phase whose threshold health has reached,
+// cancelling any attack in progress. Phases never go back.
+func (b *Boss) updatePhase() {
+	fraction := b.GetHealthPercent()
+	next := b.phase
+	for i := b.phase + 1; i < len(b.Phases); i++ {
+		if fraction <= b.Phases[i].HealthThreshold {
+			next = i
+		}
+	}
+	if next == b.phase {
+		return
+	}
+
+	b.phase = next
+	b.attackIndex = 0
+	b.attackCooldown = bossPhasePause
+	b.telegraph = nil
+	b.charge = nil
+	b.notify(events.NewBossPhaseChangedEvent(b.ID, b.Name, b.phase, b.Phases[b.phase].Name, b.Position))
+}
+
+// defeat reports the boss's death once
+func (b *Boss) defeat() {
+	if b.defeated {
+		return
+	}
+	b.defeated = true
+	b.telegraph = nil
+	b.charge = nil
+
+	killer := b.LastDamageSource
+	if killer == "" {
+		killer = "environment"
+	}
+	b.notify(events.NewBossDefeatedEvent(b.ID, b.Name, killer, b.Position))
+}
+
+func (b *Boss) notify(event events.Event) {
+	if b.eventBus == nil {
+		return
+	}
+	if err := b.eventBus.Notify(event); err != nil {
+		fmt.Printf("Error notifying boss event: %v\n", err)
+	}
+}
+
+// OnCollision lets the boss strike on contact like a regular enemy, except
+// while charging, where the charge deals the damage
+func (b *Boss) OnCollision(other globals.Collidable) {
+	if b.charge != nil {
+		return
+	}
+	b.Enemy.OnCollision(other)
+}
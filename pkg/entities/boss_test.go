@@ -0,0 +1,194 @@
+package entities
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/events"
+)
+
+func TestBossTelegraphsBeforeBurst(t *testing.T) {
+	// given
+	// ... a boss whose only attack is an 8 projectile burst with a 1s telegraph
+	burst := BossAttack{Type: BossAttackBurst, Telegraph: 1, Cooldown: 3, Speed: 10, Range: 10, Radius: 3, Count: 8}
+	world := testWorld()
+	bus := &MockEventBus{}
+	enemy := NewEnemy(rl.Vector3{}, 100, 2)
+	enemy.ID = "boss"
+	enemy.SetEventBus(bus)
+	boss := NewBoss(enemy, "Warden", []BossPhase{{Name: "one", HealthThreshold: 1, Attacks: []BossAttack{burst}}})
+	boss.State = AIStateChase
+	player := NewPlayer(5.0, bus)
+	player.Position = rl.Vector3{X: 5}
+	player.HurtInvulnerability = 0
+	for _, entity := range []Entity{player, boss} {
+		if err := world.Spawn(entity); err != nil {
+			t.Fatalf("Unexpected spawn error: %v", err)
+		}
+	}
+	// when
+	// ... the boss thinks once
+	boss.Think(world, 0.1)
+	// then
+	// ... should show the burst telegraph without firing
+	if boss.Telegraph() == nil || boss.Telegraph().Attack != BossAttackBurst {
+		t.Fatal("Expected a burst telegraph")
+	}
+	if len(bulletSpawns(bus)) != 0 {
+		t.Fatal("Expected no projectiles during the telegraph")
+	}
+	// when
+	// ... the telegraph runs out
+	boss.Think(world, 1)
+	// then
+	// ... should fire 8 projectiles, the first at the player
+	spawns := bulletSpawns(bus)
+	if len(spawns) != 8 || boss.Telegraph() != nil {
+		t.Fatalf("Expected 8 projectiles and no telegraph, got %d", len(spawns))
+	}
+	if spawns[0].Direction.X < 0.99 || spawns[0].Faction != string(FactionEnemy) {
+		t.Fatalf("Expected first enemy projectile aimed along +X, got %+v", spawns[0])
+	}
+}
+
+func TestBossChargeHitsPlayerOnce(t *testing.T) {
+	// given
+	// ... a boss charging 10 units at speed 20 for 30 damage
+	charge := BossAttack{Type: BossAttackCharge, Telegraph: 0.5, Cooldown: 3, Damage: 30, Speed: 20, Range: 10}
+	world := testWorld()
+	enemy := NewEnemy(rl.Vector3{}, 100, 2)
+	enemy.ID = "boss"
+	boss := NewBoss(enemy, "Warden", []BossPhase{{Name: "one", HealthThreshold: 1, Attacks: []BossAttack{charge}}})
+	boss.State = AIStateChase
+	player := NewPlayer(5.0, nil)
+	player.Position = rl.Vector3{X: 5}
+	player.HurtInvulnerability = 0
+	for _, entity := range []Entity{player, boss} {
+		if err := world.Spawn(entity); err != nil {
+			t.Fatalf("Unexpected spawn error: %v", err)
+		}
+	}
+	// when
+	// ... the telegraph plays out and the charge runs its course
+	boss.Think(world, 0.1)
+	boss.Think(world, 0.5)
+	for i := 0; i < 20 && boss.IsCharging(); i++ {
+		boss.Think(world, 0.05)
+	}
+	queued := world.Actions().Pending()
+	world.FlushActions()
+	// then
+	// ... should pass through the player, queueing one hit, and stop 10 units out
+	if queued != 1 {
+		t.Fatalf("Expected one queued hit, got %d", queued)
+	}
+	if boss.IsCharging() {
+		t.Fatal("Expected the charge to end")
+	}
+	if player.Health != player.MaxHealth-30 {
+		t.Fatalf("Expected one 30 damage hit, got health %f", player.Health)
+	}
+	if boss.Position.X < 9.9 {
+		t.Fatalf("Expected boss to charge 10 units, got x %f", boss.Position.X)
+	}
+}
+
+func TestBossSummonsAdds(t *testing.T) {
+	// given
+	// ... a boss summoning 3 grunts
+	summon := BossAttack{Type: BossAttackSummon, Telegraph: 0.5, Cooldown: 3, Radius: 2, Count: 3, Archetype: "grunt"}
+	world := testWorld()
+	enemy := NewEnemy(rl.Vector3{}, 100, 2)
+	enemy.ID = "boss"
+	boss := NewBoss(enemy, "Warden", []BossPhase{{Name: "one", HealthThreshold: 1, Attacks: []BossAttack{summon}}})
+	boss.State = AIStateChase
+	player := NewPlayer(5.0, nil)
+	player.Position = rl.Vector3{X: 5}
+	player.HurtInvulnerability = 0
+	for _, entity := range []Entity{player, boss} {
+		if err := world.Spawn(entity); err != nil {
+			t.Fatalf("Unexpected spawn error: %v", err)
+		}
+	}
+	boss.SetSummoner(testEnemyFactory)
+	// when
+	// ... the summon telegraph plays out
+	boss.Think(world, 0.1)
+	points := len(boss.Telegraph().Points)
+	boss.Think(world, 0.5)
+	// then
+	// ... should mark and spawn 3 grunts
+	adds := OfType[*Enemy](world)
+	if points != 3 || len(adds) != 3 {
+		t.Fatalf("Expected 3 marked and summoned adds, got %d and %d", points, len(adds))
+	}
+	if adds[0].Archetype != "grunt" {
+		t.Fatalf("Expected grunt adds, got %s", adds[0].Archetype)
+	}
+}
+
+func TestBossPhasesFollowHealthThresholds(t *testing.T) {
+	// given
+	// ... a boss with phases at full, half and a quarter health
+	burst := BossAttack{Type: BossAttackBurst, Telegraph: 1, Speed: 10, Range: 10, Count: 4}
+	world := testWorld()
+	bus := &MockEventBus{}
+	enemy := NewEnemy(rl.Vector3{}, 100, 2)
+	enemy.ID = "boss"
+	enemy.SetEventBus(bus)
+	boss := NewBoss(enemy, "Warden", []BossPhase{
+		{Name: "one", HealthThreshold: 1, Attacks: []BossAttack{burst}},
+		{Name: "two", HealthThreshold: 0.5, Attacks: []BossAttack{burst}},
+		{Name: "three", HealthThreshold: 0.25, Attacks: []BossAttack{burst}},
+	})
+	boss.State = AIStateChase
+	player := NewPlayer(5.0, bus)
+	player.Position = rl.Vector3{X: 5}
+	player.HurtInvulnerability = 0
+	for _, entity := range []Entity{player, boss} {
+		if err := world.Spawn(entity); err != nil {
+			t.Fatalf("Unexpected spawn error: %v", err)
+		}
+	}
+	boss.Think(world, 0.1)
+	// when
+	// ... a single hit takes the boss from full to 20% health
+	boss.TakeDamage(NewDamage(80, DamageFire, "player"))
+	// then
+	// ... should skip straight to the last phase, cancelling the telegraph
+	if boss.Phase() != 2 || boss.Telegraph() != nil {
+		t.Fatalf("Expected phase 2 without a telegraph, got phase %d", boss.Phase())
+	}
+	if countEvents(bus, events.EventTypeBossPhaseChanged) != 1 {
+		t.Fatalf("Expected 1 phase change event, got %d", countEvents(bus, events.EventTypeBossPhaseChanged))
+	}
+	// when
+	// ... the boss is killed and hit again
+	boss.TakeDamage(NewDamage(50, DamageFire, "player"))
+	boss.TakeDamage(NewDamage(50, DamageFire, "player"))
+	// then
+	// ... should report the defeat once
+	if countEvents(bus, events.EventTypeBossDefeated) != 1 {
+		t.Fatalf("Expected 1 defeated event, got %d", countEvents(bus, events.EventTypeBossDefeated))
+	}
+}
+
+func TestValidateBossPhases(t *testing.T) {
+	// given
+	// ... phases out of threshold order
+	burst := BossAttack{Type: BossAttackBurst, Speed: 10, Range: 10, Count: 4}
+	phases := []BossPhase{
+		{Name: "one", HealthThreshold: 1, Attacks: []BossAttack{burst}},
+		{Name: "two", HealthThreshold: 0.25, Attacks: []BossAttack{burst}},
+		{Name: "three", HealthThreshold: 0.5, Attacks: []BossAttack{burst}},
+	}
+	// when
+	// ... they are validated
+	err := ValidateBossPhases(phases)
+	// then
+	// ... should fail
+	if err == nil {
+		t.Fatal("Expected error for phases out of order")
+	}
+}
@@ -1,33 +1,73 @@
 package entities
 
 import (
+	"slices"
+
 	rl "github.com/gen2brain/raylib-go/raylib"
 
+	"arpg/pkg/events"
 	"arpg/pkg/globals"
 )
 
 type Bullet struct {
-	Position rl.Vector3
-	Velocity rl.Vector3
-	Lifetime float32
-	Speed    float32
-	Radius   float32
-	Damage   float32
-	Active   bool
+	ID string
+	TransformComponent
+	VelocityComponent
+	ColliderComponent
+	RenderableComponent
+	Lifetime      float32
+	Damage        float32
+	DamageType    DamageType
+	Critical      bool
+	StatusEffects []StatusEffect // Applied to whatever the bullet damages
+	OwnerID       string         // Entity that fired the bullet; never hit by it
+	Faction       Faction        // Only entities of a hostile faction take damage
+	Modifiers     ProjectileModifiers
+	Active        bool
+
+	hitIDs   []string // Entities already damaged, which the bullet never hits again
+	eventBus events.Subject
+	actions  ActionHandler
 }
 
 func NewBullet(pos, vel rl.Vector3, speed, lifetime, damage float32) *Bullet {
 	return &Bullet{
-		Position: pos,
-		Velocity: vel,
-		Lifetime: lifetime,
-		Speed:    speed,
-		Radius:   0.1,
-		Damage:   damage,
-		Active:   true,
+		TransformComponent: TransformComponent{Position: pos},
+		VelocityComponent:  VelocityComponent{Velocity: vel, Speed: speed},
+		ColliderComponent: ColliderComponent{
+			Shape:  ColliderSphere,
+			Radius: 0.1,
+		},
+		RenderableComponent: RenderableComponent{Color: rl.Yellow},
+		Lifetime:            lifetime,
+		Damage:              damage,
+		DamageType:          DamagePhysical,
+		Active:              true,
+	}
+}
+
+func (b *Bullet) Components() Components {
+	return Components{
+		Transform:  &b.TransformComponent,
+		Collider:   &b.ColliderComponent,
+		Velocity:   &b.VelocityComponent,
+		Renderable: &b.RenderableComponent,
 	}
 }
 
+// SetEventBus injects the event bus used to report explosions
+func (b *Bullet) SetEventBus(eventBus events.Subject) {
+	b.eventBus = eventBus
+}
+
+// SetActionHandler injects the handler hits are queued on. Without one the
+// bullet damages its targets directly.
+func (b *Bullet) SetActionHandler(handler ActionHandler) {
+	b.actions = handler
+}
+
+// Update counts down the bullet's lifetime and steers homing bullets. The
+// movement system moves it.
 func (b *Bullet) Update(deltaTime float32) {
 	if !b.Active {
 		return
@@ -35,12 +75,10 @@ func (b *Bullet) Update(deltaTime float32) {
 
 	b.Lifetime -= deltaTime
 	if b.Lifetime <= 0 {
-		b.Active = false
+		b.stop()
 		return
 	}
-
-	b.Position.X += b.Velocity.X * b.Speed * deltaTime
-	b.Position.Z += b.Velocity.Z * b.Speed * deltaTime
+	b.home(deltaTime)
 }
 
 func (b *Bullet) IsExpired() bool {
@@ -51,42 +89,91 @@ func (b *Bullet) Deactivate() {
 	b.Active = false
 }
 
+func (b *Bullet) GetID() string {
+	return b.ID
+}
+
 func (b *Bullet) GetBoundingBox() rl.BoundingBox {
-	return rl.BoundingBox{
-		Min: rl.Vector3{
-			X: b.Position.X - b.Radius,
-			Y: b.Position.Y - b.Radius,
-			Z: b.Position.Z - b.Radius,
-		},
-		Max: rl.Vector3{
-			X: b.Position.X + b.Radius,
-			Y: b.Position.Y + b.Radius,
-			Z: b.Position.Z + b.Radius,
-		},
-	}
+	return b.Bounds(b.Position)
 }
 
 func (b *Bullet) GetCollisionTags() []string {
 	return []string{"bullet"}
 }
 
+// OnCollision damages destructible obstacles and bounces off or stops on
+// every obstacle, and damages anything of a hostile faction before chaining,
+// piercing or stopping. Friendly entities, the bullet's owner and targets it
+// already hit are ignored, and invulnerable targets are passed through.
 func (b *Bullet) OnCollision(other globals.Collidable) {
-	tags := other.GetCollisionTags()
-
-	for _, tag := range tags {
-		switch tag {
-		case "enemy":
-			entity, ok := other.(*Enemy)
-			if !ok {
-				panic("Bullet collided with non-enemy entity")
-			}
-			entity.TakeDamage(b.Damage)
-
-			b.Deactivate()
-		case "obstacle":
-			b.Deactivate()
+	if !b.Active {
+		return
+	}
+
+	if obstacle, ok := other.(*Obstacle); ok {
+		if obstacle.IsAlive() {
+			b.dealDamage(obstacle)
+		}
+		if b.Modifiers.Bounces > 0 && obstacle.IsActive() {
+			b.Modifiers.Bounces--
+			b.bounce(obstacle)
+			return
 		}
+		b.stop()
+		return
+	}
+	if slices.Contains(other.GetCollisionTags(), "obstacle") {
+		b.stop()
+		return
+	}
+
+	if !b.canDamage(other) {
+		return
+	}
+	target := other.(Damageable)
+	b.dealDamage(target)
+	if receiver, ok := target.(StatusReceiver); ok && receiver.IsAlive() {
+		for _, effect := range b.StatusEffects {
+			effect.SourceID = b.OwnerID
+			receiver.ApplyStatusEffect(effect)
+		}
+	}
+	b.hitIDs = append(b.hitIDs, target.GetID())
+	b.afterHit()
+}
+
+// dealDamage hits target with the bullet's damage
+func (b *Bullet) dealDamage(target Damageable) {
+	b.dealDamageInfo(target, b.damageInfo())
+}
+
+// dealDamageInfo hits target, queueing the hit on the action handler when
+// the bullet has one so it lands with the rest of the tick's actions
+func (b *Bullet) dealDamageInfo(target Damageable, info DamageInfo) {
+	if b.actions != nil {
+		b.actions.HandleAction(NewApplyDamageAction(target.GetID(), info))
+		return
+	}
+	target.TakeDamage(info)
+}
+
+// damageInfo describes the hit the bullet deals
+func (b *Bullet) damageInfo() DamageInfo {
+	return DamageInfo{
+		Amount:   b.Damage,
+		Type:     b.DamageType,
+		SourceID: b.OwnerID,
+		Critical: b.Critical,
+		Position: b.Position,
+	}
+}
+
+// CanHit reports whether the bullet may damage target
+func (b *Bullet) CanHit(target Damageable) bool {
+	if b.OwnerID != "" && target.GetID() == b.OwnerID {
+		return false
 	}
+	return b.Faction.IsHostile(target.GetFaction())
 }
 
 func (b *Bullet) IsActive() bool {
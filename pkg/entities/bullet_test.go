@@ -0,0 +1,92 @@
+package entities
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+func TestPlayerBulletDamagesEnemyOnly(t *testing.T) {
+	// given
+	// ... a bullet fired by the player
+	// ... an enemy and the player in its way
+	bullet := NewBullet(rl.Vector3{}, rl.Vector3{X: 1}, 10, 1, 5)
+	bullet.ID = "bullet_1"
+	bullet.OwnerID = PlayerID
+	bullet.Faction = FactionPlayer
+	enemy := NewEnemy(rl.Vector3{}, 50.0, 2.0)
+	player := NewPlayer(5.0, nil)
+	// when
+	// ... the bullet touches the player
+	bullet.OnCollision(player)
+	// then
+	// ... should pass through the player unharmed
+	if player.Health != player.MaxHealth || !bullet.Active {
+		t.Fatal("Player bullet should ignore the player")
+	}
+	// when
+	// ... the bullet touches the enemy
+	bullet.OnCollision(enemy)
+	// then
+	// ... should damage the enemy and be spent
+	if enemy.Health != 45.0 {
+		t.Fatalf("Expected enemy health 45.0, got %f", enemy.Health)
+	}
+	if bullet.Active {
+		t.Fatal("Bullet should deactivate after a hit")
+	}
+}
+
+func TestEnemyBulletDamagesPlayerAndSparesAllies(t *testing.T) {
+	// given
+	// ... a bullet fired by an enemy
+	// ... the shooter, another enemy and the player
+	mockEventBus := &MockEventBus{}
+	shooter := NewEnemy(rl.Vector3{}, 50.0, 2.0)
+	shooter.ID = "enemy_1"
+	ally := NewEnemy(rl.Vector3{}, 50.0, 2.0)
+	ally.ID = "enemy_2"
+	player := NewPlayer(5.0, mockEventBus)
+	bullet := NewBullet(rl.Vector3{}, rl.Vector3{X: 1}, 10, 1, 5)
+	bullet.ID = "bullet_1"
+	bullet.OwnerID = shooter.ID
+	bullet.Faction = FactionEnemy
+	// when
+	// ... the bullet touches its shooter and an ally
+	bullet.OnCollision(shooter)
+	bullet.OnCollision(ally)
+	// then
+	// ... should hurt neither
+	if shooter.Health != 50.0 || ally.Health != 50.0 || !bullet.Active {
+		t.Fatal("Enemy bullet should ignore enemies")
+	}
+	// when
+	// ... the bullet touches the player
+	bullet.OnCollision(player)
+	// then
+	// ... should damage the player through the damage event path
+	if player.Health != 95.0 {
+		t.Fatalf("Expected player health 95.0, got %f", player.Health)
+	}
+	if len(mockEventBus.events) != 1 {
+		t.Fatalf("Expected 1 damage event, got %d", len(mockEventBus.events))
+	}
+}
+
+func TestUnownedBulletNeverHitsItsOwner(t *testing.T) {
+	// given
+	// ... a bullet with no faction owned by the player
+	bullet := NewBullet(rl.Vector3{}, rl.Vector3{X: 1}, 10, 1, 5)
+	bullet.ID = "bullet_1"
+	bullet.OwnerID = PlayerID
+	bullet.Faction = FactionNone
+	player := NewPlayer(5.0, nil)
+	// when
+	// ... the bullet touches its owner
+	bullet.OnCollision(player)
+	// then
+	// ... should not hurt the owner
+	if player.Health != player.MaxHealth {
+		t.Fatal("Bullet should never hit its owner")
+	}
+}
@@ -114,4 +114,3 @@ func TestEnemyPlayerFatalCollisionEmitsGameOver(t *testing.T) {
 		t.Fatalf("Expected player health 0.0, got %f", player.Health)
 	}
 }
-
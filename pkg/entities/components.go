@@ -0,0 +1,174 @@
+package entities
+
+import (
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// ComponentMask is a bit set describing which components an entity has
+type ComponentMask uint32
+
+const (
+	ComponentTransform ComponentMask = 1 << iota
+	ComponentHealth
+	ComponentCollider
+	ComponentVelocity
+	ComponentRenderable
+	ComponentAI
+	ComponentDefense
+	ComponentStatus
+	ComponentStats
+)
+
+// TransformComponent holds an entity's placement in the world
+type TransformComponent struct {
+	Position rl.Vector3
+	Rotation float32
+}
+
+// HealthComponent tracks current and maximum hit points
+type HealthComponent struct {
+	Health    float32
+	MaxHealth float32
+}
+
+// GetHealthPercent returns current health as a fraction of max health
+func (h *HealthComponent) GetHealthPercent() float32 {
+	if h.MaxHealth == 0 {
+		return 0
+	}
+	return h.Health / h.MaxHealth
+}
+
+// ColliderShape describes how a collider's bounding box is derived
+type ColliderShape int
+
+const (
+	// ColliderCylinder is an upright cylinder standing on Position
+	ColliderCylinder ColliderShape = iota
+	// ColliderSphere is a sphere centred on Position
+	ColliderSphere
+	// ColliderBox is a box centred on Position
+	ColliderBox
+)
+
+// ColliderComponent describes the physical extent of an entity
+type ColliderComponent struct {
+	Shape  ColliderShape
+	Radius float32
+	Height float32
+	Size   rl.Vector3
+}
+
+// Bounds returns the axis-aligned bounding box of the collider at pos
+func (c *ColliderComponent) Bounds(pos rl.Vector3) rl.BoundingBox {
+	switch c.Shape {
+	case ColliderCylinder:
+		return rl.BoundingBox{
+			Min: rl.Vector3{X: pos.X - c.Radius, Y: pos.Y, Z: pos.Z - c.Radius},
+			Max: rl.Vector3{X: pos.X + c.Radius, Y: pos.Y + c.Height, Z: pos.Z + c.Radius},
+		}
+	case ColliderSphere:
+		return rl.BoundingBox{
+			Min: rl.Vector3{X: pos.X - c.Radius, Y: pos.Y - c.Radius, Z: pos.Z - c.Radius},
+			Max: rl.Vector3{X: pos.X + c.Radius, Y: pos.Y + c.Radius, Z: pos.Z + c.Radius},
+		}
+	case ColliderBox:
+		return rl.BoundingBox{
+			Min: rl.Vector3{X: pos.X - c.Size.X/2, Y: pos.Y - c.Size.Y/2, Z: pos.Z - c.Size.Z/2},
+			Max: rl.Vector3{X: pos.X + c.Size.X/2, Y: pos.Y + c.Size.Y/2, Z: pos.Z + c.Size.Z/2},
+		}
+	default:
+		return rl.BoundingBox{}
+	}
+}
+
+// VelocityComponent holds a movement direction and the speed along it
+type VelocityComponent struct {
+	Velocity rl.Vector3
+	Speed    float32
+}
+
+// RenderableComponent holds how an entity should be drawn
+type RenderableComponent struct {
+	Color rl.Color
+}
+
+// AIState names a state in the enemy AI state machine
+type AIState string
+
+const (
+	AIStateIdle   AIState = "idle"
+	AIStatePatrol AIState = "patrol"
+	AIStateAlert  AIState = "alert"
+	AIStateChase  AIState = "chase"
+	AIStateAttack AIState = "attack"
+	AIStateReturn AIState = "return"
+	AIStateFlee   AIState = "flee"
+)
+
+// AIComponent holds state for entities driven by AI
+type AIComponent struct {
+	Target         *Player
+	AttackCooldown float32
+
+	State     AIState
+	StateTime float32 // Seconds spent in the current state
+
+	Home          rl.Vector3   // Where the entity idles when it has no patrol route
+	LeashOrigin   rl.Vector3   // Where the current chase began
+	Waypoints     []rl.Vector3 // Patrol route, visited in a loop
+	WaypointIndex int
+
+	AggroRadius       float32 // Distance at which the target is noticed
+	LeashDistance     float32 // Max distance from LeashOrigin before giving up
+	AttackRange       float32 // Distance at which the entity stops chasing and attacks
+	AlertDuration     float32 // Seconds spent alerted before chasing
+	FleeHealthPercent float32 // Flee when health drops to this fraction; 0 never flees
+}
+
+// Components points at the component data owned by an entity. A nil
+// pointer means the entity does not have that component.
+type Components struct {
+	Transform  *TransformComponent
+	Health     *HealthComponent
+	Collider   *ColliderComponent
+	Velocity   *VelocityComponent
+	Renderable *RenderableComponent
+	AI         *AIComponent
+	Defense    *DefenseComponent
+	Status     *StatusComponent
+	Stats      *StatsComponent
+}
+
+// Mask returns the set of components present
+func (c Components) Mask() ComponentMask {
+	var mask ComponentMask
+	if c.Transform != nil {
+		mask |= ComponentTransform
+	}
+	if c.Health != nil {
+		mask |= ComponentHealth
+	}
+	if c.Collider != nil {
+		mask |= ComponentCollider
+	}
+	if c.Velocity != nil {
+		mask |= ComponentVelocity
+	}
+	if c.Renderable != nil {
+		mask |= ComponentRenderable
+	}
+	if c.AI != nil {
+		mask |= ComponentAI
+	}
+	if c.Defense != nil {
+		mask |= ComponentDefense
+	}
+	if c.Status != nil {
+		mask |= ComponentStatus
+	}
+	if c.Stats != nil {
+		mask |= ComponentStats
+	}
+	return mask
+}
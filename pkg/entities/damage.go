@@ -0,0 +1,151 @@
+package entities
+
+import (
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/events"
+)
+
+// Faction decides which entities a projectile may hurt
+type Faction string
+
+const (
+	FactionNone   Faction = ""
+	FactionPlayer Faction = "player"
+	FactionEnemy  Faction = "enemy"
+)
+
+// IsHostile reports whether entities of the two factions may damage each
+// other. Entities without a faction are hostile to everyone.
+func (f Faction) IsHostile(other Faction) bool {
+	return f == FactionNone || other == FactionNone || f != other
+}
+
+// DamageType selects which defence mitigates a hit
+type DamageType string
+
+const (
+	DamagePhysical DamageType = "physical"
+	DamageFire     DamageType = "fire"
+	DamageCold     DamageType = "cold"
+	DamagePoison   DamageType = "poison"
+)
+
+// IsKnownDamageType reports whether damageType names a supported damage type
+func IsKnownDamageType(damageType DamageType) bool {
+	switch damageType {
+	case DamagePhysical, DamageFire, DamageCold, DamagePoison:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defence tuning
+const (
+	// ArmorScale is the armor value that halves physical damage
+	ArmorScale = 100.0
+	// MaxResistance caps how much of a damage type a resistance can remove
+	MaxResistance = 0.75
+	// MinResistance is the most a negative resistance can amplify damage
+	MinResistance = -1.0
+)
+
+// DamageInfo describes a single hit before defences are applied
+type DamageInfo struct {
+	Amount   float32
+	Type     DamageType
+	SourceID string // ID of the entity that dealt the hit
+	Critical bool
+	Position rl.Vector3 // Where the hit landed
+	Periodic bool       // Damage over time tick rather than a hit
+}
+
+// NewDamage creates a hit of the given type from sourceID
+func NewDamage(amount float32, damageType DamageType, sourceID string) DamageInfo {
+	return DamageInfo{
+		Amount:   amount,
+		Type:     damageType,
+		SourceID: sourceID,
+	}
+}
+
+// DamageResult is the outcome of a hit after defences
+type DamageResult struct {
+	Raw       float32 // Amount before defences
+	Mitigated float32 // Amount removed by armor or resistance
+	Dealt     float32 // Health actually lost
+	Killed    bool
+}
+
+// Breakdown converts the result of info into its event representation
+func (r DamageResult) Breakdown(info DamageInfo) events.DamageBreakdown {
+	damageType := info.Type
+	if damageType == "" {
+		damageType = DamagePhysical
+	}
+	return events.DamageBreakdown{
+		RawDamage:  r.Raw,
+		Mitigated:  r.Mitigated,
+		DamageType: string(damageType),
+		Critical:   info.Critical,
+	}
+}
+
+// DefenseComponent holds armor and per-type resistances. Armor reduces
+// physical damage; resistances are fractions removed from the other types.
+type DefenseComponent struct {
+	Armor         float32
+	Resistances   map[DamageType]float32
+	AllResistance float32 // Added to every elemental resistance
+}
+
+// Mitigate returns the damage remaining after armor and resistance
+func (d *DefenseComponent) Mitigate(info DamageInfo) float32 {
+	amount := max(info.Amount, 0)
+	if d == nil {
+		return amount
+	}
+
+	if info.Type == DamagePhysical || info.Type == "" {
+		if d.Armor > 0 {
+			amount *= ArmorScale / (ArmorScale + d.Armor)
+		}
+		return amount
+	}
+
+	resistance := d.Resistances[info.Type] + d.AllResistance
+	resistance = min(max(resistance, MinResistance), MaxResistance)
+	return amount * (1 - resistance)
+}
+
+// Damageable is implemented by entities that can take damage
+type Damageable interface {
+	Identifiable
+	GetFaction() Faction
+	TakeDamage(info DamageInfo) DamageResult
+	IsAlive() bool
+}
+
+// Invulnerable is implemented by entities that can briefly ignore hits
+type Invulnerable interface {
+	IsInvulnerable() bool
+}
+
+// applyDamage runs a hit through defence and removes the result from health
+func applyDamage(health *HealthComponent, defense *DefenseComponent, info DamageInfo) DamageResult {
+	result := DamageResult{Raw: info.Amount}
+
+	amount := defense.Mitigate(info)
+	result.Mitigated = info.Amount - amount
+
+	wasAlive := health.Health > 0
+	result.Dealt = min(amount, max(health.Health, 0))
+	health.Health -= amount
+	if health.Health < 0 {
+		health.Health = 0
+	}
+	result.Killed = wasAlive && health.Health <= 0
+
+	return result
+}
@@ -0,0 +1,97 @@
+package entities
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/events"
+)
+
+func TestArmorReducesOnlyPhysicalDamage(t *testing.T) {
+	// given
+	// ... an enemy with armor equal to the armor scale
+	enemy := NewEnemy(rl.Vector3{}, 100.0, 2.0)
+	enemy.Armor = ArmorScale
+	// when
+	// ... it takes physical and fire hits of 20
+	physical := enemy.TakeDamage(NewDamage(20.0, DamagePhysical, PlayerID))
+	fire := enemy.TakeDamage(NewDamage(20.0, DamageFire, PlayerID))
+	// then
+	// ... armor should halve the physical hit and ignore the fire hit
+	if physical.Dealt != 10.0 || physical.Mitigated != 10.0 {
+		t.Fatalf("Expected 10 dealt and 10 mitigated, got %+v", physical)
+	}
+	if fire.Dealt != 20.0 {
+		t.Fatalf("Expected 20 fire damage, got %f", fire.Dealt)
+	}
+	if enemy.Health != 70.0 {
+		t.Fatalf("Expected health 70.0, got %f", enemy.Health)
+	}
+}
+
+func TestResistancesAreCappedAndCanAmplify(t *testing.T) {
+	// given
+	// ... a defence with an over-cap fire resistance and a cold weakness
+	defense := &DefenseComponent{
+		Resistances: map[DamageType]float32{
+			DamageFire: 0.9,
+			DamageCold: -0.5,
+		},
+	}
+	// when
+	// ... hits of 100 are mitigated
+	fire := defense.Mitigate(NewDamage(100.0, DamageFire, ""))
+	cold := defense.Mitigate(NewDamage(100.0, DamageCold, ""))
+	poison := defense.Mitigate(NewDamage(100.0, DamagePoison, ""))
+	// then
+	// ... fire should be capped, cold amplified and poison untouched
+	if fire != 100.0*(1-MaxResistance) {
+		t.Fatalf("Expected capped fire damage %f, got %f", 100.0*(1-MaxResistance), fire)
+	}
+	if cold != 150.0 {
+		t.Fatalf("Expected amplified cold damage 150.0, got %f", cold)
+	}
+	if poison != 100.0 {
+		t.Fatalf("Expected unmitigated poison damage 100.0, got %f", poison)
+	}
+}
+
+func TestEnemyDamageEmitsBreakdownAndRecordsKiller(t *testing.T) {
+	// given
+	// ... an armored enemy with a mock event bus
+	mockEventBus := &MockEventBus{}
+	enemy := NewEnemy(rl.Vector3{}, 50.0, 2.0)
+	enemy.ID = "enemy_1"
+	enemy.SetEventBus(mockEventBus)
+	enemy.Armor = ArmorScale
+	// when
+	// ... the player lands a fatal critical hit
+	info := NewDamage(200.0, DamagePhysical, PlayerID)
+	info.Critical = true
+	result := enemy.TakeDamage(info)
+	// then
+	// ... should report the full breakdown
+	// ... and remember who dealt the killing blow
+	if !result.Killed || enemy.IsAlive() {
+		t.Fatal("Expected the hit to kill the enemy")
+	}
+	if result.Dealt != 50.0 {
+		t.Fatalf("Expected 50 health lost, got %f", result.Dealt)
+	}
+	if len(mockEventBus.events) != 1 || mockEventBus.events[0].Type != events.EventTypeEnemyDamaged {
+		t.Fatal("Expected one enemy damaged event")
+	}
+	damageData := mockEventBus.events[0].Data.(events.EnemyDamagedEvent)
+	if damageData.Source != PlayerID || damageData.NewHealth != 0 {
+		t.Fatalf("Unexpected damage data: %+v", damageData)
+	}
+	breakdown := damageData.Breakdown
+	if breakdown.RawDamage != 200.0 || breakdown.Mitigated != 100.0 ||
+		breakdown.DamageType != "physical" || !breakdown.Critical {
+		t.Fatalf("Unexpected damage breakdown: %+v", breakdown)
+	}
+	if enemy.LastDamageSource != PlayerID {
+		t.Fatalf("Expected last damage source %s, got %s", PlayerID, enemy.LastDamageSource)
+	}
+}
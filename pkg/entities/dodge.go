@@ -0,0 +1,121 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/events"
+	"arpg/pkg/globals"
+)
+
+// Default dodge tuning
+const (
+	DefaultDodgeDistance = 4.0
+	DefaultDodgeDuration = 0.3
+	DefaultDodgeCooldown = 1.0
+)
+
+var (
+	ErrDodgeOnCooldown = errors.New("dodge is on cooldown")
+	ErrCannotDodge     = errors.New("cannot dodge")
+)
+
+// DodgeComponent lets an entity roll a fixed distance in a committed
+// direction, ignoring hits while it rolls
+type DodgeComponent struct {
+	DodgeDistance float32
+	DodgeDuration float32
+	DodgeCooldown float32
+
+	dodgeDirection rl.Vector3
+	dodgeRemaining float32
+	dodgeCooldown  float32
+}
+
+// NewDodgeComponent creates a dodge with the default tuning
+func NewDodgeComponent() DodgeComponent {
+	return DodgeComponent{
+		DodgeDistance: DefaultDodgeDistance,
+		DodgeDuration: DefaultDodgeDuration,
+		DodgeCooldown: DefaultDodgeCooldown,
+	}
+}
+
+// IsDodging reports whether a dodge is in progress
+func (d *DodgeComponent) IsDodging() bool {
+	return d.dodgeRemaining > 0
+}
+
+// DodgeCooldownRemaining returns the seconds until the next dodge
+func (d *DodgeComponent) DodgeCooldownRemaining() float32 {
+	return d.dodgeCooldown
+}
+
+// Dodge rolls the player along direction, or the way it faces when
+// direction is zero. The roll cancels any cast and cannot be steered.
+func (p *Player) Dodge(direction rl.Vector3) error {
+	if !p.CanAct() || p.IsDodging() {
+		return ErrCannotDodge
+	}
+	if p.dodgeCooldown > 0 {
+		return ErrDodgeOnCooldown
+	}
+
+	direction.Y = 0
+	if rl.Vector3Length(direction) == 0 {
+		direction = p.GetDirection()
+	}
+	p.dodgeDirection = rl.Vector3Normalize(direction)
+	p.dodgeRemaining = p.DodgeDuration
+	p.dodgeCooldown = p.DodgeCooldown
+	p.CancelCast()
+
+	p.notifyDodge(events.NewDodgeStartedEvent(p.ID, p.Position, p.dodgeDirection, p.DodgeDuration))
+	return nil
+}
+
+// updateDodge counts down the cooldown and moves a dodge in progress. Each
+// step goes through the collision resolver, sliding along whatever blocks
+// the roll.
+func (p *Player) updateDodge(deltaTime float32) {
+	p.dodgeCooldown = max(p.dodgeCooldown-deltaTime, 0)
+	if !p.IsDodging() {
+		return
+	}
+
+	stepTime := min(deltaTime, p.dodgeRemaining)
+	p.dodgeRemaining -= stepTime
+	step := rl.Vector3Scale(p.dodgeDirection, p.DodgeDistance/p.DodgeDuration*stepTime)
+
+	newPos := rl.Vector3Add(p.Position, step)
+	if globals.Collision.CheckMovement(p, newPos) {
+		p.Position = newPos
+	} else {
+		p.slide(rl.Vector3{X: step.X})
+		p.slide(rl.Vector3{Z: step.Z})
+	}
+
+	if !p.IsDodging() {
+		p.notifyDodge(events.NewDodgeEndedEvent(p.ID, p.Position, p.dodgeDirection))
+	}
+}
+
+// slide moves the player by offset if the collision resolver allows it
+func (p *Player) slide(offset rl.Vector3) {
+	newPos := rl.Vector3Add(p.Position, offset)
+	if globals.Collision.CheckMovement(p, newPos) {
+		p.Position = newPos
+	}
+}
+
+// notifyDodge reports a dodge starting or ending on the event bus
+func (p *Player) notifyDodge(event events.Event) {
+	if p.eventBus == nil {
+		return
+	}
+	if err := p.eventBus.Notify(event); err != nil {
+		fmt.Printf("Error notifying dodge: %v\n", err)
+	}
+}
@@ -0,0 +1,145 @@
+package entities
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/events"
+)
+
+func TestDodgeMovesFixedDistance(t *testing.T) {
+	// given
+	// ... a player in an empty world
+	world := testWorld()
+	bus := &MockEventBus{}
+	player := NewPlayer(5.0, bus)
+	if err := world.Spawn(player); err != nil {
+		t.Fatalf("Unexpected spawn error: %v", err)
+	}
+	// when
+	// ... the player dodges along +X and the roll plays out in small frames
+	if err := player.Dodge(rl.Vector3{X: 1}); err != nil {
+		t.Fatalf("Expected dodge to succeed, got %v", err)
+	}
+	for range 20 {
+		player.updateDodge(0.05)
+	}
+	// then
+	// ... should travel the dodge distance and stop
+	// ... and report the start and end of the roll
+	if math.Abs(float64(player.Position.X-DefaultDodgeDistance)) > 0.001 {
+		t.Fatalf("Expected player at x %f, got %f", DefaultDodgeDistance, player.Position.X)
+	}
+	if player.IsDodging() {
+		t.Fatal("Expected dodge to have finished")
+	}
+	var started, ended int
+	for _, event := range bus.events {
+		switch event.Type {
+		case events.EventTypeDodgeStarted:
+			started++
+		case events.EventTypeDodgeEnded:
+			ended++
+		}
+	}
+	if started != 1 || ended != 1 {
+		t.Fatalf("Expected one start and one end event, got %d and %d", started, ended)
+	}
+}
+
+func TestDodgeIgnoresDamage(t *testing.T) {
+	// given
+	// ... a dodging player
+	testWorld()
+	player := NewPlayer(5.0, &MockEventBus{})
+	if err := player.Dodge(rl.Vector3{Z: 1}); err != nil {
+		t.Fatalf("Expected dodge to succeed, got %v", err)
+	}
+	// when
+	// ... the player is hit during and after the roll
+	during := player.TakeDamage(NewDamage(10, DamagePhysical, "enemy_1"))
+	player.updateDodge(DefaultDodgeDuration)
+	after := player.TakeDamage(NewDamage(10, DamagePhysical, "enemy_1"))
+	// then
+	// ... should ignore the first hit and take the second
+	if during.Dealt != 0 {
+		t.Fatalf("Expected no damage while dodging, got %f", during.Dealt)
+	}
+	if after.Dealt != 10 || player.Health != 90 {
+		t.Fatalf("Expected 10 damage after dodging, got %f (health %f)", after.Dealt, player.Health)
+	}
+}
+
+func TestDodgeHasCooldown(t *testing.T) {
+	// given
+	// ... a player that has just finished a dodge
+	testWorld()
+	player := NewPlayer(5.0, &MockEventBus{})
+	if err := player.Dodge(rl.Vector3{X: 1}); err != nil {
+		t.Fatalf("Expected dodge to succeed, got %v", err)
+	}
+	player.updateDodge(DefaultDodgeDuration)
+	// when
+	// ... the player dodges again before and after the cooldown
+	early := player.Dodge(rl.Vector3{X: 1})
+	player.updateDodge(DefaultDodgeCooldown)
+	late := player.Dodge(rl.Vector3{X: 1})
+	// then
+	// ... should refuse the early dodge and allow the late one
+	if !errors.Is(early, ErrDodgeOnCooldown) {
+		t.Fatalf("Expected cooldown error, got %v", early)
+	}
+	if late != nil {
+		t.Fatalf("Expected dodge after cooldown, got %v", late)
+	}
+}
+
+func TestDodgeIsStoppedByObstacles(t *testing.T) {
+	// given
+	// ... a player facing a wall 2 units away
+	world := testWorld()
+	player := NewPlayer(5.0, &MockEventBus{})
+	wall := NewBoxObstacle(rl.Vector3{X: 2}, rl.Vector3{X: 1, Y: 2, Z: 4}, rl.Gray)
+	wall.ID = "wall"
+	for _, entity := range []Entity{player, wall} {
+		if err := world.Spawn(entity); err != nil {
+			t.Fatalf("Unexpected spawn error: %v", err)
+		}
+	}
+	// when
+	// ... the player dodges into the wall
+	if err := player.Dodge(rl.Vector3{X: 1}); err != nil {
+		t.Fatalf("Expected dodge to succeed, got %v", err)
+	}
+	for range 20 {
+		player.updateDodge(0.05)
+	}
+	// then
+	// ... should stop in front of the wall
+	if player.Position.X >= 1.5 {
+		t.Fatalf("Expected player to stop before the wall, got x %f", player.Position.X)
+	}
+}
+
+func TestBulletsPassThroughDodgingPlayer(t *testing.T) {
+	// given
+	// ... an enemy bullet overlapping a dodging player
+	testWorld()
+	player := NewPlayer(5.0, &MockEventBus{})
+	bullet := NewBullet(rl.Vector3{}, rl.Vector3{X: 1}, 10, 1, 20)
+	bullet.Faction = FactionEnemy
+	if err := player.Dodge(rl.Vector3{X: 1}); err != nil {
+		t.Fatalf("Expected dodge to succeed, got %v", err)
+	}
+	// when
+	// ... the bullet collides with the player
+	bullet.OnCollision(player)
+	// then
+	// ... should leave the player unharmed and keep flying
+	if player.Health != player.MaxHealth || !bullet.Active {
+		t.Fatalf("Expected bullet to pass through, got health %f active %v", player.Health, bullet.Active)
+	}
+}
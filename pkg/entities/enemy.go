@@ -1,98 +1,260 @@
 package entities
 
 import (
-	"math"
+	"fmt"
 
 	rl "github.com/gen2brain/raylib-go/raylib"
 
+	"arpg/pkg/events"
 	"arpg/pkg/globals"
+	"arpg/pkg/navigation"
 )
 
 type Enemy struct {
-	Position       rl.Vector3
-	Health         float32
-	MaxHealth      float32
-	Radius         float32
-	Height         float32
-	Speed          float32
-	Active         bool
-	Target         *Player
-	AttackCooldown float32
+	ID string
+	TransformComponent
+	VelocityComponent
+	ColliderComponent
+	HealthComponent
+	DefenseComponent
+	StatusComponent
+	RenderableComponent
+	AIComponent
+	StatsComponent
+	Archetype        string
+	Behavior         string
+	Damage           float32
+	DamageType       DamageType
+	AttackInterval   float32 // Seconds between attacks
+	ProjectileSpeed  float32 // Used by ranged enemies
+	XPValue          float32
+	Level            int
+	LootTable        string         // Rolled for drops when the enemy dies
+	LastDamageSource string         // ID of the entity that last hurt this enemy
+	OnHitEffects     []StatusEffect // Applied to the player by this enemy's attacks
+	Active           bool
+	eventBus         events.Subject // Injected dependency for events
+	navGrid          *navigation.Grid
+	pathFollower     *navigation.PathFollower
+}
+
+// Enemy behaviours selectable from archetype data
+const (
+	BehaviorChase      = "chase"
+	BehaviorStationary = "stationary"
+	BehaviorRanged     = "ranged"
+)
+
+// IsKnownBehavior reports whether behavior names a supported enemy behaviour
+func IsKnownBehavior(behavior string) bool {
+	switch behavior {
+	case BehaviorChase, BehaviorStationary, BehaviorRanged:
+		return true
+	default:
+		return false
+	}
 }
 
 func NewEnemy(pos rl.Vector3, health, speed float32) *Enemy {
-	return &Enemy{
-		Position:  pos,
-		Health:    health,
-		MaxHealth: health,
-		Radius:    0.6,
-		Height:    1.5,
-		Speed:     speed,
-		Active:    true,
+	enemy := &Enemy{
+		TransformComponent: TransformComponent{Position: pos},
+		VelocityComponent:  VelocityComponent{Speed: speed},
+		ColliderComponent: ColliderComponent{
+			Shape:  ColliderCylinder,
+			Radius: 0.6,
+			Height: 1.5,
+		},
+		HealthComponent:     HealthComponent{Health: health, MaxHealth: health},
+		RenderableComponent: RenderableComponent{Color: rl.Red},
+		AIComponent: AIComponent{
+			State:         AIStateIdle,
+			Home:          pos,
+			LeashOrigin:   pos,
+			AggroRadius:   DefaultAggroRadius,
+			LeashDistance: DefaultLeashDistance,
+			AttackRange:   DefaultAttackRange,
+			AlertDuration: DefaultAlertDuration,
+		},
+		Behavior:       BehaviorChase,
+		Damage:         10.0,
+		DamageType:     DamagePhysical,
+		AttackInterval: 1.0,
+		Level:          1,
+		Active:         true,
+		StatsComponent: StatsComponent{Stats: NewStatSheet()},
+		pathFollower:   navigation.NewPathFollower(),
 	}
+	enemy.Stats.SetBase(StatMaxHealth, health)
+	enemy.Stats.SetBase(StatMoveSpeed, speed)
+	enemy.Stats.SetBase(StatDamage, enemy.Damage)
+	return enemy
+}
+
+func (e *Enemy) Components() Components {
+	return Components{
+		Transform:  &e.TransformComponent,
+		Health:     &e.HealthComponent,
+		Collider:   &e.ColliderComponent,
+		Velocity:   &e.VelocityComponent,
+		Renderable: &e.RenderableComponent,
+		AI:         &e.AIComponent,
+		Defense:    &e.DefenseComponent,
+		Status:     &e.StatusComponent,
+		Stats:      &e.StatsComponent,
+	}
+}
+
+// SetEventBus injects the event bus used to publish AI state changes
+func (e *Enemy) SetEventBus(eventBus events.Subject) {
+	e.eventBus = eventBus
+}
+
+// Think runs the enemy's AI against the world's player, pathing around
+// obstacles with the world's navigation grid
+func (e *Enemy) Think(w *World, deltaTime float32) {
+	e.navGrid = w.NavGrid()
+	e.Update(deltaTime, w.Player())
+}
+
+// CurrentPath returns the waypoints the enemy is currently following
+func (e *Enemy) CurrentPath() []rl.Vector3 {
+	return e.pathFollower.Path()
 }
 
+// Update ticks the attack cooldown and runs the AI state machine, which sets
+// the enemy's velocity. The movement system applies the velocity.
 func (e *Enemy) Update(deltaTime float32, player *Player) {
+	e.Velocity = rl.Vector3{}
 	if !e.Active || player == nil {
 		return
 	}
+	e.Target = player
+
+	if !e.CanAct() {
+		return // Stunned
+	}
 
 	if e.AttackCooldown > 0 {
 		e.AttackCooldown -= deltaTime
 	}
 
-	deltaX := player.Position.X - e.Position.X
-	deltaZ := player.Position.Z - e.Position.Z
-
-	distance := float32(math.Sqrt(float64(deltaX*deltaX + deltaZ*deltaZ)))
-
-	if distance > 1.0 {
-		dirX := deltaX / distance
-		dirZ := deltaZ / distance
+	e.updateAI(deltaTime, player)
 
-		e.Position.X += dirX * e.Speed * deltaTime
-		e.Position.Z += dirZ * e.Speed * deltaTime
+	if e.Behavior == BehaviorStationary {
+		e.Velocity = rl.Vector3{}
 	}
+	e.Velocity = rl.Vector3Scale(e.Velocity, e.SpeedMultiplier())
+}
+
+func (e *Enemy) GetID() string {
+	return e.ID
 }
 
 func (e *Enemy) GetBoundingBox() rl.BoundingBox {
-	return rl.BoundingBox{
-		Min: rl.Vector3{
-			X: e.Position.X - e.Radius,
-			Y: e.Position.Y,
-			Z: e.Position.Z - e.Radius,
-		},
-		Max: rl.Vector3{
-			X: e.Position.X + e.Radius,
-			Y: e.Position.Y + e.Height,
-			Z: e.Position.Z + e.Radius,
-		},
-	}
+	return e.Bounds(e.Position)
 }
 
-func (e *Enemy) TakeDamage(damage float32) {
-	e.Health -= damage
+// TakeDamage applies a hit after armor and resistances and reports it on
+// the event bus
+func (e *Enemy) TakeDamage(info DamageInfo) DamageResult {
+	result := applyDamage(&e.HealthComponent, &e.DefenseComponent, info)
+	if info.SourceID != "" {
+		e.LastDamageSource = info.SourceID
+	}
 	if e.Health <= 0 {
-		e.Health = 0
 		e.Active = false
 	}
+
+	if e.eventBus != nil {
+		damageEvent := events.NewEnemyDamagedEvent(
+			e.ID,
+			result.Dealt,
+			info.SourceID,
+			e.Health,
+			e.Position,
+			result.Breakdown(info),
+		)
+		if err := e.eventBus.Notify(damageEvent); err != nil {
+			fmt.Printf("Error notifying enemy damage: %v\n", err)
+		}
+	}
+
+	return result
+}
+
+// attackDamage describes a melee or ranged hit from this enemy
+func (e *Enemy) attackDamage() DamageInfo {
+	return DamageInfo{
+		Amount:   e.Damage,
+		Type:     e.DamageType,
+		SourceID: e.ID,
+		Position: e.Position,
+	}
+}
+
+// ApplyStatusEffect applies a timed effect and reports it on the event bus
+func (e *Enemy) ApplyStatusEffect(effect StatusEffect) {
+	applyStatusEffect(e, &e.StatusComponent, e.eventBus, effect)
+}
+
+// UpdateStatus ticks the enemy's status effects
+func (e *Enemy) UpdateStatus(deltaTime float32) {
+	updateStatus(e, &e.StatusComponent, e.eventBus, deltaTime)
+}
+
+// SetBaseStat sets the unmodified value of stat and refreshes derived fields
+func (e *Enemy) SetBaseStat(stat StatType, value float32) {
+	e.Stats.SetBase(stat, value)
+	e.syncStats()
+}
+
+// AddModifier layers a stat modifier onto the enemy
+func (e *Enemy) AddModifier(modifier Modifier) {
+	e.Stats.AddModifier(modifier)
+	e.syncStats()
+}
+
+// RemoveModifierSource removes every modifier from source
+func (e *Enemy) RemoveModifierSource(source string) {
+	if e.Stats.RemoveSource(source) {
+		e.syncStats()
+	}
+}
+
+// UpdateStats expires timed modifiers
+func (e *Enemy) UpdateStats(deltaTime float32) {
+	if e.Stats.Tick(deltaTime) {
+		e.syncStats()
+	}
+}
+
+// syncStats copies final stat values into the fields gameplay code reads
+func (e *Enemy) syncStats() {
+	e.MaxHealth = e.Stats.Get(StatMaxHealth)
+	e.Health = min(e.Health, e.MaxHealth)
+	e.Speed = e.Stats.Get(StatMoveSpeed)
+	e.Damage = e.Stats.Get(StatDamage)
+	e.Armor = e.Stats.Get(StatArmor)
+	e.AllResistance = e.Stats.Get(StatAllResistance)
+}
+
+func (e *Enemy) GetFaction() Faction {
+	return FactionEnemy
 }
 
 func (e *Enemy) IsAlive() bool {
 	return e.Active && e.Health > 0
 }
 
-func (e *Enemy) GetHealthPercent() float32 {
-	if e.MaxHealth == 0 {
-		return 0
-	}
-	return e.Health / e.MaxHealth
+// revive reactivates an enemy whose killing blow was undone
+func (e *Enemy) revive() {
+	e.Active = true
 }
 
 func (e *Enemy) GetHeadPosition() rl.Vector3 {
 	return rl.Vector3{
 		X: e.Position.X,
-		Y: e.Position.Y + 1.0,
+		Y: e.Position.Y + e.Height - 0.5,
 		Z: e.Position.Z,
 	}
 }
@@ -100,7 +262,7 @@ func (e *Enemy) GetHeadPosition() rl.Vector3 {
 func (e *Enemy) GetHealthBarPosition() rl.Vector3 {
 	return rl.Vector3{
 		X: e.Position.X,
-		Y: e.Position.Y + 2.0,
+		Y: e.Position.Y + e.Height + 0.5,
 		Z: e.Position.Z,
 	}
 }
@@ -114,10 +276,14 @@ func (e *Enemy) OnCollision(other globals.Collidable) {
 	for _, tag := range tags {
 		switch tag {
 		case "player":
-			if e.AttackCooldown <= 0 {
+			if e.AttackCooldown <= 0 && e.CanAct() {
 				p := other.(*Player)
-				p.TakeDamage(10.0)
-				e.AttackCooldown = 1.0
+				p.TakeDamage(e.attackDamage())
+				for _, effect := range e.OnHitEffects {
+					effect.SourceID = e.ID
+					p.ApplyStatusEffect(effect)
+				}
+				e.AttackCooldown = e.AttackInterval
 			}
 		}
 	}
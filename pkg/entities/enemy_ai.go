@@ -0,0 +1,222 @@
+package entities
+
+import (
+	"fmt"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/events"
+)
+
+// Default AI tuning used when an enemy is not configured from data
+const (
+	DefaultAggroRadius   = 8.0
+	DefaultLeashDistance = 15.0
+	DefaultAttackRange   = 1.5
+	DefaultAlertDuration = 0.5
+
+	waypointTolerance = 0.3
+	fleeSafeDistance  = 1.5 // Multiple of aggro radius at which fleeing stops
+
+	rangedRetreatRatio = 0.5 // Ranged enemies back off inside this fraction of attack range
+	projectileHeight   = 0.6 // Height above the enemy's feet projectiles are fired from
+	projectileRange    = 1.5 // Projectile lifetime covers this multiple of attack range
+)
+
+// updateAI advances the state machine and sets the enemy's velocity
+func (e *Enemy) updateAI(deltaTime float32, player *Player) {
+	e.StateTime += deltaTime
+
+	distance := horizontalDistance(e.Position, player.Position)
+	targetVisible := player.IsAlive() && distance <= e.AggroRadius
+
+	if e.shouldFlee() && e.State != AIStateFlee && player.IsAlive() && distance <= e.AggroRadius {
+		e.setState(AIStateFlee)
+	}
+
+	switch e.State {
+	case AIStateIdle:
+		if targetVisible {
+			e.beginAlert()
+		} else if len(e.Waypoints) > 0 {
+			e.setState(AIStatePatrol)
+		}
+
+	case AIStatePatrol:
+		if targetVisible {
+			e.beginAlert()
+			break
+		}
+		if len(e.Waypoints) == 0 {
+			e.setState(AIStateIdle)
+			break
+		}
+		waypoint := e.Waypoints[e.WaypointIndex]
+		if horizontalDistance(e.Position, waypoint) <= waypointTolerance {
+			e.WaypointIndex = (e.WaypointIndex + 1) % len(e.Waypoints)
+			waypoint = e.Waypoints[e.WaypointIndex]
+		}
+		e.moveToward(waypoint)
+
+	case AIStateAlert:
+		if !player.IsAlive() {
+			e.setState(AIStateReturn)
+		} else if e.StateTime >= e.AlertDuration {
+			e.setState(AIStateChase)
+		}
+
+	case AIStateChase:
+		switch {
+		case !player.IsAlive() || e.isLeashed():
+			e.setState(AIStateReturn)
+		case distance <= e.AttackRange:
+			e.setState(AIStateAttack)
+		default:
+			e.moveToward(player.Position)
+		}
+
+	case AIStateAttack:
+		switch {
+		case !player.IsAlive() || e.isLeashed():
+			e.setState(AIStateReturn)
+		case distance > e.AttackRange:
+			e.setState(AIStateChase)
+			e.moveToward(player.Position)
+		case e.Behavior == BehaviorRanged:
+			e.updateRangedAttack(player, distance)
+		case distance > 1.0:
+			e.moveToward(player.Position) // Close in to make contact
+		}
+
+	case AIStateReturn:
+		origin := e.returnPoint()
+		if horizontalDistance(e.Position, origin) <= waypointTolerance {
+			e.Health = e.MaxHealth // Leashing resets the fight
+			e.setState(AIStateIdle)
+			break
+		}
+		e.moveToward(origin)
+
+	case AIStateFlee:
+		if !player.IsAlive() || distance >= e.AggroRadius*fleeSafeDistance {
+			e.setState(AIStateReturn)
+			break
+		}
+		e.moveAwayFrom(player.Position)
+
+	default:
+		e.setState(AIStateIdle)
+	}
+}
+
+// updateRangedAttack keeps the enemy at range and fires whenever it has a
+// clear shot and its attack is off cooldown
+func (e *Enemy) updateRangedAttack(player *Player, distance float32) {
+	if e.navGrid != nil && !e.navGrid.HasLineOfSight(e.Position, player.Position) {
+		e.moveToward(player.Position) // Reposition for a clear shot
+		return
+	}
+
+	if distance < e.AttackRange*rangedRetreatRatio {
+		e.moveAwayFrom(player.Position)
+	}
+
+	if e.AttackCooldown <= 0 {
+		e.fireAt(player.Position)
+		e.AttackCooldown = e.AttackInterval
+	}
+}
+
+// fireAt publishes a bullet spawn aimed at target
+func (e *Enemy) fireAt(target rl.Vector3) {
+	if e.eventBus == nil || e.ProjectileSpeed <= 0 {
+		return
+	}
+
+	direction := horizontalDirection(e.Position, target)
+	muzzle := rl.Vector3Add(e.Position, rl.Vector3Scale(direction, e.Radius+0.2))
+	muzzle.Y = e.Position.Y + projectileHeight
+
+	var statusEffects []events.StatusEffectSpec
+	for _, effect := range e.OnHitEffects {
+		statusEffects = append(statusEffects, effect.Spec())
+	}
+
+	bulletEvent := events.NewBulletSpawnEventFromData(events.BulletSpawnEvent{
+		Position:      muzzle,
+		Direction:     direction,
+		Speed:         e.ProjectileSpeed,
+		Lifetime:      e.AttackRange * projectileRange / e.ProjectileSpeed,
+		Damage:        e.Damage,
+		OwnerID:       e.ID,
+		Faction:       string(FactionEnemy),
+		DamageType:    string(e.DamageType),
+		StatusEffects: statusEffects,
+	})
+	if err := e.eventBus.Notify(bulletEvent); err != nil {
+		fmt.Printf("Error notifying bullet spawn: %v\n", err)
+	}
+}
+
+func (e *Enemy) beginAlert() {
+	e.LeashOrigin = e.Position
+	e.setState(AIStateAlert)
+}
+
+func (e *Enemy) shouldFlee() bool {
+	return e.FleeHealthPercent > 0 && e.GetHealthPercent() <= e.FleeHealthPercent
+}
+
+func (e *Enemy) isLeashed() bool {
+	return e.LeashDistance > 0 && horizontalDistance(e.Position, e.LeashOrigin) > e.LeashDistance
+}
+
+// returnPoint is where a leashed enemy heads back to
+func (e *Enemy) returnPoint() rl.Vector3 {
+	if len(e.Waypoints) > 0 {
+		return e.LeashOrigin
+	}
+	return e.Home
+}
+
+// moveToward steers along a cached path to target, re-planning when the
+// target has moved far enough. Without a navigation grid it steers directly.
+func (e *Enemy) moveToward(target rl.Vector3) {
+	waypoint, _ := e.pathFollower.NextWaypoint(e.navGrid, e.Position, target)
+	e.Velocity = horizontalDirection(e.Position, waypoint)
+}
+
+func (e *Enemy) moveAwayFrom(target rl.Vector3) {
+	e.Velocity = rl.Vector3Negate(horizontalDirection(e.Position, target))
+}
+
+// setState switches AI state and publishes the change
+func (e *Enemy) setState(state AIState) {
+	if e.State == state {
+		return
+	}
+
+	previous := e.State
+	e.State = state
+	e.StateTime = 0
+
+	if e.eventBus != nil {
+		stateEvent := events.NewEnemyStateChangedEvent(e.ID, string(previous), string(state), e.Position)
+		if err := e.eventBus.Notify(stateEvent); err != nil {
+			fmt.Printf("Error notifying enemy state change: %v\n", err)
+		}
+	}
+}
+
+func horizontalDistance(a, b rl.Vector3) float32 {
+	return rl.Vector2Distance(rl.Vector2{X: a.X, Y: a.Z}, rl.Vector2{X: b.X, Y: b.Z})
+}
+
+// horizontalDirection returns the unit vector from a to b on the XZ plane
+func horizontalDirection(a, b rl.Vector3) rl.Vector3 {
+	direction := rl.Vector3{X: b.X - a.X, Z: b.Z - a.Z}
+	if direction.X == 0 && direction.Z == 0 {
+		return rl.Vector3{}
+	}
+	return rl.Vector3Normalize(direction)
+}
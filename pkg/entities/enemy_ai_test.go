@@ -0,0 +1,229 @@
+package entities
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/events"
+)
+
+func TestEnemyIgnoresPlayerOutsideAggroRadius(t *testing.T) {
+	// given
+	// ... an idle enemy with an aggro radius of 8
+	// ... a player 20 units away
+	enemy := NewEnemy(rl.Vector3{}, 50.0, 2.0)
+	enemy.ID = "enemy_1"
+	player := NewPlayer(5.0, nil)
+	player.Position = rl.Vector3{X: 20}
+	// when
+	// ... the enemy updates
+	enemy.Update(0.1, player)
+	// then
+	// ... should stay idle and not move
+	if enemy.State != AIStateIdle {
+		t.Fatalf("Expected idle state, got %s", enemy.State)
+	}
+	if enemy.Velocity != (rl.Vector3{}) {
+		t.Fatal("Idle enemy should not move")
+	}
+}
+
+func TestEnemyAlertsThenChasesPlayerInAggroRadius(t *testing.T) {
+	// given
+	// ... an idle enemy with a mock event bus
+	// ... a player 5 units away
+	bus := &MockEventBus{}
+	enemy := NewEnemy(rl.Vector3{}, 50.0, 2.0)
+	enemy.ID = "enemy_1"
+	enemy.SetEventBus(bus)
+	player := NewPlayer(5.0, nil)
+	player.Position = rl.Vector3{X: 5}
+	// when
+	// ... the enemy updates once
+	enemy.Update(0.1, player)
+	// then
+	// ... should become alert and publish the change
+	if enemy.State != AIStateAlert {
+		t.Fatalf("Expected alert state, got %s", enemy.State)
+	}
+	if len(bus.events) != 1 || bus.events[0].Type != events.EventTypeEnemyStateChanged {
+		t.Fatal("Expected one enemy state changed event")
+	}
+	stateData := bus.events[0].Data.(events.EnemyStateChangedEvent)
+	if stateData.EnemyId != "enemy_1" || stateData.FromState != "idle" || stateData.ToState != "alert" {
+		t.Fatalf("Unexpected state change data: %+v", stateData)
+	}
+	// when
+	// ... the alert duration passes and the enemy updates again
+	enemy.Update(DefaultAlertDuration, player)
+	enemy.Update(0.1, player)
+	// then
+	// ... should chase toward the player
+	if enemy.State != AIStateChase {
+		t.Fatalf("Expected chase state, got %s", enemy.State)
+	}
+	if enemy.Velocity.X <= 0 {
+		t.Fatal("Chasing enemy should move toward the player")
+	}
+}
+
+func TestEnemyAttacksInRangeAndReturnsWhenLeashed(t *testing.T) {
+	// given
+	// ... a chasing enemy whose chase began at the origin
+	enemy := NewEnemy(rl.Vector3{}, 50.0, 2.0)
+	enemy.ID = "enemy_1"
+	enemy.State = AIStateChase
+	player := NewPlayer(5.0, nil)
+	player.Position = rl.Vector3{X: 1.2}
+	// when
+	// ... the player is within attack range
+	enemy.Update(0.1, player)
+	// then
+	// ... should switch to attack
+	if enemy.State != AIStateAttack {
+		t.Fatalf("Expected attack state, got %s", enemy.State)
+	}
+	// when
+	// ... the enemy has been dragged beyond its leash distance
+	enemy.Position = rl.Vector3{X: DefaultLeashDistance + 1}
+	player.Position = rl.Vector3{X: DefaultLeashDistance + 2}
+	enemy.Health = 20.0
+	enemy.Update(0.1, player)
+	// then
+	// ... should give up and return home
+	if enemy.State != AIStateReturn {
+		t.Fatalf("Expected return state, got %s", enemy.State)
+	}
+	// when
+	// ... the enemy reaches home
+	enemy.Position = rl.Vector3{}
+	enemy.Update(0.1, player)
+	// then
+	// ... should go idle with full health
+	if enemy.State != AIStateIdle {
+		t.Fatalf("Expected idle state, got %s", enemy.State)
+	}
+	if enemy.Health != enemy.MaxHealth {
+		t.Fatalf("Expected health reset to %f, got %f", enemy.MaxHealth, enemy.Health)
+	}
+}
+
+func TestEnemyFleesAtLowHealth(t *testing.T) {
+	// given
+	// ... a chasing enemy that flees at 25% health
+	// ... with only 10% health left
+	enemy := NewEnemy(rl.Vector3{}, 50.0, 2.0)
+	enemy.ID = "enemy_1"
+	enemy.State = AIStateChase
+	enemy.FleeHealthPercent = 0.25
+	enemy.Health = 5.0
+	player := NewPlayer(5.0, nil)
+	player.Position = rl.Vector3{X: 3}
+	// when
+	// ... the enemy updates
+	enemy.Update(0.1, player)
+	// then
+	// ... should flee away from the player
+	if enemy.State != AIStateFlee {
+		t.Fatalf("Expected flee state, got %s", enemy.State)
+	}
+	if enemy.Velocity.X >= 0 {
+		t.Fatal("Fleeing enemy should move away from the player")
+	}
+}
+
+func TestEnemyPatrolsWaypointsInLoop(t *testing.T) {
+	// given
+	// ... an enemy with a two-point patrol route
+	// ... standing on the first waypoint
+	enemy := NewEnemy(rl.Vector3{}, 50.0, 2.0)
+	enemy.ID = "enemy_1"
+	enemy.Waypoints = []rl.Vector3{{X: 0}, {X: 4}}
+	player := NewPlayer(5.0, nil)
+	player.Position = rl.Vector3{X: 50}
+	// when
+	// ... the enemy updates twice
+	enemy.Update(0.1, player)
+	enemy.Update(0.1, player)
+	// then
+	// ... should be patrolling toward the second waypoint
+	if enemy.State != AIStatePatrol {
+		t.Fatalf("Expected patrol state, got %s", enemy.State)
+	}
+	if enemy.WaypointIndex != 1 || enemy.Velocity.X <= 0 {
+		t.Fatal("Expected enemy to head for the second waypoint")
+	}
+	// when
+	// ... the enemy reaches the second waypoint
+	enemy.Position = rl.Vector3{X: 4}
+	enemy.Update(0.1, player)
+	// then
+	// ... should loop back toward the first waypoint
+	if enemy.WaypointIndex != 0 || enemy.Velocity.X >= 0 {
+		t.Fatal("Expected enemy to loop back to the first waypoint")
+	}
+}
+
+func TestRangedEnemyFiresAtPlayerInRange(t *testing.T) {
+	// given
+	// ... an attacking ranged enemy with an 8 unit range
+	// ... a player 6 units away
+	bus := &MockEventBus{}
+	enemy := NewEnemy(rl.Vector3{}, 50.0, 2.0)
+	enemy.ID = "enemy_1"
+	enemy.SetEventBus(bus)
+	enemy.Behavior = BehaviorRanged
+	enemy.AttackRange = 8.0
+	enemy.ProjectileSpeed = 10.0
+	enemy.State = AIStateAttack
+	player := NewPlayer(5.0, nil)
+	player.Position = rl.Vector3{X: 6}
+	// when
+	// ... the enemy updates
+	enemy.Update(0.1, player)
+	// then
+	// ... should hold position and fire an enemy bullet at the player
+	if enemy.Velocity != (rl.Vector3{}) {
+		t.Fatal("Ranged enemy at a comfortable range should hold position")
+	}
+	if len(bus.events) != 1 || bus.events[0].Type != events.EventTypeBulletSpawn {
+		t.Fatal("Expected one bullet spawn event")
+	}
+	bulletData := bus.events[0].Data.(events.BulletSpawnEvent)
+	if bulletData.OwnerID != "enemy_1" || bulletData.Faction != string(FactionEnemy) {
+		t.Fatalf("Unexpected bullet owner data: %+v", bulletData)
+	}
+	if bulletData.Direction.X <= 0 {
+		t.Fatal("Bullet should head toward the player")
+	}
+	if enemy.AttackCooldown != enemy.AttackInterval {
+		t.Fatalf("Expected attack cooldown %f, got %f", enemy.AttackInterval, enemy.AttackCooldown)
+	}
+}
+
+func TestRangedEnemyBacksAwayFromClosePlayer(t *testing.T) {
+	// given
+	// ... an attacking ranged enemy on cooldown
+	// ... a player 2 units away
+	enemy := NewEnemy(rl.Vector3{}, 50.0, 2.0)
+	enemy.ID = "enemy_1"
+	enemy.Behavior = BehaviorRanged
+	enemy.AttackRange = 8.0
+	enemy.ProjectileSpeed = 10.0
+	enemy.State = AIStateAttack
+	enemy.AttackCooldown = 1.0
+	player := NewPlayer(5.0, nil)
+	player.Position = rl.Vector3{X: 2}
+	// when
+	// ... the enemy updates
+	enemy.Update(0.1, player)
+	// then
+	// ... should keep its distance
+	if enemy.State != AIStateAttack {
+		t.Fatalf("Expected attack state, got %s", enemy.State)
+	}
+	if enemy.Velocity.X >= 0 {
+		t.Fatal("Ranged enemy should back away from a close player")
+	}
+}
@@ -0,0 +1,121 @@
+package entities
+
+import (
+	"fmt"
+
+	"arpg/pkg/events"
+	"arpg/pkg/items"
+)
+
+// Player inventory grid size in cells
+const (
+	PlayerInventoryWidth  = 10
+	PlayerInventoryHeight = 4
+)
+
+// EquipItem moves the inventory item with the given UID into its equipment
+// slot, returning whatever it replaces to the inventory. Nothing changes if
+// the replaced item does not fit.
+func (p *Player) EquipItem(uid string) error {
+	placement, exists := p.Inventory.Get(uid)
+	if !exists {
+		return items.ErrItemNotFound
+	}
+	item, err := p.Inventory.Remove(uid)
+	if err != nil {
+		return err
+	}
+
+	slot, previous, err := p.Equipment.Equip(item)
+	if err != nil {
+		p.restoreToInventory(item, placement.Position)
+		return err
+	}
+	if previous != nil {
+		if err := p.Inventory.Add(previous); err != nil {
+			if _, err := p.Equipment.EquipTo(slot, previous); err != nil {
+				return err
+			}
+			p.restoreToInventory(item, placement.Position)
+			return err
+		}
+		p.RemoveModifierSource(itemModifierSource(previous))
+	}
+
+	p.applyItemModifiers(item)
+	p.notifyEquipmentChanged(slot, item, previous)
+	return nil
+}
+
+// UnequipItem moves the item in slot back into the inventory
+func (p *Player) UnequipItem(slot items.EquipSlot) error {
+	item := p.Equipment.Get(slot)
+	if item == nil {
+		return fmt.Errorf("nothing equipped in %s", slot)
+	}
+	if err := p.Inventory.Add(item); err != nil {
+		return err
+	}
+	p.Equipment.Unequip(slot)
+
+	p.RemoveModifierSource(itemModifierSource(item))
+	p.notifyEquipmentChanged(slot, nil, item)
+	return nil
+}
+
+// GiveItem puts an item in the player's inventory
+func (p *Player) GiveItem(item *items.Item) error {
+	return p.Inventory.Add(item)
+}
+
+// restoreToInventory puts an item back where it was taken from
+func (p *Player) restoreToInventory(item *items.Item, position items.Position) {
+	if err := p.Inventory.PlaceAt(item, position.X, position.Y); err != nil {
+		fmt.Printf("Error restoring %s to inventory: %v\n", item.UID, err)
+	}
+}
+
+// applyItemModifiers adds an equipped item's modifiers to the stat sheet
+// under a source unique to that item
+func (p *Player) applyItemModifiers(item *items.Item) {
+	source := itemModifierSource(item)
+	for _, modifier := range item.Modifiers() {
+		p.Stats.AddModifier(NewModifier(
+			StatType(modifier.Stat), modifierKindFromItem(modifier.Kind), modifier.Value, source,
+		))
+	}
+	p.syncStats()
+}
+
+func (p *Player) notifyEquipmentChanged(slot items.EquipSlot, equipped, unequipped *items.Item) {
+	if p.eventBus == nil {
+		return
+	}
+
+	var equippedUID, unequippedUID string
+	if equipped != nil {
+		equippedUID = equipped.UID
+	}
+	if unequipped != nil {
+		unequippedUID = unequipped.UID
+	}
+	changedEvent := events.NewEquipmentChangedEvent(p.ID, string(slot), equippedUID, unequippedUID)
+	if err := p.eventBus.Notify(changedEvent); err != nil {
+		fmt.Printf("Error notifying equipment change: %v\n", err)
+	}
+}
+
+func itemModifierSource(item *items.Item) string {
+	return "item:" + item.UID
+}
+
+func modifierKindFromItem(kind items.ModifierKind) ModifierKind {
+	switch kind {
+	case items.ModifierIncreased:
+		return ModifierIncreased
+	case items.ModifierMore:
+		return ModifierMore
+	default:
+		return ModifierFlat
+	}
+}
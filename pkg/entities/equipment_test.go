@@ -0,0 +1,122 @@
+package entities
+
+import (
+	"errors"
+	"testing"
+
+	"arpg/pkg/events"
+	"arpg/pkg/items"
+)
+
+func TestEquipAndUnequipChangeStats(t *testing.T) {
+	// given
+	// ... a player with speed 5 carrying boots
+	bus := &MockEventBus{}
+	player := NewPlayer(5.0, bus)
+	boots := &items.Item{UID: "boots_1", Quantity: 1, Definition: &items.Definition{
+		ID: "boots", Name: "Boots", Slot: items.SlotBoots, Width: 2, Height: 2, MaxStack: 1,
+		Modifiers: []items.StatModifier{{Stat: string(StatMoveSpeed), Kind: items.ModifierIncreased, Value: 0.2}},
+	}}
+	if err := player.GiveItem(boots); err != nil {
+		t.Fatalf("Failed to give boots: %v", err)
+	}
+	// when
+	// ... the boots are equipped and then unequipped
+	if err := player.EquipItem(boots.UID); err != nil {
+		t.Fatalf("Expected boots to be equipped, got %v", err)
+	}
+	equippedSpeed := player.Speed
+	if err := player.UnequipItem(items.EquipBoots); err != nil {
+		t.Fatalf("Expected boots to be unequipped, got %v", err)
+	}
+	// then
+	// ... speed should rise by 20% and return exactly to 5
+	// ... and the boots should be back in the inventory
+	if equippedSpeed != 6.0 {
+		t.Fatalf("Expected equipped speed 6.0, got %f", equippedSpeed)
+	}
+	if player.Speed != 5.0 {
+		t.Fatalf("Expected speed 5.0 after unequip, got %f", player.Speed)
+	}
+	if _, found := player.Inventory.Get(boots.UID); !found {
+		t.Fatal("Expected boots back in the inventory")
+	}
+	changes := 0
+	for _, event := range bus.events {
+		if event.Type == events.EventTypeEquipmentChanged {
+			changes++
+		}
+	}
+	if changes != 2 {
+		t.Fatalf("Expected 2 equipment changed events, got %d", changes)
+	}
+}
+
+func TestEquipSwapsReplacedItemIntoInventory(t *testing.T) {
+	// given
+	// ... a player wielding a sword and carrying an axe
+	player := NewPlayer(5.0, nil)
+	sword := &items.Item{UID: "sword_1", Quantity: 1, Definition: &items.Definition{
+		ID: "sword", Name: "Sword", Slot: items.SlotWeapon, Width: 1, Height: 3, MaxStack: 1,
+		Modifiers: []items.StatModifier{{Stat: string(StatDamage), Kind: items.ModifierFlat, Value: 5}},
+	}}
+	axe := &items.Item{UID: "axe_1", Quantity: 1, Definition: &items.Definition{
+		ID: "axe", Name: "Axe", Slot: items.SlotWeapon, Width: 2, Height: 3, MaxStack: 1,
+		Modifiers: []items.StatModifier{{Stat: string(StatDamage), Kind: items.ModifierFlat, Value: 15}},
+	}}
+	for _, item := range []*items.Item{sword, axe} {
+		if err := player.GiveItem(item); err != nil {
+			t.Fatalf("Failed to give %s: %v", item.Definition.ID, err)
+		}
+	}
+	if err := player.EquipItem(sword.UID); err != nil {
+		t.Fatalf("Expected sword to be equipped, got %v", err)
+	}
+	// when
+	// ... the axe is equipped
+	err := player.EquipItem(axe.UID)
+	// then
+	// ... the axe should replace the sword's damage bonus
+	// ... and the sword should return to the inventory
+	if err != nil {
+		t.Fatalf("Expected axe to be equipped, got %v", err)
+	}
+	if player.Equipment.Get(items.EquipWeapon) != axe {
+		t.Fatal("Expected axe in the weapon slot")
+	}
+	if player.BulletDamage != playerBaseBulletDamage+15 {
+		t.Fatalf("Expected damage %f, got %f", playerBaseBulletDamage+15.0, player.BulletDamage)
+	}
+	if _, found := player.Inventory.Get(sword.UID); !found {
+		t.Fatal("Expected sword back in the inventory")
+	}
+}
+
+func TestUnequipFailsWhenInventoryFull(t *testing.T) {
+	// given
+	// ... a player wearing boots with an inventory too small to hold them
+	player := NewPlayer(5.0, nil)
+	player.Inventory = items.NewInventory(2, 2)
+	boots := &items.Item{UID: "boots_1", Quantity: 1, Definition: &items.Definition{
+		ID: "boots", Name: "Boots", Slot: items.SlotBoots, Width: 2, Height: 2, MaxStack: 1,
+		Modifiers: []items.StatModifier{{Stat: string(StatMoveSpeed), Kind: items.ModifierIncreased, Value: 0.2}},
+	}}
+	if err := player.GiveItem(boots); err != nil {
+		t.Fatalf("Failed to give boots: %v", err)
+	}
+	if err := player.EquipItem(boots.UID); err != nil {
+		t.Fatalf("Expected boots to be equipped, got %v", err)
+	}
+	player.Inventory = items.NewInventory(1, 1)
+	// when
+	// ... the boots are unequipped
+	err := player.UnequipItem(items.EquipBoots)
+	// then
+	// ... should fail and keep the boots and their bonus
+	if !errors.Is(err, items.ErrInventoryFull) {
+		t.Fatalf("Expected ErrInventoryFull, got %v", err)
+	}
+	if player.Equipment.Get(items.EquipBoots) != boots || player.Speed != 6.0 {
+		t.Fatal("Expected boots to stay equipped")
+	}
+}
@@ -0,0 +1,206 @@
+package entities
+
+import (
+	"fmt"
+	"math/rand"
+
+	"arpg/pkg/events"
+)
+
+// Attribute names a spendable character attribute
+type Attribute string
+
+const (
+	AttributeStrength     Attribute = "strength"
+	AttributeDexterity    Attribute = "dexterity"
+	AttributeVitality     Attribute = "vitality"
+	AttributeIntelligence Attribute = "intelligence"
+)
+
+// AllAttributes lists every attribute in display order
+var AllAttributes = []Attribute{
+	AttributeStrength,
+	AttributeDexterity,
+	AttributeVitality,
+	AttributeIntelligence,
+}
+
+// Derived stat tuning per attribute point
+const (
+	HealthPerVitality          = 5.0   // Max health
+	DamagePerStrength          = 0.02  // Fraction of base bullet damage
+	CritChancePerDexterity     = 0.005 // Chance for a shot to crit
+	ResistancePerIntelligence  = 0.005 // Added to every elemental resistance
+	ManaPerIntelligence        = 2.0   // Max mana
+	CritMultiplier             = 1.5
+	defaultAttributePointsGain = 5
+
+	attributeModifierSource = "attributes"
+)
+
+// Attributes holds points invested in each attribute
+type Attributes struct {
+	Strength     int
+	Dexterity    int
+	Vitality     int
+	Intelligence int
+}
+
+// Get returns the points invested in attribute
+func (a *Attributes) Get(attribute Attribute) int {
+	switch attribute {
+	case AttributeStrength:
+		return a.Strength
+	case AttributeDexterity:
+		return a.Dexterity
+	case AttributeVitality:
+		return a.Vitality
+	case AttributeIntelligence:
+		return a.Intelligence
+	default:
+		return 0
+	}
+}
+
+// add invests points in attribute, returning false for unknown attributes
+func (a *Attributes) add(attribute Attribute, points int) bool {
+	switch attribute {
+	case AttributeStrength:
+		a.Strength += points
+	case AttributeDexterity:
+		a.Dexterity += points
+	case AttributeVitality:
+		a.Vitality += points
+	case AttributeIntelligence:
+		a.Intelligence += points
+	default:
+		return false
+	}
+	return true
+}
+
+// LevelCurve defines how much XP each level needs and what a level grants
+type LevelCurve struct {
+	XPToNext          []float32 // XPToNext[i] is the XP needed to go from level i+1 to i+2
+	PointsPerLevel    int       // Attribute points granted per level gained
+	EnemyLevelXPScale float32   // Extra kill XP per enemy level above 1, as a fraction
+}
+
+// DefaultLevelCurve is used when no level curve data is loaded
+func DefaultLevelCurve() *LevelCurve {
+	return &LevelCurve{
+		XPToNext:          []float32{100, 150, 225, 340, 500, 750, 1100, 1600, 2400},
+		PointsPerLevel:    defaultAttributePointsGain,
+		EnemyLevelXPScale: 0.25,
+	}
+}
+
+// MaxLevel returns the highest reachable level
+func (c *LevelCurve) MaxLevel() int {
+	return len(c.XPToNext) + 1
+}
+
+// XPToNextLevel returns the XP needed to advance from level, or 0 at max level
+func (c *LevelCurve) XPToNextLevel(level int) float32 {
+	if level < 1 || level >= c.MaxLevel() {
+		return 0
+	}
+	return c.XPToNext[level-1]
+}
+
+// KillXP returns the XP awarded for killing an enemy worth baseXP at enemyLevel
+func (c *LevelCurve) KillXP(baseXP float32, enemyLevel int) float32 {
+	if enemyLevel < 1 {
+		enemyLevel = 1
+	}
+	return baseXP * (1 + c.EnemyLevelXPScale*float32(enemyLevel-1))
+}
+
+// ExperienceComponent tracks level progress and attribute investment
+type ExperienceComponent struct {
+	Level           int
+	XP              float32 // Progress toward the next level
+	AttributePoints int     // Unspent points
+	Attributes
+}
+
+// SetLevelCurve sets the curve used for levelling
+func (p *Player) SetLevelCurve(curve *LevelCurve) {
+	p.levelCurve = curve
+}
+
+// LevelCurve returns the curve used for levelling
+func (p *Player) LevelCurve() *LevelCurve {
+	return p.levelCurve
+}
+
+// GainXP adds experience, levelling up as many times as it allows
+func (p *Player) GainXP(amount float32) {
+	if amount <= 0 || p.Level >= p.levelCurve.MaxLevel() {
+		return
+	}
+
+	p.XP += amount
+	for p.Level < p.levelCurve.MaxLevel() && p.XP >= p.levelCurve.XPToNextLevel(p.Level) {
+		p.XP -= p.levelCurve.XPToNextLevel(p.Level)
+		p.levelUp()
+	}
+	if p.Level >= p.levelCurve.MaxLevel() {
+		p.XP = 0
+	}
+}
+
+func (p *Player) levelUp() {
+	p.Level++
+	p.AttributePoints += p.levelCurve.PointsPerLevel
+	p.recalculateStats()
+	p.Health = p.MaxHealth // Levelling up restores health
+
+	if p.eventBus != nil {
+		levelUpEvent := events.NewPlayerLevelUpEvent(p.Level, p.AttributePoints, p.MaxHealth)
+		if err := p.eventBus.Notify(levelUpEvent); err != nil {
+			fmt.Printf("Error notifying level up: %v\n", err)
+		}
+	}
+}
+
+// SpendAttributePoint invests one unspent point in attribute
+func (p *Player) SpendAttributePoint(attribute Attribute) error {
+	if p.AttributePoints <= 0 {
+		return fmt.Errorf("no attribute points to spend")
+	}
+	if !p.Attributes.add(attribute, 1) {
+		return fmt.Errorf("unknown attribute %q", attribute)
+	}
+	p.AttributePoints--
+
+	oldMaxHealth := p.MaxHealth
+	p.recalculateStats()
+	p.Health += p.MaxHealth - oldMaxHealth // Vitality grows current health too
+	return nil
+}
+
+// recalculateStats replaces the attribute modifiers on the player's stat
+// sheet, deriving max health, damage, crit chance, resistances and mana
+func (p *Player) recalculateStats() {
+	p.Stats.RemoveSource(attributeModifierSource)
+	p.Stats.AddModifier(NewModifier(StatMaxHealth, ModifierFlat,
+		float32(p.Vitality)*HealthPerVitality, attributeModifierSource))
+	p.Stats.AddModifier(NewModifier(StatDamage, ModifierIncreased,
+		float32(p.Strength)*DamagePerStrength, attributeModifierSource))
+	p.Stats.AddModifier(NewModifier(StatCritChance, ModifierFlat,
+		float32(p.Dexterity)*CritChancePerDexterity, attributeModifierSource))
+	p.Stats.AddModifier(NewModifier(StatAllResistance, ModifierFlat,
+		float32(p.Intelligence)*ResistancePerIntelligence, attributeModifierSource))
+	p.Stats.AddModifier(NewModifier(StatMaxMana, ModifierFlat,
+		float32(p.Intelligence)*ManaPerIntelligence, attributeModifierSource))
+	p.syncStats()
+}
+
+// rollDamage returns the player's bullet damage, rolling for a critical hit
+func (p *Player) rollDamage() (float32, bool) {
+	if p.CritChance > 0 && rand.Float32() < p.CritChance {
+		return p.BulletDamage * CritMultiplier, true
+	}
+	return p.BulletDamage, false
+}
@@ -0,0 +1,159 @@
+package entities
+
+import (
+	"testing"
+
+	"arpg/pkg/events"
+)
+
+func TestGainXPLevelsUpAcrossThresholds(t *testing.T) {
+	// given
+	// ... a level 1 player with a two-threshold curve
+	bus := &MockEventBus{}
+	player := NewPlayer(5.0, bus)
+	player.SetLevelCurve(&LevelCurve{
+		XPToNext:          []float32{100, 200},
+		PointsPerLevel:    5,
+		EnemyLevelXPScale: 0.5,
+	})
+	player.Health = 50.0
+	// when
+	// ... the player gains enough XP for two levels at once
+	player.GainXP(320.0)
+	// then
+	// ... should reach level 3 with 10 points and full health
+	// ... and should stop accumulating XP at the max level
+	if player.Level != 3 {
+		t.Fatalf("Expected level 3, got %d", player.Level)
+	}
+	if player.AttributePoints != 10 {
+		t.Fatalf("Expected 10 attribute points, got %d", player.AttributePoints)
+	}
+	if player.Health != player.MaxHealth {
+		t.Fatalf("Expected full health after level up, got %f/%f", player.Health, player.MaxHealth)
+	}
+	if player.XP != 0 {
+		t.Fatalf("Expected no XP at max level, got %f", player.XP)
+	}
+	levelUps := 0
+	for _, event := range bus.events {
+		if event.Type == events.EventTypePlayerLevelUp {
+			levelUps++
+		}
+	}
+	if levelUps != 2 {
+		t.Fatalf("Expected 2 level up events, got %d", levelUps)
+	}
+}
+
+func TestGainXPKeepsProgressBelowThreshold(t *testing.T) {
+	// given
+	// ... a level 1 player
+	player := NewPlayer(5.0, nil)
+	player.SetLevelCurve(&LevelCurve{
+		XPToNext:          []float32{100, 200},
+		PointsPerLevel:    5,
+		EnemyLevelXPScale: 0.5,
+	})
+	// when
+	// ... the player gains 150 XP
+	player.GainXP(150.0)
+	// then
+	// ... should reach level 2 carrying 50 XP toward level 3
+	if player.Level != 2 || player.XP != 50.0 {
+		t.Fatalf("Expected level 2 with 50 XP, got level %d with %f XP", player.Level, player.XP)
+	}
+}
+
+func TestSpendAttributePointUpdatesDerivedStats(t *testing.T) {
+	// given
+	// ... a player with two unspent points
+	player := NewPlayer(5.0, nil)
+	player.AttributePoints = 2
+	baseHealth := player.MaxHealth
+	// when
+	// ... one point goes into vitality and one into strength
+	if err := player.SpendAttributePoint(AttributeVitality); err != nil {
+		t.Fatalf("Expected vitality point to be spent, got %v", err)
+	}
+	if err := player.SpendAttributePoint(AttributeStrength); err != nil {
+		t.Fatalf("Expected strength point to be spent, got %v", err)
+	}
+	// then
+	// ... max and current health should grow by the vitality bonus
+	// ... and bullet damage should grow by the strength bonus
+	if player.MaxHealth != baseHealth+HealthPerVitality || player.Health != player.MaxHealth {
+		t.Fatalf("Expected health %f, got %f/%f", baseHealth+HealthPerVitality, player.Health, player.MaxHealth)
+	}
+	expectedDamage := player.Stats.Base(StatDamage) * (1 + DamagePerStrength)
+	if player.BulletDamage != expectedDamage {
+		t.Fatalf("Expected bullet damage %f, got %f", expectedDamage, player.BulletDamage)
+	}
+	if player.AttributePoints != 0 {
+		t.Fatalf("Expected no points left, got %d", player.AttributePoints)
+	}
+}
+
+func TestSpendAttributePointRejectsInvalidSpend(t *testing.T) {
+	// given
+	// ... a player with no unspent points
+	player := NewPlayer(5.0, nil)
+	// when
+	// ... a point is spent, then one is spent on an unknown attribute
+	noPointsErr := player.SpendAttributePoint(AttributeVitality)
+	player.AttributePoints = 1
+	unknownErr := player.SpendAttributePoint(Attribute("luck"))
+	// then
+	// ... both spends should fail without consuming points
+	if noPointsErr == nil || unknownErr == nil {
+		t.Fatal("Expected errors for spending without points and on an unknown attribute")
+	}
+	if player.AttributePoints != 1 {
+		t.Fatalf("Expected the point to be kept, got %d", player.AttributePoints)
+	}
+}
+
+func TestIntelligenceAddsElementalResistance(t *testing.T) {
+	// given
+	// ... a player with 20 points of intelligence
+	// ... and no invulnerability after being hit
+	player := NewPlayer(5.0, nil)
+	player.HurtInvulnerability = 0
+	player.AttributePoints = 20
+	for range 20 {
+		if err := player.SpendAttributePoint(AttributeIntelligence); err != nil {
+			t.Fatalf("Expected intelligence point to be spent, got %v", err)
+		}
+	}
+	// when
+	// ... the player takes fire and physical hits of 100
+	fire := player.TakeDamage(NewDamage(100.0, DamageFire, "enemy_1"))
+	physical := player.TakeDamage(NewDamage(10.0, DamagePhysical, "enemy_1"))
+	// then
+	// ... only the fire hit should be resisted
+	if fire.Dealt != 90.0 {
+		t.Fatalf("Expected 90 fire damage, got %f", fire.Dealt)
+	}
+	if physical.Dealt != 10.0 {
+		t.Fatalf("Expected 10 physical damage, got %f", physical.Dealt)
+	}
+}
+
+func TestKillXPScalesWithEnemyLevel(t *testing.T) {
+	// given
+	// ... a curve granting 50% extra XP per enemy level
+	curve := &LevelCurve{
+		XPToNext:          []float32{100, 200},
+		PointsPerLevel:    5,
+		EnemyLevelXPScale: 0.5,
+	}
+	// when
+	// ... XP is computed for level 1 and level 3 enemies worth 10
+	levelOne := curve.KillXP(10.0, 1)
+	levelThree := curve.KillXP(10.0, 3)
+	// then
+	// ... the level 3 enemy should be worth twice as much
+	if levelOne != 10.0 || levelThree != 20.0 {
+		t.Fatalf("Expected 10 and 20 XP, got %f and %f", levelOne, levelThree)
+	}
+}
@@ -0,0 +1,37 @@
+package entities
+
+import "arpg/pkg/globals"
+
+// NewGroundSystem keeps walkers standing on the world's ground and stops
+// projectiles that fly into it. Without a ground it does nothing, leaving
+// everything at the heights it was placed.
+func NewGroundSystem() System {
+	return SystemFunc(func(w *World, deltaTime float32) {
+		if w.ground == nil {
+			return
+		}
+		for _, entity := range w.entities {
+			if !entity.IsActive() {
+				continue
+			}
+			if bullet, ok := entity.(*Bullet); ok {
+				if bullet.Position.Y < w.ground.HeightAt(bullet.Position.X, bullet.Position.Z) {
+					bullet.stop()
+				}
+				continue
+			}
+			if !walksOnGround(entity) {
+				continue
+			}
+			transform := w.components[entity.GetID()].Transform
+			transform.Position.Y = w.ground.HeightAt(transform.Position.X, transform.Position.Z)
+		}
+	})
+}
+
+// walksOnGround reports whether entity moves on foot, so steep ground
+// blocks it. The collision system decides this from the collision tags.
+func walksOnGround(entity Entity) bool {
+	collidable, ok := entity.(globals.Collidable)
+	return ok && globals.WalksOnGround(collidable)
+}
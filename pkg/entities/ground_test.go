@@ -0,0 +1,148 @@
+package entities
+
+import (
+	"math"
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/terrain"
+)
+
+func TestPlayerWalksUpRampButNotCliff(t *testing.T) {
+	// given
+	// ... a player at the foot of the ramp
+	world := testWorld()
+	heightmap, err := terrain.New(5, 2, []float32{
+		0, 0, 0.25, 1, 1,
+		0, 0, 0.25, 1, 1,
+	}, rl.Vector3{X: 20, Y: 8, Z: 20})
+	if err != nil {
+		t.Fatalf("Unexpected heightmap error: %v", err)
+	}
+	heightmap.MaxSlope = 0.8
+	world.SetGround(heightmap)
+	player := NewPlayer(5.0, &MockEventBus{})
+	player.Position = rl.Vector3{X: -4}
+	if err := world.Spawn(player); err != nil {
+		t.Fatalf("Unexpected spawn error: %v", err)
+	}
+	ground := NewGroundSystem()
+	// when
+	// ... the player walks along +X for three seconds
+	for range 180 {
+		player.move(rl.Vector2{X: 1}, testMoveFrame)
+		ground.Update(world, testMoveFrame)
+	}
+	// then
+	// ... should climb the ramp and stop where the cliff starts
+	// ... standing on the ground
+	if player.Position.X < -0.5 || player.Position.X > 0.5 {
+		t.Fatalf("Expected player stopped at the cliff near x 0, got x %f", player.Position.X)
+	}
+	if want := world.GroundHeight(player.Position.X, player.Position.Z); math.Abs(float64(player.Position.Y-want)) > 0.001 {
+		t.Fatalf("Expected player at ground height %f, got %f", want, player.Position.Y)
+	}
+}
+
+func TestEnemiesCannotClimbSteepGround(t *testing.T) {
+	// given
+	// ... an enemy on the cliff face
+	world := testWorld()
+	heightmap, err := terrain.New(5, 2, []float32{
+		0, 0, 0.25, 1, 1,
+		0, 0, 0.25, 1, 1,
+	}, rl.Vector3{X: 20, Y: 8, Z: 20})
+	if err != nil {
+		t.Fatalf("Unexpected heightmap error: %v", err)
+	}
+	heightmap.MaxSlope = 0.8
+	world.SetGround(heightmap)
+	enemy := NewEnemy(rl.Vector3{X: 2}, 50, 2)
+	enemy.ID = "enemy_1"
+	if err := world.Spawn(enemy); err != nil {
+		t.Fatalf("Unexpected spawn error: %v", err)
+	}
+	movement := NewMovementSystem()
+	// when
+	// ... it tries to walk up the cliff, then back down it
+	enemy.Velocity = rl.Vector3{X: 1}
+	movement.Update(world, 0.1)
+	blockedX := enemy.Position.X
+	enemy.Velocity = rl.Vector3{X: -1}
+	movement.Update(world, 0.1)
+	// then
+	// ... should hold still going up and move going down
+	if blockedX != 2 {
+		t.Fatalf("Expected enemy held at x 2, got %f", blockedX)
+	}
+	if enemy.Position.X >= 2 {
+		t.Fatalf("Expected enemy to walk downhill, got x %f", enemy.Position.X)
+	}
+}
+
+func TestBulletsStopWhenTheyHitTheGround(t *testing.T) {
+	// given
+	// ... a bullet at height 1 flying up the ramp
+	world := testWorld()
+	heightmap, err := terrain.New(5, 2, []float32{
+		0, 0, 0.25, 1, 1,
+		0, 0, 0.25, 1, 1,
+	}, rl.Vector3{X: 20, Y: 8, Z: 20})
+	if err != nil {
+		t.Fatalf("Unexpected heightmap error: %v", err)
+	}
+	heightmap.MaxSlope = 0.8
+	world.SetGround(heightmap)
+	bullet := NewBullet(rl.Vector3{X: -6, Y: 1}, rl.Vector3{X: 1}, 10, 5, 5)
+	bullet.ID = "bullet_1"
+	if err := world.Spawn(bullet); err != nil {
+		t.Fatalf("Unexpected spawn error: %v", err)
+	}
+	movement := NewMovementSystem()
+	ground := NewGroundSystem()
+	// when
+	// ... it flies for a second
+	for range 60 {
+		movement.Update(world, testMoveFrame)
+		ground.Update(world, testMoveFrame)
+		if !bullet.IsActive() {
+			break
+		}
+	}
+	// then
+	// ... should stop where the ramp rises above it
+	if bullet.IsActive() {
+		t.Fatal("Expected the bullet to stop in the ramp")
+	}
+	if bullet.Position.X < -2.5 || bullet.Position.X > -2 {
+		t.Fatalf("Expected the bullet to stop near x -2.5, got %f", bullet.Position.X)
+	}
+}
+
+func TestSpawnSetsWalkersOnTheGround(t *testing.T) {
+	// given
+	// ... a world with terrain and an enemy placed at height 0 on the ramp
+	world := testWorld()
+	heightmap, err := terrain.New(5, 2, []float32{
+		0, 0, 0.25, 1, 1,
+		0, 0, 0.25, 1, 1,
+	}, rl.Vector3{X: 20, Y: 8, Z: 20})
+	if err != nil {
+		t.Fatalf("Unexpected heightmap error: %v", err)
+	}
+	heightmap.MaxSlope = 0.8
+	world.SetGround(heightmap)
+	enemy := NewEnemy(rl.Vector3{X: -2.5}, 50, 2)
+	enemy.ID = "enemy_1"
+	// when
+	// ... the enemy is spawned
+	if err := world.Spawn(enemy); err != nil {
+		t.Fatalf("Unexpected spawn error: %v", err)
+	}
+	// then
+	// ... should stand on the ramp
+	if enemy.Position.Y != 1 {
+		t.Fatalf("Expected enemy at height 1, got %f", enemy.Position.Y)
+	}
+}
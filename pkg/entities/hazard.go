@@ -0,0 +1,94 @@
+package entities
+
+import (
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/globals"
+)
+
+// DefaultHazardInterval is how often a hazard re-applies its effect to an
+// entity standing in it
+const DefaultHazardInterval = 1.0
+
+// HazardZone is a ground area that applies a status effect to anything
+// standing in it
+type HazardZone struct {
+	ID string
+	TransformComponent
+	ColliderComponent
+	RenderableComponent
+	Effect        StatusEffect
+	ApplyInterval float32 // Seconds between applications to the same entity
+	Active        bool
+
+	cooldowns map[string]float32
+}
+
+func NewHazardZone(pos rl.Vector3, radius float32, effect StatusEffect) *HazardZone {
+	return &HazardZone{
+		TransformComponent: TransformComponent{Position: pos},
+		ColliderComponent: ColliderComponent{
+			Shape:  ColliderCylinder,
+			Radius: radius,
+			Height: 0.2,
+		},
+		RenderableComponent: RenderableComponent{Color: StatusColor(effect.Type)},
+		Effect:              effect,
+		ApplyInterval:       DefaultHazardInterval,
+		Active:              true,
+		cooldowns:           make(map[string]float32),
+	}
+}
+
+func (h *HazardZone) Components() Components {
+	return Components{
+		Transform:  &h.TransformComponent,
+		Collider:   &h.ColliderComponent,
+		Renderable: &h.RenderableComponent,
+	}
+}
+
+// Update counts down the per-entity re-application cooldowns
+func (h *HazardZone) Update(deltaTime float32) {
+	for id, remaining := range h.cooldowns {
+		remaining -= deltaTime
+		if remaining <= 0 {
+			delete(h.cooldowns, id)
+			continue
+		}
+		h.cooldowns[id] = remaining
+	}
+}
+
+func (h *HazardZone) GetID() string {
+	return h.ID
+}
+
+func (h *HazardZone) GetTriggerBounds() rl.BoundingBox {
+	return h.Bounds(h.Position)
+}
+
+func (h *HazardZone) GetTriggerTags() []string {
+	return []string{"hazard"}
+}
+
+// OnTriggerEnter applies the hazard's effect to a receiver standing in it,
+// at most once per ApplyInterval
+func (h *HazardZone) OnTriggerEnter(other globals.Collidable) {
+	receiver, ok := other.(StatusReceiver)
+	if !ok || !receiver.IsAlive() {
+		return
+	}
+	if _, cooling := h.cooldowns[receiver.GetID()]; cooling {
+		return
+	}
+
+	effect := h.Effect
+	effect.SourceID = h.ID
+	receiver.ApplyStatusEffect(effect)
+	h.cooldowns[receiver.GetID()] = h.ApplyInterval
+}
+
+func (h *HazardZone) IsActive() bool {
+	return h.Active
+}
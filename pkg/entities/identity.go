@@ -0,0 +1,98 @@
+package entities
+
+import (
+	"fmt"
+)
+
+// PlayerID is the stable ID given to the player entity
+const PlayerID = "player"
+
+// Identifiable is implemented by every runtime entity that carries a stable ID
+type Identifiable interface {
+	GetID() string
+}
+
+// IDGenerator hands out IDs for entities spawned at runtime, such as bullets
+type IDGenerator struct {
+	counters map[string]int
+}
+
+// NewIDGenerator creates a new ID generator
+func NewIDGenerator() *IDGenerator {
+	return &IDGenerator{
+		counters: make(map[string]int),
+	}
+}
+
+// Next returns the next ID for the given prefix, e.g. "bullet_1", "bullet_2"
+func (g *IDGenerator) Next(prefix string) string {
+	g.counters[prefix]++
+	return fmt.Sprintf("%s_%d", prefix, g.counters[prefix])
+}
+
+// Reset restarts numbering for every prefix
+func (g *IDGenerator) Reset() {
+	g.counters = make(map[string]int)
+}
+
+// Registry looks up runtime entities by their ID
+type Registry struct {
+	entities map[string]Identifiable
+	ids      *IDGenerator
+}
+
+// NewRegistry creates an empty entity registry
+func NewRegistry() *Registry {
+	return &Registry{
+		entities: make(map[string]Identifiable),
+		ids:      NewIDGenerator(),
+	}
+}
+
+// Register adds an entity to the registry. The entity must have a non-empty,
+// unused ID.
+func (r *Registry) Register(entity Identifiable) error {
+	id := entity.GetID()
+	if id == "" {
+		return fmt.Errorf("cannot register entity with empty ID")
+	}
+	if _, exists := r.entities[id]; exists {
+		return fmt.Errorf("duplicate entity ID: %s", id)
+	}
+
+	r.entities[id] = entity
+	return nil
+}
+
+// Unregister removes the entity with the given ID, if present
+func (r *Registry) Unregister(id string) {
+	delete(r.entities, id)
+}
+
+// Get returns the entity with the given ID
+func (r *Registry) Get(id string) (Identifiable, bool) {
+	entity, exists := r.entities[id]
+	return entity, exists
+}
+
+// NextID generates an ID for a runtime spawn that does not clash with any
+// registered entity, including IDs loaded from scene data
+func (r *Registry) NextID(prefix string) string {
+	for {
+		id := r.ids.Next(prefix)
+		if _, exists := r.entities[id]; !exists {
+			return id
+		}
+	}
+}
+
+// Len returns the number of registered entities
+func (r *Registry) Len() int {
+	return len(r.entities)
+}
+
+// Clear removes all entities and restarts ID generation
+func (r *Registry) Clear() {
+	r.entities = make(map[string]Identifiable)
+	r.ids.Reset()
+}
@@ -0,0 +1,125 @@
+package entities
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+func TestRegistryLooksUpEntitiesByID(t *testing.T) {
+	// given
+	// ... an empty registry
+	// ... an enemy and a player with stable IDs
+	registry := NewRegistry()
+	enemy := NewEnemy(rl.Vector3{X: 1, Y: 0, Z: 1}, 50.0, 2.0)
+	enemy.ID = "enemy_1"
+	player := NewPlayer(5.0, nil)
+	// when
+	// ... both entities are registered
+	if err := registry.Register(enemy); err != nil {
+		t.Fatalf("Unexpected error registering enemy: %v", err)
+	}
+	if err := registry.Register(player); err != nil {
+		t.Fatalf("Unexpected error registering player: %v", err)
+	}
+	// then
+	// ... should find the enemy by its scene ID
+	// ... and should find the player by the reserved player ID
+	found, ok := registry.Get("enemy_1")
+	if !ok || found != enemy {
+		t.Fatal("Expected to find enemy_1 in registry")
+	}
+	found, ok = registry.Get(PlayerID)
+	if !ok || found != player {
+		t.Fatal("Expected to find player in registry")
+	}
+	if registry.Len() != 2 {
+		t.Fatalf("Expected 2 registered entities, got %d", registry.Len())
+	}
+}
+
+func TestRegistryRejectsDuplicateAndEmptyIDs(t *testing.T) {
+	// given
+	// ... a registry with one registered enemy
+	registry := NewRegistry()
+	first := NewEnemy(rl.Vector3{}, 50.0, 2.0)
+	first.ID = "enemy_1"
+	if err := registry.Register(first); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// when
+	// ... registering another enemy with the same ID
+	// ... and registering an enemy without an ID
+	duplicate := NewEnemy(rl.Vector3{}, 50.0, 2.0)
+	duplicate.ID = "enemy_1"
+	duplicateErr := registry.Register(duplicate)
+	emptyErr := registry.Register(NewEnemy(rl.Vector3{}, 50.0, 2.0))
+	// then
+	// ... both registrations should fail
+	// ... and the original enemy should still be registered
+	if duplicateErr == nil {
+		t.Fatal("Expected error for duplicate ID")
+	}
+	if emptyErr == nil {
+		t.Fatal("Expected error for empty ID")
+	}
+	found, _ := registry.Get("enemy_1")
+	if found != first {
+		t.Fatal("Original enemy should remain registered")
+	}
+}
+
+func TestRegistryGeneratesUniqueRuntimeIDs(t *testing.T) {
+	// given
+	// ... a registry already holding an entity named like a generated ID
+	registry := NewRegistry()
+	existing := NewBullet(rl.Vector3{}, rl.Vector3{X: 1}, 10, 1, 5)
+	existing.ID = "bullet_1"
+	if err := registry.Register(existing); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// when
+	// ... generating IDs for two runtime bullets
+	firstID := registry.NextID("bullet")
+	secondID := registry.NextID("bullet")
+	// then
+	// ... should skip the ID already in use
+	// ... and should not repeat IDs
+	if firstID != "bullet_2" {
+		t.Fatalf("Expected bullet_2, got %s", firstID)
+	}
+	if secondID != "bullet_3" {
+		t.Fatalf("Expected bullet_3, got %s", secondID)
+	}
+}
+
+func TestRegistryUnregisterAndClear(t *testing.T) {
+	// given
+	// ... a registry with a registered pickup
+	registry := NewRegistry()
+	pickup := NewHealthPickup(rl.Vector3{})
+	pickup.ID = "health_pickup_1"
+	if err := registry.Register(pickup); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// when
+	// ... the pickup is unregistered
+	registry.Unregister("health_pickup_1")
+	// then
+	// ... should no longer be found
+	if _, ok := registry.Get("health_pickup_1"); ok {
+		t.Fatal("Pickup should have been unregistered")
+	}
+	// when
+	// ... IDs are generated and the registry is cleared
+	registry.NextID("bullet")
+	registry.Clear()
+	// then
+	// ... should be empty and restart ID numbering
+	if registry.Len() != 0 {
+		t.Fatalf("Expected empty registry, got %d", registry.Len())
+	}
+	if id := registry.NextID("bullet"); id != "bullet_1" {
+		t.Fatalf("Expected numbering to restart at bullet_1, got %s", id)
+	}
+}
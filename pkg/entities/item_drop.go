@@ -0,0 +1,83 @@
+package entities
+
+import (
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/events"
+	"arpg/pkg/globals"
+	"arpg/pkg/items"
+)
+
+// ItemDrop is an item lying in the world waiting to be picked up
+type ItemDrop struct {
+	ID string
+	TransformComponent
+	ColliderComponent
+	RenderableComponent
+	Item   *items.Item
+	Active bool
+}
+
+func NewItemDrop(pos rl.Vector3, item *items.Item) *ItemDrop {
+	return &ItemDrop{
+		TransformComponent: TransformComponent{Position: pos},
+		ColliderComponent: ColliderComponent{
+			Shape:  ColliderSphere,
+			Radius: 0.3,
+		},
+		RenderableComponent: RenderableComponent{Color: rl.Gold},
+		Item:                item,
+		Active:              true,
+	}
+}
+
+func (d *ItemDrop) Components() Components {
+	return Components{
+		Transform:  &d.TransformComponent,
+		Collider:   &d.ColliderComponent,
+		Renderable: &d.RenderableComponent,
+	}
+}
+
+func (d *ItemDrop) GetID() string {
+	return d.ID
+}
+
+func (d *ItemDrop) GetBoundingBox() rl.BoundingBox {
+	return d.Bounds(d.Position)
+}
+
+func (d *ItemDrop) GetCollisionTags() []string {
+	return []string{"item_drop"}
+}
+
+func (d *ItemDrop) GetTriggerBounds() rl.BoundingBox {
+	return d.GetBoundingBox()
+}
+
+func (d *ItemDrop) GetTriggerTags() []string {
+	return []string{"item_drop"}
+}
+
+// OnTriggerEnter moves the item into the player's inventory and reports the
+// pickup. The drop stays in the world while the inventory is full.
+func (d *ItemDrop) OnTriggerEnter(other globals.Collidable) {
+	if !d.Active {
+		return
+	}
+
+	player, ok := other.(*Player)
+	if !ok {
+		return
+	}
+	quantity := d.Item.Quantity // Stacking into the inventory may use it up
+	if err := player.GiveItem(d.Item); err != nil {
+		return
+	}
+	d.Active = false
+	player.notifyPickup(events.NewPickupCollectedEvent(d.ID, "item", float32(quantity), d.Position))
+}
+
+func (d *ItemDrop) IsActive() bool {
+	return d.Active
+}
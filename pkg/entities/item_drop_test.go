@@ -0,0 +1,60 @@
+package entities
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/events"
+	"arpg/pkg/items"
+)
+
+func TestItemDropMovesIntoInventory(t *testing.T) {
+	// given
+	// ... a sword lying in the world
+	bus := &MockEventBus{}
+	player := NewPlayer(5.0, bus)
+	item := &items.Item{UID: "sword_1", Quantity: 1, Definition: &items.Definition{
+		ID: "sword", Name: "Sword", Slot: items.SlotWeapon, Width: 1, Height: 3, MaxStack: 1,
+	}}
+	drop := NewItemDrop(rl.Vector3{}, item)
+	drop.ID = "drop_1"
+	// when
+	// ... the player walks over it
+	drop.OnTriggerEnter(player)
+	// then
+	// ... the sword should be in the inventory and the drop inactive
+	// ... with the pickup reported
+	if _, found := player.Inventory.Get(item.UID); !found {
+		t.Fatal("Expected sword in the inventory")
+	}
+	if drop.IsActive() {
+		t.Fatal("Expected drop to be inactive after pickup")
+	}
+	if countEvents(bus, events.EventTypePickupCollected) != 1 {
+		t.Fatalf("Expected 1 pickup collected event, got %+v", bus.events)
+	}
+	pickup := bus.events[len(bus.events)-1].Data.(events.PickupEvent)
+	if pickup.PickupID != "drop_1" || pickup.PickupType != "item" || pickup.Amount != 1 {
+		t.Fatalf("Expected 1 item picked up from drop_1, got %+v", pickup)
+	}
+}
+
+func TestItemDropStaysWhenInventoryFull(t *testing.T) {
+	// given
+	// ... a player whose inventory has no room for a sword
+	player := NewPlayer(5.0, nil)
+	player.Inventory = items.NewInventory(1, 1)
+	item := &items.Item{UID: "sword_1", Quantity: 1, Definition: &items.Definition{
+		ID: "sword", Name: "Sword", Slot: items.SlotWeapon, Width: 1, Height: 3, MaxStack: 1,
+	}}
+	drop := NewItemDrop(rl.Vector3{}, item)
+	// when
+	// ... the player walks over it
+	drop.OnTriggerEnter(player)
+	// then
+	// ... the drop should stay in the world
+	if !drop.IsActive() {
+		t.Fatal("Expected drop to stay active")
+	}
+}
@@ -0,0 +1,121 @@
+package entities
+
+import (
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/globals"
+)
+
+// Default movement tuning, in units per second squared
+const (
+	DefaultMoveAcceleration = 40.0
+	DefaultMoveDeceleration = 30.0
+)
+
+// MovementComponent turns a 2D movement input into a ground velocity that
+// ramps up to the top speed and coasts to a stop when the input is released
+type MovementComponent struct {
+	MoveAcceleration float32 // How fast the velocity approaches the input
+	MoveDeceleration float32 // How fast the velocity bleeds off with no input
+
+	moveVelocity rl.Vector2 // Ground velocity on the X/Z plane
+}
+
+// NewMovementComponent creates a movement controller with the default tuning
+func NewMovementComponent() MovementComponent {
+	return MovementComponent{
+		MoveAcceleration: DefaultMoveAcceleration,
+		MoveDeceleration: DefaultMoveDeceleration,
+	}
+}
+
+// MoveVelocity returns the current ground velocity, X along world X and Y
+// along world Z
+func (m *MovementComponent) MoveVelocity() rl.Vector2 {
+	return m.moveVelocity
+}
+
+// StopMoving drops the ground velocity to zero
+func (m *MovementComponent) StopMoving() {
+	m.moveVelocity = rl.Vector2{}
+}
+
+// Steer advances the velocity towards input scaled by maxSpeed and returns
+// the displacement for this frame. Inputs longer than one are clamped so
+// diagonals are no faster than straight lines; shorter ones from an analog
+// stick walk proportionally slower. A non-positive rate snaps straight to
+// the target.
+func (m *MovementComponent) Steer(input rl.Vector2, maxSpeed, deltaTime float32) rl.Vector2 {
+	if length := rl.Vector2Length(input); length > 1 {
+		input = rl.Vector2Scale(input, 1/length)
+	}
+
+	target := rl.Vector2Scale(input, maxSpeed)
+	rate := m.MoveAcceleration
+	if rl.Vector2Length(input) == 0 {
+		rate = m.MoveDeceleration
+	}
+	m.moveVelocity = approach(m.moveVelocity, target, rate*deltaTime)
+
+	return rl.Vector2Scale(m.moveVelocity, deltaTime)
+}
+
+// approach moves current towards target by at most maxDelta
+func approach(current, target rl.Vector2, maxDelta float32) rl.Vector2 {
+	diff := rl.Vector2Subtract(target, current)
+	distance := rl.Vector2Length(diff)
+	if maxDelta <= 0 || distance <= maxDelta {
+		return target
+	}
+	return rl.Vector2Add(current, rl.Vector2Scale(diff, maxDelta/distance))
+}
+
+// moveAxis returns the movement input as a 2D vector, X along world X and
+// Y along world Z. Held keys count as a full push and are added to the
+// analog stick, and the result is clamped to unit length.
+func moveAxis() rl.Vector2 {
+	axis := globals.InputSystem.GetMoveAxis()
+	if globals.InputSystem.IsUpDown() {
+		axis.Y--
+	}
+	if globals.InputSystem.IsDownDown() {
+		axis.Y++
+	}
+	if globals.InputSystem.IsLeftDown() {
+		axis.X--
+	}
+	if globals.InputSystem.IsRightDown() {
+		axis.X++
+	}
+	if length := rl.Vector2Length(axis); length > 1 {
+		axis = rl.Vector2Scale(axis, 1/length)
+	}
+	return axis
+}
+
+// move steers the player with input and applies the displacement through
+// the collision resolver one axis at a time, so the player slides along
+// walls and loses its speed into whatever blocks it
+func (p *Player) move(input rl.Vector2, deltaTime float32) {
+	maxSpeed := p.Speed * p.SpeedMultiplier()
+	step := p.Steer(input, maxSpeed, deltaTime)
+
+	if step.X != 0 {
+		newPos := p.Position
+		newPos.X += step.X
+		if globals.Collision.CheckMovement(p, newPos) {
+			p.Position = newPos
+		} else {
+			p.moveVelocity.X = 0
+		}
+	}
+	if step.Y != 0 {
+		newPos := p.Position
+		newPos.Z += step.Y
+		if globals.Collision.CheckMovement(p, newPos) {
+			p.Position = newPos
+		} else {
+			p.moveVelocity.Y = 0
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package entities
+
+import (
+	"math"
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+const testMoveFrame = float32(1.0 / 60.0)
+
+func TestDiagonalMovementIsNoFasterThanStraight(t *testing.T) {
+	// given
+	// ... two players in an empty world, one walking along +X and one
+	// ... holding two keys for a diagonal
+	world := testWorld()
+	straight := NewPlayer(5.0, &MockEventBus{})
+	diagonal := NewPlayer(5.0, &MockEventBus{})
+	diagonal.ID = "diagonal"
+	diagonal.Position = rl.Vector3{Z: 10}
+	for _, entity := range []Entity{straight, diagonal} {
+		if err := world.Spawn(entity); err != nil {
+			t.Fatalf("Unexpected spawn error: %v", err)
+		}
+	}
+	// when
+	// ... both move for two seconds
+	for range 120 {
+		straight.move(rl.Vector2{X: 1}, testMoveFrame)
+		diagonal.move(rl.Vector2{X: 1, Y: 1}, testMoveFrame)
+	}
+	// then
+	// ... should both top out at the player speed
+	// ... and cover the same distance
+	if speed := rl.Vector2Length(diagonal.MoveVelocity()); math.Abs(float64(speed-5)) > 0.001 {
+		t.Fatalf("Expected diagonal speed 5, got %f", speed)
+	}
+	straightDistance := rl.Vector3Length(straight.Position)
+	diagonalDistance := rl.Vector3Distance(diagonal.Position, rl.Vector3{Z: 10})
+	if math.Abs(float64(straightDistance-diagonalDistance)) > 0.001 {
+		t.Fatalf("Expected equal distances, got %f straight and %f diagonal", straightDistance, diagonalDistance)
+	}
+}
+
+func TestMovementAcceleratesToMaxSpeed(t *testing.T) {
+	// given
+	// ... a resting movement controller
+	movement := NewMovementComponent()
+	// when
+	// ... the input is held for one frame, then long enough to reach the top
+	movement.Steer(rl.Vector2{X: 1}, 5, testMoveFrame)
+	first := movement.MoveVelocity().X
+	for range 60 {
+		movement.Steer(rl.Vector2{X: 1}, 5, testMoveFrame)
+	}
+	// then
+	// ... should only gain one frame of acceleration at first
+	// ... and settle at max speed without overshooting
+	if want := float32(DefaultMoveAcceleration) * testMoveFrame; math.Abs(float64(first-want)) > 0.0001 {
+		t.Fatalf("Expected first frame speed %f, got %f", want, first)
+	}
+	if speed := movement.MoveVelocity().X; speed != 5 {
+		t.Fatalf("Expected speed 5, got %f", speed)
+	}
+}
+
+func TestMovementStoppingDistance(t *testing.T) {
+	// given
+	// ... a player at full speed along +X
+	world := testWorld()
+	player := NewPlayer(5.0, &MockEventBus{})
+	if err := world.Spawn(player); err != nil {
+		t.Fatalf("Unexpected spawn error: %v", err)
+	}
+	for range 60 {
+		player.move(rl.Vector2{X: 1}, testMoveFrame)
+	}
+	start := player.Position.X
+	// when
+	// ... the input is released until the player comes to rest
+	for range 60 {
+		player.move(rl.Vector2{}, testMoveFrame)
+	}
+	// then
+	// ... should stop within a frame's travel of v²/2d
+	// ... and be at rest
+	want := 5.0 * 5.0 / (2 * DefaultMoveDeceleration)
+	if distance := float64(player.Position.X - start); math.Abs(distance-want) > 5*float64(testMoveFrame) {
+		t.Fatalf("Expected stopping distance %f, got %f", want, distance)
+	}
+	if speed := rl.Vector2Length(player.MoveVelocity()); speed != 0 {
+		t.Fatalf("Expected player at rest, got speed %f", speed)
+	}
+}
+
+func TestAnalogInputScalesSpeed(t *testing.T) {
+	// given
+	// ... a movement controller and a half-pushed stick
+	movement := NewMovementComponent()
+	// when
+	// ... the stick is held until the speed settles
+	for range 60 {
+		movement.Steer(rl.Vector2{Y: -0.5}, 6, testMoveFrame)
+	}
+	// then
+	// ... should move at half the max speed
+	if speed := movement.MoveVelocity().Y; speed != -3 {
+		t.Fatalf("Expected speed -3, got %f", speed)
+	}
+}
+
+func TestMovementIsBlockedByObstacles(t *testing.T) {
+	// given
+	// ... a player walking into a wall 2 units away
+	world := testWorld()
+	player := NewPlayer(5.0, &MockEventBus{})
+	wall := NewBoxObstacle(rl.Vector3{X: 2}, rl.Vector3{X: 1, Y: 2, Z: 4}, rl.Gray)
+	wall.ID = "wall"
+	for _, entity := range []Entity{player, wall} {
+		if err := world.Spawn(entity); err != nil {
+			t.Fatalf("Unexpected spawn error: %v", err)
+		}
+	}
+	// when
+	// ... the input is held into the wall
+	for range 120 {
+		player.move(rl.Vector2{X: 1}, testMoveFrame)
+	}
+	// then
+	// ... should stop in front of the wall
+	// ... and lose its speed into it
+	if player.Position.X >= 1.5 {
+		t.Fatalf("Expected player to stop before the wall, got x %f", player.Position.X)
+	}
+	if speed := player.MoveVelocity().X; speed > DefaultMoveAcceleration*testMoveFrame {
+		t.Fatalf("Expected speed lost into the wall, got %f", speed)
+	}
+}
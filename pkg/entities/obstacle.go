@@ -1,9 +1,6 @@
 package entities
 
 import (
-	"fmt"
-	"slices"
-
 	rl "github.com/gen2brain/raylib-go/raylib"
 
 	"arpg/pkg/globals"
@@ -16,68 +13,81 @@ const (
 	ObstacleTypeCylinder
 )
 
+// ObstacleDamagedFraction is the fraction of max health below which a
+// destructible obstacle shows as damaged
+const ObstacleDamagedFraction = 0.5
+
+// Obstacle blocks movement and projectiles. Destructible obstacles have
+// health, take damage from bullets and area skills and are removed from the
+// world once destroyed; the rest are permanent.
 type Obstacle struct {
-	Position rl.Vector3
-	Size     rl.Vector3
-	Radius   float32
-	Height   float32
-	Type     ObstacleType
-	Color    rl.Color
-	Active   bool
+	ID string
+	TransformComponent
+	ColliderComponent
+	HealthComponent
+	DefenseComponent
+	RenderableComponent
+	Type             ObstacleType
+	Destructible     bool
+	LootTable        string // Rolled when the obstacle is destroyed
+	LastDamageSource string // ID of the entity that last damaged the obstacle
+	Active           bool
 }
 
 func NewBoxObstacle(pos rl.Vector3, size rl.Vector3, color rl.Color) *Obstacle {
 	return &Obstacle{
-		Position: pos,
-		Size:     size,
-		Type:     ObstacleTypeBox,
-		Color:    color,
-		Active:   true,
+		TransformComponent: TransformComponent{Position: pos},
+		ColliderComponent: ColliderComponent{
+			Shape: ColliderBox,
+			Size:  size,
+		},
+		RenderableComponent: RenderableComponent{Color: color},
+		Type:                ObstacleTypeBox,
+		Active:              true,
 	}
 }
 
 func NewCylinderObstacle(pos rl.Vector3, radius, height float32, color rl.Color) *Obstacle {
 	return &Obstacle{
-		Position: pos,
-		Radius:   radius,
-		Height:   height,
-		Type:     ObstacleTypeCylinder,
-		Color:    color,
-		Active:   true,
+		TransformComponent: TransformComponent{Position: pos},
+		ColliderComponent: ColliderComponent{
+			Shape:  ColliderCylinder,
+			Radius: radius,
+			Height: height,
+		},
+		RenderableComponent: RenderableComponent{Color: color},
+		Type:                ObstacleTypeCylinder,
+		Active:              true,
 	}
 }
 
-func (o *Obstacle) GetBoundingBox() rl.BoundingBox {
-	switch o.Type {
-	case ObstacleTypeBox:
-		return rl.BoundingBox{
-			Min: rl.Vector3{
-				X: o.Position.X - o.Size.X/2,
-				Y: o.Position.Y - o.Size.Y/2,
-				Z: o.Position.Z - o.Size.Z/2,
-			},
-			Max: rl.Vector3{
-				X: o.Position.X + o.Size.X/2,
-				Y: o.Position.Y + o.Size.Y/2,
-				Z: o.Position.Z + o.Size.Z/2,
-			},
-		}
-	case ObstacleTypeCylinder:
-		return rl.BoundingBox{
-			Min: rl.Vector3{
-				X: o.Position.X - o.Radius,
-				Y: o.Position.Y,
-				Z: o.Position.Z - o.Radius,
-			},
-			Max: rl.Vector3{
-				X: o.Position.X + o.Radius,
-				Y: o.Position.Y + o.Height,
-				Z: o.Position.Z + o.Radius,
-			},
-		}
-	default:
-		return rl.BoundingBox{}
+func (o *Obstacle) GetID() string {
+	return o.ID
+}
+
+// Components exposes health and defence only for destructible obstacles
+func (o *Obstacle) Components() Components {
+	components := Components{
+		Transform:  &o.TransformComponent,
+		Collider:   &o.ColliderComponent,
+		Renderable: &o.RenderableComponent,
+	}
+	if o.Destructible {
+		components.Health = &o.HealthComponent
+		components.Defense = &o.DefenseComponent
 	}
+	return components
+}
+
+// MakeDestructible gives the obstacle health so it can be destroyed
+func (o *Obstacle) MakeDestructible(health float32) {
+	o.Destructible = true
+	o.Health = health
+	o.MaxHealth = health
+}
+
+func (o *Obstacle) GetBoundingBox() rl.BoundingBox {
+	return o.Bounds(o.Position)
 }
 
 func (o *Obstacle) IsBox() bool {
@@ -92,13 +102,52 @@ func (o *Obstacle) GetCollisionTags() []string {
 	return []string{"obstacle"}
 }
 
-func (o *Obstacle) OnCollision(other globals.Collidable) {
-	if slices.Contains(other.GetCollisionTags(), "player") {
-		o.Active = false
-		fmt.Println("Collision with obstacle detected:", other.GetCollisionTags())
-	}
-}
+// OnCollision does nothing; whatever runs into an obstacle is stopped by
+// its own collision handling
+func (o *Obstacle) OnCollision(other globals.Collidable) {}
 
 func (o *Obstacle) IsActive() bool {
-	return o.Active // Obstacles are always active
+	return o.Active
+}
+
+// GetFaction returns no faction, so anyone's attacks can break obstacles
+func (o *Obstacle) GetFaction() Faction {
+	return FactionNone
+}
+
+// IsAlive reports whether the obstacle can still take damage
+func (o *Obstacle) IsAlive() bool {
+	return o.Active && o.Destructible && o.Health > 0
+}
+
+// revive rebuilds an obstacle whose destruction was undone
+func (o *Obstacle) revive() {
+	o.Active = true
+}
+
+// IsDamaged reports whether a destructible obstacle has lost enough health
+// to show as damaged
+func (o *Obstacle) IsDamaged() bool {
+	return o.Destructible && o.Health <= o.MaxHealth*ObstacleDamagedFraction
+}
+
+// IsDestroyed reports whether a destructible obstacle has been broken
+func (o *Obstacle) IsDestroyed() bool {
+	return o.Destructible && o.Health <= 0
+}
+
+// TakeDamage applies a hit to a destructible obstacle, removing it from the
+// world at zero health. Indestructible obstacles ignore damage.
+func (o *Obstacle) TakeDamage(info DamageInfo) DamageResult {
+	if !o.IsAlive() {
+		return DamageResult{}
+	}
+	result := applyDamage(&o.HealthComponent, &o.DefenseComponent, info)
+	if info.SourceID != "" {
+		o.LastDamageSource = info.SourceID
+	}
+	if result.Killed {
+		o.Active = false
+	}
+	return result
 }
@@ -0,0 +1,121 @@
+package entities
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/globals"
+)
+
+func TestIndestructibleObstacleSurvivesContactAndBullets(t *testing.T) {
+	// given
+	// ... an indestructible obstacle
+	obstacle := NewBoxObstacle(rl.Vector3{}, rl.Vector3{X: 1, Y: 1, Z: 1}, rl.Brown)
+	bullet := NewBullet(rl.Vector3{}, rl.Vector3{X: 1}, 10, 1, 5)
+	bullet.ID = "bullet_1"
+	bullet.OwnerID = PlayerID
+	bullet.Faction = FactionPlayer
+	// when
+	// ... the player walks into it and a bullet hits it
+	obstacle.OnCollision(NewPlayer(5.0, nil))
+	bullet.OnCollision(obstacle)
+	// then
+	// ... should stay in the world and stop the bullet
+	if !obstacle.IsActive() {
+		t.Fatal("Indestructible obstacle should never disappear")
+	}
+	if bullet.Active {
+		t.Fatal("Bullet should stop on the obstacle")
+	}
+}
+
+func TestBulletsDamageAndDestroyDestructibleObstacle(t *testing.T) {
+	// given
+	// ... a destructible obstacle with 8 health
+	crate := NewBoxObstacle(rl.Vector3{X: 2}, rl.Vector3{X: 1, Y: 1, Z: 1}, rl.Brown)
+	crate.ID = "crate_1"
+	crate.MakeDestructible(8.0)
+	// when
+	// ... a 5 damage bullet hits it
+	playerBullet := NewBullet(rl.Vector3{}, rl.Vector3{X: 1}, 10, 1, 5)
+	playerBullet.OwnerID = PlayerID
+	playerBullet.Faction = FactionPlayer
+	playerBullet.OnCollision(crate)
+	// then
+	// ... should lose health and show as damaged
+	if crate.Health != 3.0 || !crate.IsDamaged() {
+		t.Fatalf("Expected a damaged obstacle with 3 health, got %f", crate.Health)
+	}
+	// when
+	// ... an enemy's bullet hits it
+	enemyBullet := NewBullet(rl.Vector3{}, rl.Vector3{X: 1}, 10, 1, 5)
+	enemyBullet.OwnerID = "enemy_1"
+	enemyBullet.Faction = FactionEnemy
+	enemyBullet.OnCollision(crate)
+	// then
+	// ... should be destroyed and credit the enemy
+	if !crate.IsDestroyed() || crate.IsActive() {
+		t.Fatal("Expected the obstacle to be destroyed")
+	}
+	if crate.LastDamageSource != "enemy_1" {
+		t.Fatalf("Expected enemy_1 to be credited, got %s", crate.LastDamageSource)
+	}
+}
+
+func TestDestroyedObstacleLeavesCollision(t *testing.T) {
+	// given
+	// ... a world with a destructible obstacle about to break
+	world := testWorld()
+	world.AddSystem("cleanup", OrderCleanup, NewCleanupSystem())
+	crate := NewBoxObstacle(rl.Vector3{X: 2}, rl.Vector3{X: 1, Y: 1, Z: 1}, rl.Brown)
+	crate.ID = "crate_1"
+	crate.MakeDestructible(5.0)
+	if err := world.Spawn(crate); err != nil {
+		t.Fatalf("Unexpected spawn error: %v", err)
+	}
+	var despawned Entity
+	world.OnDespawn(func(entity Entity) { despawned = entity })
+	// when
+	// ... it is destroyed and the world is updated
+	crate.TakeDamage(NewDamage(5.0, DamagePhysical, PlayerID))
+	world.Update(0.016)
+	// then
+	// ... should be despawned and no longer found by the collision system
+	if despawned != Entity(crate) {
+		t.Fatal("Expected the destroyed obstacle to be despawned")
+	}
+	if found := globals.Collision.QuerySphere(crate.Position, 1, "obstacle"); len(found) != 0 {
+		t.Fatalf("Expected no obstacles left in collision, got %d", len(found))
+	}
+}
+
+func TestFrostNovaDamagesDestructibleObstacles(t *testing.T) {
+	// given
+	// ... a player next to a destructible and an indestructible obstacle
+	world := testWorld()
+	player := NewPlayer(5.0, nil)
+	crate := NewBoxObstacle(rl.Vector3{X: 2}, rl.Vector3{X: 1, Y: 1, Z: 1}, rl.Brown)
+	crate.ID = "crate_1"
+	crate.MakeDestructible(100.0)
+	wall := NewBoxObstacle(rl.Vector3{X: -2}, rl.Vector3{X: 1, Y: 1, Z: 1}, rl.Brown)
+	wall.ID = "wall_1"
+	for _, entity := range []Entity{player, crate, wall} {
+		if err := world.Spawn(entity); err != nil {
+			t.Fatalf("Unexpected spawn error: %v", err)
+		}
+	}
+	// when
+	// ... frost nova is cast
+	if err := player.CastSkill(SkillSlotQ, SkillTarget{}); err != nil {
+		t.Fatalf("Expected cast to succeed, got %v", err)
+	}
+	// then
+	// ... should damage the destructible obstacle and leave the wall standing
+	if crate.Health >= 100 {
+		t.Fatalf("Expected the crate to take damage, got health %f", crate.Health)
+	}
+	if !wall.IsActive() {
+		t.Fatal("Expected the wall to be unaffected")
+	}
+}
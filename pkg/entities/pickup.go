@@ -0,0 +1,306 @@
+package entities
+
+import (
+	"fmt"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/events"
+	"arpg/pkg/globals"
+)
+
+// PickupType names what a pickup gives the player
+type PickupType string
+
+const (
+	PickupHealth     PickupType = "health"
+	PickupMana       PickupType = "mana"
+	PickupAmmo       PickupType = "ammo"
+	PickupGold       PickupType = "gold"
+	PickupSpeedBuff  PickupType = "speed_buff"
+	PickupDamageBuff PickupType = "damage_buff"
+	PickupXP         PickupType = "xp"
+)
+
+// Pickup defaults
+const (
+	DefaultPickupRadius      = 0.3
+	DefaultPickupMagnetSpeed = 6.0
+	defaultHealAmount        = 25.0
+)
+
+// PickupEffect gives a collected pickup to the player, reporting false when
+// the player has no use for it so the pickup stays in the world
+type PickupEffect func(player *Player, definition *PickupDefinition) bool
+
+// pickupEffects maps each pickup type to what collecting it does
+var pickupEffects = map[PickupType]PickupEffect{
+	PickupHealth:     collectHealth,
+	PickupMana:       collectMana,
+	PickupAmmo:       collectAmmo,
+	PickupGold:       collectGold,
+	PickupSpeedBuff:  buffEffect(StatMoveSpeed),
+	PickupDamageBuff: buffEffect(StatDamage),
+	PickupXP:         collectXP,
+}
+
+// IsKnownPickupType reports whether pickupType has an effect
+func IsKnownPickupType(pickupType PickupType) bool {
+	_, ok := pickupEffects[pickupType]
+	return ok
+}
+
+// IsBuffPickup reports whether pickupType grants a timed buff
+func IsBuffPickup(pickupType PickupType) bool {
+	return pickupType == PickupSpeedBuff || pickupType == PickupDamageBuff
+}
+
+// PickupDefinition describes one kind of pickup. Amount is health, mana,
+// rounds, gold or XP, or the increased fraction for buffs.
+type PickupDefinition struct {
+	ID            string
+	Type          PickupType
+	Amount        float32
+	Duration      float32 // Seconds a buff lasts
+	Radius        float32
+	MagnetRadius  float32 // Pulled toward the player within this distance; 0 disables
+	MagnetSpeed   float32
+	CollectRadius float32 // Collected without touching within this distance; 0 disables
+	Lifetime      float32 // Seconds before despawning uncollected; 0 never despawns
+	Color         rl.Color
+}
+
+// PickupRegistry holds pickup definitions by ID
+type PickupRegistry struct {
+	Definitions map[string]*PickupDefinition
+}
+
+// NewPickupRegistry creates an empty registry
+func NewPickupRegistry() *PickupRegistry {
+	return &PickupRegistry{Definitions: make(map[string]*PickupDefinition)}
+}
+
+// Register adds definition, rejecting duplicate IDs and unknown types
+func (r *PickupRegistry) Register(definition *PickupDefinition) error {
+	if definition.ID == "" {
+		return fmt.Errorf("pickup definition ID cannot be empty")
+	}
+	if !IsKnownPickupType(definition.Type) {
+		return fmt.Errorf("pickup %s: unknown type %q", definition.ID, definition.Type)
+	}
+	if _, exists := r.Definitions[definition.ID]; exists {
+		return fmt.Errorf("pickup %s: already registered", definition.ID)
+	}
+	r.Definitions[definition.ID] = definition
+	return nil
+}
+
+// Get returns the definition registered under id
+func (r *PickupRegistry) Get(id string) (*PickupDefinition, bool) {
+	definition, ok := r.Definitions[id]
+	return definition, ok
+}
+
+// Pickup is a collectible lying in the world. It is collected by touching it
+// or by coming within its collect radius, drifts toward a nearby player and
+// despawns once its lifetime runs out.
+type Pickup struct {
+	ID string
+	TransformComponent
+	ColliderComponent
+	RenderableComponent
+	Definition *PickupDefinition
+	Remaining  float32 // Seconds left before despawning, when the definition has a lifetime
+	Active     bool
+}
+
+func NewPickup(pos rl.Vector3, definition *PickupDefinition) *Pickup {
+	radius := definition.Radius
+	if radius <= 0 {
+		radius = DefaultPickupRadius
+	}
+	return &Pickup{
+		TransformComponent: TransformComponent{Position: pos},
+		ColliderComponent: ColliderComponent{
+			Shape:  ColliderSphere,
+			Radius: radius,
+		},
+		RenderableComponent: RenderableComponent{Color: definition.Color},
+		Definition:          definition,
+		Remaining:           definition.Lifetime,
+		Active:              true,
+	}
+}
+
+// NewHealthPickup creates a pickup that heals the player for a fixed amount
+func NewHealthPickup(pos rl.Vector3) *Pickup {
+	return NewPickup(pos, &PickupDefinition{
+		ID:     string(PickupHealth),
+		Type:   PickupHealth,
+		Amount: defaultHealAmount,
+		Color:  rl.Green,
+	})
+}
+
+func (p *Pickup) Components() Components {
+	return Components{
+		Transform:  &p.TransformComponent,
+		Collider:   &p.ColliderComponent,
+		Renderable: &p.RenderableComponent,
+	}
+}
+
+func (p *Pickup) GetID() string {
+	return p.ID
+}
+
+func (p *Pickup) GetBoundingBox() rl.BoundingBox {
+	return p.Bounds(p.Position)
+}
+
+func (p *Pickup) GetCollisionTags() []string {
+	return []string{"pickup"}
+}
+
+func (p *Pickup) GetTriggerBounds() rl.BoundingBox {
+	return p.GetBoundingBox()
+}
+
+func (p *Pickup) GetTriggerTags() []string {
+	return []string{"pickup"}
+}
+
+// OnTriggerEnter collects the pickup when the player touches it
+func (p *Pickup) OnTriggerEnter(other globals.Collidable) {
+	if player, ok := other.(*Player); ok {
+		p.Collect(player)
+	}
+}
+
+func (p *Pickup) IsActive() bool {
+	return p.Active
+}
+
+// Expiring reports whether the pickup has a lifetime with under seconds left
+func (p *Pickup) Expiring(seconds float32) bool {
+	return p.Definition.Lifetime > 0 && p.Remaining < seconds
+}
+
+// Collect applies the pickup to player and removes it from the world,
+// reporting whether the player took it
+func (p *Pickup) Collect(player *Player) bool {
+	if !p.Active || !player.IsAlive() {
+		return false
+	}
+	if !pickupEffects[p.Definition.Type](player, p.Definition) {
+		return false
+	}
+	p.Active = false
+
+	player.notifyPickup(events.NewPickupCollectedEvent(
+		p.ID, string(p.Definition.Type), p.Definition.Amount, p.Position,
+	))
+	if p.Definition.Type == PickupHealth {
+		player.notifyPickup(events.NewHealthPickupEvent(p.Definition.Amount, p.ID, p.Position, player.Health))
+	}
+	return true
+}
+
+// update counts down the pickup's lifetime, pulls it toward player inside
+// its magnet radius and collects it inside its collect radius
+func (p *Pickup) update(deltaTime float32, player *Player) {
+	if !p.Active {
+		return
+	}
+	if p.Definition.Lifetime > 0 {
+		p.Remaining -= deltaTime
+		if p.Remaining <= 0 {
+			p.Active = false
+			if player != nil {
+				player.notifyPickup(events.NewPickupExpiredEvent(
+					p.ID, string(p.Definition.Type), p.Definition.Amount, p.Position,
+				))
+			}
+			return
+		}
+	}
+	if player == nil || !player.IsAlive() {
+		return
+	}
+
+	toPlayer := rl.Vector3{X: player.Position.X - p.Position.X, Z: player.Position.Z - p.Position.Z}
+	distance := rl.Vector3Length(toPlayer)
+	if distance <= p.Definition.CollectRadius && p.Collect(player) {
+		return
+	}
+	if distance > 0 && distance <= p.Definition.MagnetRadius {
+		speed := p.Definition.MagnetSpeed
+		if speed <= 0 {
+			speed = DefaultPickupMagnetSpeed
+		}
+		step := min(speed*deltaTime, distance)
+		p.Position = rl.Vector3Add(p.Position, rl.Vector3Scale(toPlayer, step/distance))
+	}
+}
+
+// NewPickupSystem ages, attracts and auto-collects every pickup in the world
+func NewPickupSystem() System {
+	return SystemFunc(func(w *World, deltaTime float32) {
+		player := w.Player()
+		for _, pickup := range OfType[*Pickup](w) {
+			pickup.update(deltaTime, player)
+		}
+	})
+}
+
+func (p *Player) notifyPickup(event events.Event) {
+	if p.eventBus == nil {
+		return
+	}
+	if err := p.eventBus.Notify(event); err != nil {
+		fmt.Printf("Error notifying pickup: %v\n", err)
+	}
+}
+
+func collectHealth(player *Player, definition *PickupDefinition) bool {
+	if player.Health >= player.MaxHealth {
+		return false
+	}
+	player.Heal(definition.Amount)
+	return true
+}
+
+func collectMana(player *Player, definition *PickupDefinition) bool {
+	if player.Mana >= player.MaxMana {
+		return false
+	}
+	player.Mana = min(player.Mana+definition.Amount, player.MaxMana)
+	return true
+}
+
+// collectAmmo refills the held weapon's magazine; an amount of 0 fills it
+func collectAmmo(player *Player, definition *PickupDefinition) bool {
+	weapon := player.ActiveWeapon()
+	return weapon != nil && weapon.Refill(int(definition.Amount))
+}
+
+func collectGold(player *Player, definition *PickupDefinition) bool {
+	player.Gold += int(definition.Amount)
+	return true
+}
+
+func collectXP(player *Player, definition *PickupDefinition) bool {
+	player.GainXP(definition.Amount)
+	return true
+}
+
+// buffEffect grants increased stat for the pickup's duration. Collecting the
+// same buff again refreshes it rather than stacking.
+func buffEffect(stat StatType) PickupEffect {
+	return func(player *Player, definition *PickupDefinition) bool {
+		source := "pickup_" + definition.ID
+		player.RemoveModifierSource(source)
+		player.AddModifier(NewTimedModifier(stat, ModifierIncreased, definition.Amount, source, definition.Duration))
+		return true
+	}
+}
@@ -0,0 +1,197 @@
+package entities
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/events"
+)
+
+func countEvents(bus *MockEventBus, eventType string) int {
+	count := 0
+	for _, event := range bus.events {
+		if event.Type == eventType {
+			count++
+		}
+	}
+	return count
+}
+
+func TestHealthPickupEmitsEvents(t *testing.T) {
+	// given
+	// ... a hurt player with a mock event bus and a health pickup
+	bus := &MockEventBus{}
+	player := NewPlayer(5.0, bus)
+	player.Health = 50.0
+	pickup := NewHealthPickup(rl.Vector3{})
+	pickup.ID = "health_pickup_1"
+	// when
+	// ... the player collects the pickup
+	collected := pickup.Collect(player)
+	// then
+	// ... should heal the player and emit collected and health pickup events
+	if !collected || pickup.IsActive() {
+		t.Fatal("Expected the pickup to be collected")
+	}
+	if player.Health != 75.0 {
+		t.Fatalf("Expected player health 75.0, got %f", player.Health)
+	}
+	if countEvents(bus, events.EventTypePickupCollected) != 1 || countEvents(bus, events.EventTypeHealthPickup) != 1 {
+		t.Fatalf("Expected pickup collected and health pickup events, got %v", bus.events)
+	}
+}
+
+func TestPickupStaysWhenPlayerHasNoUseForIt(t *testing.T) {
+	// given
+	// ... a player at full health and mana with a full magazine
+	player := NewPlayer(5.0, nil)
+	player.SetWeapons([]*WeaponDefinition{{ID: "pistol", FireRate: 1, ProjectileCount: 1, MagazineSize: 6}})
+	health := NewHealthPickup(rl.Vector3{})
+	mana := NewPickup(rl.Vector3{}, &PickupDefinition{ID: "mana", Type: PickupMana, Amount: 20})
+	ammo := NewPickup(rl.Vector3{}, &PickupDefinition{ID: "ammo", Type: PickupAmmo})
+	// when
+	// ... the player tries to collect each pickup
+	collected := health.Collect(player) || mana.Collect(player) || ammo.Collect(player)
+	// then
+	// ... none should be taken
+	if collected || !health.IsActive() || !mana.IsActive() || !ammo.IsActive() {
+		t.Fatal("Expected pickups the player cannot use to stay in the world")
+	}
+}
+
+func TestPickupEffectsByType(t *testing.T) {
+	// given
+	// ... a player with an emptied magazine and spent mana
+	player := NewPlayer(5.0, nil)
+	player.SetWeapons([]*WeaponDefinition{{ID: "pistol", FireRate: 1, ProjectileCount: 1, MagazineSize: 6, ReloadTime: 1}})
+	player.ActiveWeapon().Ammo = 0
+	player.Mana = 0
+	baseSpeed := player.Speed
+	baseDamage := player.BulletDamage
+	definitions := []*PickupDefinition{
+		{ID: "mana", Type: PickupMana, Amount: 20},
+		{ID: "ammo", Type: PickupAmmo, Amount: 4},
+		{ID: "gold", Type: PickupGold, Amount: 15},
+		{ID: "haste", Type: PickupSpeedBuff, Amount: 0.5, Duration: 5},
+		{ID: "fury", Type: PickupDamageBuff, Amount: 1, Duration: 5},
+		{ID: "xp", Type: PickupXP, Amount: 30},
+	}
+	// when
+	// ... a pickup of each type is collected
+	for _, definition := range definitions {
+		if !NewPickup(rl.Vector3{}, definition).Collect(player) {
+			t.Fatalf("Expected %s pickup to be collected", definition.ID)
+		}
+	}
+	// then
+	// ... each should apply its effect
+	if player.Mana != 20 {
+		t.Fatalf("Expected 20 mana, got %f", player.Mana)
+	}
+	if player.ActiveWeapon().Ammo != 4 || player.ActiveWeapon().IsReloading() {
+		t.Fatalf("Expected 4 rounds and no reload, got %d", player.ActiveWeapon().Ammo)
+	}
+	if player.Gold != 15 {
+		t.Fatalf("Expected 15 gold, got %d", player.Gold)
+	}
+	if player.Speed != baseSpeed*1.5 || player.BulletDamage != baseDamage*2 {
+		t.Fatalf("Expected buffed speed and damage, got %f and %f", player.Speed, player.BulletDamage)
+	}
+	if player.XP != 30 {
+		t.Fatalf("Expected 30 XP, got %f", player.XP)
+	}
+}
+
+func TestBuffPickupRefreshesInsteadOfStacking(t *testing.T) {
+	// given
+	// ... a player who has collected a speed buff
+	player := NewPlayer(5.0, nil)
+	baseSpeed := player.Speed
+	definition := &PickupDefinition{ID: "haste", Type: PickupSpeedBuff, Amount: 0.5, Duration: 5}
+	NewPickup(rl.Vector3{}, definition).Collect(player)
+	player.UpdateStats(4)
+	// when
+	// ... the same buff is collected again
+	NewPickup(rl.Vector3{}, definition).Collect(player)
+	player.UpdateStats(4)
+	// then
+	// ... should keep a single buff, still active after the first would have expired
+	if player.Speed != baseSpeed*1.5 {
+		t.Fatalf("Expected one refreshed buff, got speed %f", player.Speed)
+	}
+	player.UpdateStats(2)
+	if player.Speed != baseSpeed {
+		t.Fatalf("Expected the buff to expire, got speed %f", player.Speed)
+	}
+}
+
+func TestPickupSystemAttractsAndAutoCollects(t *testing.T) {
+	// given
+	// ... a world with a player and an XP orb inside its magnet radius
+	bus := &MockEventBus{}
+	world := testWorld()
+	world.AddSystem("pickups", OrderTick, NewPickupSystem())
+	player := NewPlayer(5.0, bus)
+	orb := NewPickup(rl.Vector3{X: 3, Y: 0.3}, &PickupDefinition{
+		ID: "xp_orb", Type: PickupXP, Amount: 10, MagnetRadius: 4, MagnetSpeed: 5, CollectRadius: 0.5,
+	})
+	orb.ID = "xp_orb_1"
+	if err := world.Spawn(player); err != nil {
+		t.Fatalf("Unexpected spawn error: %v", err)
+	}
+	if err := world.Spawn(orb); err != nil {
+		t.Fatalf("Unexpected spawn error: %v", err)
+	}
+	// when
+	// ... the world is updated once, then until the orb arrives
+	world.Update(0.1)
+	pulled := orb.Position.X
+	for range 10 {
+		world.Update(0.1)
+	}
+	// then
+	// ... the orb should drift toward the player and be collected on arrival
+	if pulled != 2.5 {
+		t.Fatalf("Expected the orb to move to x=2.5, got %f", pulled)
+	}
+	if orb.IsActive() || player.XP != 10 {
+		t.Fatalf("Expected the orb to be collected, got active %v and %f XP", orb.IsActive(), player.XP)
+	}
+	if countEvents(bus, events.EventTypePickupCollected) != 1 {
+		t.Fatalf("Expected 1 pickup collected event, got %d", countEvents(bus, events.EventTypePickupCollected))
+	}
+}
+
+func TestPickupDespawnsAfterLifetime(t *testing.T) {
+	// given
+	// ... a world with a player and a distant gold pile that lasts one second
+	bus := &MockEventBus{}
+	world := testWorld()
+	world.AddSystem("pickups", OrderTick, NewPickupSystem())
+	player := NewPlayer(5.0, bus)
+	gold := NewPickup(rl.Vector3{X: 10}, &PickupDefinition{ID: "gold", Type: PickupGold, Amount: 5, Lifetime: 1})
+	gold.ID = "gold_1"
+	if err := world.Spawn(player); err != nil {
+		t.Fatalf("Unexpected spawn error: %v", err)
+	}
+	if err := world.Spawn(gold); err != nil {
+		t.Fatalf("Unexpected spawn error: %v", err)
+	}
+	// when
+	// ... more than a second passes
+	world.Update(0.6)
+	expiring := gold.Expiring(0.5)
+	world.Update(0.6)
+	// then
+	// ... should warn before expiring, then despawn with an expired event
+	if !expiring {
+		t.Fatal("Expected the pickup to report it is about to expire")
+	}
+	if gold.IsActive() || player.Gold != 0 {
+		t.Fatal("Expected the pickup to despawn uncollected")
+	}
+	if countEvents(bus, events.EventTypePickupExpired) != 1 {
+		t.Fatalf("Expected 1 pickup expired event, got %d", countEvents(bus, events.EventTypePickupExpired))
+	}
+}
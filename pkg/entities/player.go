@@ -8,55 +8,170 @@ import (
 
 	"arpg/pkg/events"
 	"arpg/pkg/globals"
+	"arpg/pkg/items"
 )
 
 type Player struct {
-	Position  rl.Vector3
-	Rotation  float32
-	Speed     float32
-	Radius    float32
-	Height    float32
-	Health    float32
-	MaxHealth float32
-	eventBus  events.Subject // Injected dependency for events
+	ID string
+	TransformComponent
+	VelocityComponent
+	ColliderComponent
+	HealthComponent
+	DefenseComponent
+	StatusComponent
+	RenderableComponent
+	ExperienceComponent
+	StatsComponent
+	ManaComponent
+	SkillBarComponent
+	DodgeComponent
+	MovementComponent
+
+	BulletDamage   float32
+	BulletSpeed    float32
+	BulletLifetime float32
+	CritChance     float32
+
+	Inventory *items.Inventory
+	Equipment *items.Equipment
+	Gold      int
+
+	Weapons      []*Weapon
+	activeWeapon int
+
+	HurtInvulnerability float32 // Seconds hits are ignored after taking damage
+	hurtRemaining       float32
+
+	levelCurve *LevelCurve
+	eventBus   events.Subject // Injected dependency for events
 }
 
+// Player base stats before modifiers
+const (
+	playerBaseMaxHealth      = 100.0
+	playerBaseBulletDamage   = 25.0
+	playerBaseBulletSpeed    = 15.0
+	playerBaseBulletLifetime = 3.0
+	playerBaseMaxMana        = 100.0
+	playerBaseManaRegen      = 5.0
+
+	hurtFlashInterval = 0.1 // Seconds between visibility toggles while flashing
+)
+
+// DefaultHurtInvulnerability is how long, in seconds, the player ignores hits
+// after taking damage
+const DefaultHurtInvulnerability = 0.6
+
 func NewPlayer(speed float32, eventBus events.Subject) *Player {
-	return &Player{
-		Position:  rl.Vector3{X: 0, Y: 0, Z: 0},
-		Rotation:  0,
-		Speed:     speed,
-		Radius:    0.5,
-		Height:    1.0,
-		Health:    100.0,
-		MaxHealth: 100.0,
-		eventBus:  eventBus,
+	player := &Player{
+		ID: PlayerID,
+		TransformComponent: TransformComponent{
+			Position: rl.Vector3{X: 0, Y: 0, Z: 0},
+			Rotation: 0,
+		},
+		VelocityComponent: VelocityComponent{Speed: speed},
+		ColliderComponent: ColliderComponent{
+			Shape:  ColliderCylinder,
+			Radius: 0.5,
+			Height: 1.0,
+		},
+		BulletSpeed:         playerBaseBulletSpeed,
+		BulletLifetime:      playerBaseBulletLifetime,
+		HurtInvulnerability: DefaultHurtInvulnerability,
+		HealthComponent:     HealthComponent{Health: 100.0, MaxHealth: 100.0},
+		RenderableComponent: RenderableComponent{Color: rl.Blue},
+		ExperienceComponent: ExperienceComponent{Level: 1},
+		StatsComponent:      StatsComponent{Stats: NewStatSheet()},
+		DodgeComponent:      NewDodgeComponent(),
+		MovementComponent:   NewMovementComponent(),
+		Inventory:           items.NewInventory(PlayerInventoryWidth, PlayerInventoryHeight),
+		Equipment:           items.NewEquipment(),
+		Weapons:             []*Weapon{NewWeapon(DefaultWeapon())},
+		levelCurve:          DefaultLevelCurve(),
+		eventBus:            eventBus,
 	}
+	player.Stats.SetBase(StatMaxHealth, playerBaseMaxHealth)
+	player.Stats.SetBase(StatMoveSpeed, speed)
+	player.Stats.SetBase(StatDamage, playerBaseBulletDamage)
+	player.Stats.SetBase(StatMaxMana, playerBaseMaxMana)
+	player.Stats.SetBase(StatManaRegen, playerBaseManaRegen)
+	player.syncStats()
+	player.Mana = player.MaxMana
+	for slot, skill := range DefaultSkills() {
+		player.SetSkill(slot, skill)
+	}
+	return player
 }
 
 type CameraInterface interface {
 	GetWorldPositionFromMouse(mousePos rl.Vector2) rl.Vector3
 }
 
-func (p *Player) Update(
-	deltaTime float32,
-	obstacles []*Obstacle,
-	camera CameraInterface,
-) {
+func (p *Player) Update(deltaTime float32, camera CameraInterface) {
 	p.updateRotation(camera)
 
-	p.updateMovement(deltaTime, obstacles)
+	// Cooldowns, reloads and mana keep running while stunned
+	for _, weapon := range p.Weapons {
+		weapon.Update(deltaTime)
+	}
+	p.updateSkills(deltaTime)
+	p.updateDodge(deltaTime)
+	p.updateInvulnerability(deltaTime)
+
+	if !p.CanAct() {
+		p.CancelCast() // Stuns interrupt casting
+		p.StopMoving()
+		return
+	}
+	if p.IsDodging() {
+		p.StopMoving()
+		return // Committed to the roll
+	}
+	if globals.InputSystem.IsDodgePressed() {
+		if err := p.Dodge(p.movementInput()); err == nil {
+			return
+		}
+	}
+	if p.IsCasting() {
+		p.StopMoving()
+		return // Rooted until the cast completes
+	}
+
+	p.move(moveAxis(), deltaTime)
 
-	if globals.InputSystem.IsMouseLeftPressed() {
+	// Holding the button keeps firing at the weapon's fire rate
+	if globals.InputSystem.IsMouseLeftDown() {
 		p.shoot(camera)
 	}
+
+	for slot := range p.Skills {
+		if p.isSkillSlotPressed(slot) {
+			p.reportCastError(slot, p.CastSkill(slot, p.skillTarget(camera)))
+			break
+		}
+	}
 }
 
-func (p *Player) shoot(camera CameraInterface) {
-	if p.eventBus == nil {
-		return // Cannot shoot without event bus
+// isSkillSlotPressed reports whether the input bound to a skill bar slot was
+// pressed this frame
+func (p *Player) isSkillSlotPressed(slot int) bool {
+	if slot == SkillSlotRightMouse {
+		return globals.InputSystem.IsMouseRightPressed()
 	}
+	return globals.InputSystem.IsSkillKeyPressed(slot)
+}
 
+// skillTarget aims a skill at the point under the mouse
+func (p *Player) skillTarget(camera CameraInterface) SkillTarget {
+	point := camera.GetWorldPositionFromMouse(globals.InputSystem.GetMousePosition())
+	point.Y = p.Position.Y
+	return SkillTarget{
+		Direction: rl.Vector3Normalize(rl.Vector3Subtract(point, p.Position)),
+		Point:     point,
+	}
+}
+
+func (p *Player) shoot(camera CameraInterface) {
 	gunTip := p.GetGunTip()
 
 	mousePos := globals.InputSystem.GetMousePosition()
@@ -68,22 +183,84 @@ func (p *Player) shoot(camera CameraInterface) {
 		Z: worldPos.Z - gunTip.Z,
 	}
 
-	direction = rl.Vector3Normalize(direction)
+	p.FireWeapon(rl.Vector3Normalize(direction))
+}
+
+// FireWeapon fires the active weapon toward direction if it is ready,
+// emitting a bullet spawn event per projectile. It reports whether a shot
+// was fired.
+func (p *Player) FireWeapon(direction rl.Vector3) bool {
+	if p.eventBus == nil {
+		return false // Cannot shoot without event bus
+	}
+
+	weapon := p.ActiveWeapon()
+	if weapon == nil || !weapon.CanFire() {
+		return false
+	}
+	definition := weapon.Definition
+
+	gunTip := p.GetGunTip()
+	for _, projectileDirection := range SpreadDirections(direction, definition.ProjectileCount, definition.SpreadAngle) {
+		// Each projectile rolls its own critical strike
+		damage, critical := p.rollDamage()
+
+		// Create and emit bullet spawn event using the new Observer pattern
+		bulletEvent := events.NewBulletSpawnEventFromData(events.BulletSpawnEvent{
+			Position:  gunTip,
+			Direction: projectileDirection,
+			Speed:     p.BulletSpeed * definition.SpeedMultiplier,
+			Lifetime:  p.BulletLifetime,
+			Damage:    damage * definition.DamageMultiplier,
+			OwnerID:   p.ID,
+			Faction:   string(FactionPlayer),
+			Critical:  critical,
+			Modifiers: definition.Projectile,
+		})
+
+		// Notify observers of the bullet spawn event
+		if err := p.eventBus.Notify(bulletEvent); err != nil {
+			// Log error but don't prevent gameplay
+			fmt.Printf("Error notifying bullet spawn: %v\n", err)
+		}
+	}
+
+	weapon.consumeShot()
+	return true
+}
+
+// SetWeapons replaces the player's weapons and selects the first
+func (p *Player) SetWeapons(definitions []*WeaponDefinition) {
+	if len(definitions) == 0 {
+		return
+	}
+	p.Weapons = make([]*Weapon, len(definitions))
+	for i, definition := range definitions {
+		p.Weapons[i] = NewWeapon(definition)
+	}
+	p.activeWeapon = 0
+}
+
+// ActiveWeapon returns the weapon the player is holding
+func (p *Player) ActiveWeapon() *Weapon {
+	if p.activeWeapon >= len(p.Weapons) {
+		return nil
+	}
+	return p.Weapons[p.activeWeapon]
+}
 
-	// Create and emit bullet spawn event using the new Observer pattern
-	bulletEvent := events.NewBulletSpawnEvent(
-		gunTip,
-		direction,
-		15.0, // Speed
-		3.0,  // Lifetime
-		25.0, // Damage
-	)
+// ActiveWeaponIndex returns the position of the held weapon in Weapons
+func (p *Player) ActiveWeaponIndex() int {
+	return p.activeWeapon
+}
 
-	// Notify observers of the bullet spawn event
-	if err := p.eventBus.Notify(bulletEvent); err != nil {
-		// Log error but don't prevent gameplay
-		fmt.Printf("Error notifying bullet spawn: %v\n", err)
+// SwitchWeapon selects the weapon at index, reporting whether it exists
+func (p *Player) SwitchWeapon(index int) bool {
+	if index < 0 || index >= len(p.Weapons) {
+		return false
 	}
+	p.activeWeapon = index
+	return true
 }
 
 func (p *Player) updateRotation(camera CameraInterface) {
@@ -95,55 +272,36 @@ func (p *Player) updateRotation(camera CameraInterface) {
 	p.Rotation = float32(math.Atan2(float64(deltaZ), float64(deltaX)))
 }
 
-func (p *Player) updateMovement(deltaTime float32, obstacles []*Obstacle) {
-	moveSpeed := p.Speed * deltaTime
-
-	if globals.InputSystem.IsUpDown() {
-		newPos := p.Position
-		newPos.Z -= moveSpeed
-		if globals.Collision.CheckMovement(p, newPos) {
-			p.Position = newPos
-		}
-	}
-
-	if globals.InputSystem.IsDownDown() {
-		newPos := p.Position
-		newPos.Z += moveSpeed
-		if globals.Collision.CheckMovement(p, newPos) {
-			p.Position = newPos
-		}
+// movementInput returns the unit direction of the movement input, or zero
+// when there is none
+func (p *Player) movementInput() rl.Vector3 {
+	axis := moveAxis()
+	direction := rl.Vector3{X: axis.X, Z: axis.Y}
+	if rl.Vector3Length(direction) == 0 {
+		return direction
 	}
+	return rl.Vector3Normalize(direction)
+}
 
-	if globals.InputSystem.IsLeftDown() {
-		newPos := p.Position
-		newPos.X -= moveSpeed
-		if globals.Collision.CheckMovement(p, newPos) {
-			p.Position = newPos
-		}
-	}
+func (p *Player) GetID() string {
+	return p.ID
+}
 
-	if globals.InputSystem.IsRightDown() {
-		newPos := p.Position
-		newPos.X += moveSpeed
-		if globals.Collision.CheckMovement(p, newPos) {
-			p.Position = newPos
-		}
+func (p *Player) Components() Components {
+	return Components{
+		Transform:  &p.TransformComponent,
+		Health:     &p.HealthComponent,
+		Collider:   &p.ColliderComponent,
+		Velocity:   &p.VelocityComponent,
+		Renderable: &p.RenderableComponent,
+		Defense:    &p.DefenseComponent,
+		Status:     &p.StatusComponent,
+		Stats:      &p.StatsComponent,
 	}
 }
 
 func (p *Player) GetBoundingBox() rl.BoundingBox {
-	return rl.BoundingBox{
-		Min: rl.Vector3{
-			X: p.Position.X - p.Radius,
-			Y: p.Position.Y,
-			Z: p.Position.Z - p.Radius,
-		},
-		Max: rl.Vector3{
-			X: p.Position.X + p.Radius,
-			Y: p.Position.Y + p.Height,
-			Z: p.Position.Z + p.Radius,
-		},
-	}
+	return p.Bounds(p.Position)
 }
 
 func (p *Player) GetCollisionTags() []string {
@@ -173,20 +331,48 @@ func (p *Player) GetDirection() rl.Vector3 {
 	}
 }
 
-func (p *Player) TakeDamage(damage float32) {
-	oldHealth := p.Health
-	p.Health -= damage
-	if p.Health < 0 {
-		p.Health = 0
+// IsInvulnerable reports whether incoming hits are currently ignored, either
+// mid-dodge or just after being hurt
+func (p *Player) IsInvulnerable() bool {
+	return p.IsDodging() || p.hurtRemaining > 0
+}
+
+// IsFlashing reports whether the player should be drawn hidden this frame.
+// The player blinks on and off while post-hit invulnerability lasts.
+func (p *Player) IsFlashing() bool {
+	if p.hurtRemaining <= 0 {
+		return false
+	}
+	return int(p.hurtRemaining/hurtFlashInterval)%2 == 0
+}
+
+// updateInvulnerability counts down the window after taking a hit
+func (p *Player) updateInvulnerability(deltaTime float32) {
+	p.hurtRemaining = max(p.hurtRemaining-deltaTime, 0)
+}
+
+// TakeDamage applies a hit after armor and resistances and reports it on
+// the event bus. Hits landing while the player is invulnerable are ignored,
+// and a hit that deals damage starts a short invulnerability window.
+// Damage over time ticks neither start nor respect the window.
+func (p *Player) TakeDamage(info DamageInfo) DamageResult {
+	if !info.Periodic && p.IsInvulnerable() {
+		return DamageResult{}
+	}
+
+	result := applyDamage(&p.HealthComponent, &p.DefenseComponent, info)
+	if !info.Periodic && result.Dealt > 0 && !result.Killed {
+		p.hurtRemaining = p.HurtInvulnerability
 	}
 
 	// Emit player damaged event if event bus is available
 	if p.eventBus != nil {
 		damageEvent := events.NewPlayerDamagedEvent(
-			damage,
-			"enemy", // TODO: Make this dynamic based on damage source
+			result.Dealt,
+			info.SourceID,
 			p.Health,
 			p.Position,
+			result.Breakdown(info),
 		)
 
 		if err := p.eventBus.Notify(damageEvent); err != nil {
@@ -194,7 +380,7 @@ func (p *Player) TakeDamage(damage float32) {
 		}
 
 		// Emit game over event if player died
-		if oldHealth > 0 && p.Health <= 0 {
+		if result.Killed {
 			gameOverEvent := events.NewGameOverEvent(
 				"player_died",
 				0, // TODO: Implement score system
@@ -206,6 +392,27 @@ func (p *Player) TakeDamage(damage float32) {
 			}
 		}
 	}
+
+	return result
+}
+
+// ApplyStatusEffect applies a timed effect and reports it on the event bus.
+// Effects are shrugged off mid-dodge; a hit that just landed still applies
+// its effects during the invulnerability that follows it.
+func (p *Player) ApplyStatusEffect(effect StatusEffect) {
+	if p.IsDodging() {
+		return
+	}
+	applyStatusEffect(p, &p.StatusComponent, p.eventBus, effect)
+}
+
+// UpdateStatus ticks the player's status effects
+func (p *Player) UpdateStatus(deltaTime float32) {
+	updateStatus(p, &p.StatusComponent, p.eventBus, deltaTime)
+}
+
+func (p *Player) GetFaction() Faction {
+	return FactionPlayer
 }
 
 func (p *Player) IsAlive() bool {
@@ -218,3 +425,43 @@ func (p *Player) Heal(amount float32) {
 		p.Health = p.MaxHealth
 	}
 }
+
+// SetBaseStat sets the unmodified value of stat and refreshes derived fields
+func (p *Player) SetBaseStat(stat StatType, value float32) {
+	p.Stats.SetBase(stat, value)
+	p.syncStats()
+}
+
+// AddModifier layers a stat modifier onto the player
+func (p *Player) AddModifier(modifier Modifier) {
+	p.Stats.AddModifier(modifier)
+	p.syncStats()
+}
+
+// RemoveModifierSource removes every modifier from source
+func (p *Player) RemoveModifierSource(source string) {
+	if p.Stats.RemoveSource(source) {
+		p.syncStats()
+	}
+}
+
+// UpdateStats expires timed modifiers
+func (p *Player) UpdateStats(deltaTime float32) {
+	if p.Stats.Tick(deltaTime) {
+		p.syncStats()
+	}
+}
+
+// syncStats copies final stat values into the fields gameplay code reads
+func (p *Player) syncStats() {
+	p.MaxHealth = p.Stats.Get(StatMaxHealth)
+	p.Health = min(p.Health, p.MaxHealth)
+	p.Speed = p.Stats.Get(StatMoveSpeed)
+	p.BulletDamage = p.Stats.Get(StatDamage)
+	p.CritChance = p.Stats.Get(StatCritChance)
+	p.Armor = p.Stats.Get(StatArmor)
+	p.AllResistance = p.Stats.Get(StatAllResistance)
+	p.MaxMana = p.Stats.Get(StatMaxMana)
+	p.Mana = min(p.Mana, p.MaxMana)
+	p.ManaRegen = p.Stats.Get(StatManaRegen)
+}
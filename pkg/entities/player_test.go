@@ -36,7 +36,7 @@ func TestPlayerTakeDamageEmitsEvents(t *testing.T) {
 	player.MaxHealth = 100.0
 	// when
 	// ... the player takes damage but doesn't die
-	player.TakeDamage(25.0)
+	player.TakeDamage(NewDamage(25.0, DamagePhysical, "enemy_1"))
 	// then
 	// ... should emit exactly one player damaged event
 	// ... and should be a player damaged event
@@ -58,8 +58,11 @@ func TestPlayerTakeDamageEmitsEvents(t *testing.T) {
 	if damageData.NewHealth != 75.0 {
 		t.Fatalf("Expected new health 75.0, got %f", damageData.NewHealth)
 	}
-	if damageData.Source != "enemy" {
-		t.Fatalf("Expected source 'enemy', got %s", damageData.Source)
+	if damageData.Source != "enemy_1" {
+		t.Fatalf("Expected source 'enemy_1', got %s", damageData.Source)
+	}
+	if damageData.Breakdown.RawDamage != 25.0 || damageData.Breakdown.DamageType != "physical" {
+		t.Fatalf("Unexpected damage breakdown: %+v", damageData.Breakdown)
 	}
 }
 
@@ -73,7 +76,7 @@ func TestPlayerDeathEmitsGameOverEvent(t *testing.T) {
 	player.MaxHealth = 100.0
 	// when
 	// ... the player takes fatal damage
-	player.TakeDamage(15.0)
+	player.TakeDamage(NewDamage(15.0, DamagePhysical, "enemy_1"))
 	// then
 	// ... should emit two events: player damaged and game over
 	// ... first event should be player damaged
@@ -114,3 +117,87 @@ type MockCamera struct {
 func (m *MockCamera) GetWorldPositionFromMouse(mousePos rl.Vector2) rl.Vector3 {
 	return m.worldPos
 }
+
+func TestHurtInvulnerabilityIgnoresFollowUpHits(t *testing.T) {
+	// given
+	// ... a player with a mock event bus who has just been hit
+	mockEventBus := &MockEventBus{}
+	player := NewPlayer(5.0, mockEventBus)
+	player.Health = 100.0
+	player.MaxHealth = 100.0
+	player.TakeDamage(NewDamage(10.0, DamagePhysical, "enemy_1"))
+	// when
+	// ... a second hit lands inside the invulnerability window
+	ignored := player.TakeDamage(NewDamage(10.0, DamagePhysical, "enemy_2"))
+	// then
+	// ... should deal no damage and emit only the first damaged event
+	// ... and should flash while the window lasts
+	if ignored.Dealt != 0 || player.Health != 90.0 {
+		t.Fatalf("Expected the second hit to be ignored, got %f dealt and %f health", ignored.Dealt, player.Health)
+	}
+	if len(mockEventBus.events) != 1 {
+		t.Fatalf("Expected 1 event, got %d", len(mockEventBus.events))
+	}
+	if !player.IsInvulnerable() {
+		t.Fatal("Expected player to be invulnerable after a hit")
+	}
+	flashed := false
+	for range 3 {
+		player.updateInvulnerability(hurtFlashInterval)
+		flashed = flashed || player.IsFlashing()
+	}
+	if !flashed {
+		t.Fatal("Expected player to flash during the invulnerability window")
+	}
+}
+
+func TestHurtInvulnerabilityExpires(t *testing.T) {
+	// given
+	// ... a player with a mock event bus who has just been hit
+	mockEventBus := &MockEventBus{}
+	player := NewPlayer(5.0, mockEventBus)
+	player.Health = 100.0
+	player.MaxHealth = 100.0
+	player.TakeDamage(NewDamage(10.0, DamagePhysical, "enemy_1"))
+	// when
+	// ... the window runs out and the player is hit again
+	player.updateInvulnerability(player.HurtInvulnerability)
+	visible := !player.IsFlashing()
+	player.TakeDamage(NewDamage(10.0, DamagePhysical, "enemy_2"))
+	// then
+	// ... should have stopped flashing and take the second hit
+	if !visible {
+		t.Fatal("Expected player to stop flashing once the window expires")
+	}
+	if player.Health != 80.0 || len(mockEventBus.events) != 2 {
+		t.Fatalf("Expected 80 health and 2 events, got %f and %d", player.Health, len(mockEventBus.events))
+	}
+}
+
+func TestPeriodicDamageIgnoresHurtInvulnerability(t *testing.T) {
+	// given
+	// ... a player with a mock event bus
+	mockEventBus := &MockEventBus{}
+	player := NewPlayer(5.0, mockEventBus)
+	player.Health = 100.0
+	player.MaxHealth = 100.0
+	tick := NewDamage(5.0, DamageFire, "burn")
+	tick.Periodic = true
+	// when
+	// ... a damage over time tick lands, followed by a hit and another tick
+	player.TakeDamage(tick)
+	hitDealt := player.TakeDamage(NewDamage(10.0, DamagePhysical, "enemy_1")).Dealt
+	tickDealt := player.TakeDamage(tick).Dealt
+	// then
+	// ... the first tick should not start the window
+	// ... and ticks should still land inside the window
+	if hitDealt != 10.0 {
+		t.Fatalf("Expected the hit after a tick to deal 10, got %f", hitDealt)
+	}
+	if tickDealt != 5.0 {
+		t.Fatalf("Expected the tick inside the window to deal 5, got %f", tickDealt)
+	}
+	if len(mockEventBus.events) != 3 {
+		t.Fatalf("Expected 3 events, got %d", len(mockEventBus.events))
+	}
+}
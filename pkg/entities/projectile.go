@@ -0,0 +1,232 @@
+package entities
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/events"
+	"arpg/pkg/globals"
+)
+
+// Projectile modifier defaults for ranges left at zero
+const (
+	DefaultChainRange  = 6.0
+	DefaultHomingRange = 8.0
+
+	bounceClearance = 0.01 // Gap left between a bounced projectile and the surface
+)
+
+// ProjectileModifiers are behaviours layered on a projectile. The type is
+// declared with the bullet spawn event so every layer shares one definition.
+type ProjectileModifiers = events.ProjectileModifiers
+
+// ValidateProjectileModifiers checks every modifier is non-negative
+func ValidateProjectileModifiers(m ProjectileModifiers) error {
+	if m.Pierce < 0 || m.Bounces < 0 || m.Chains < 0 {
+		return fmt.Errorf("pierce, bounces and chains cannot be negative")
+	}
+	if m.ChainRange < 0 || m.HomingTurnRate < 0 || m.HomingRange < 0 ||
+		m.ExplosionRadius < 0 || m.ExplosionDamage < 0 {
+		return fmt.Errorf("ranges, turn rate and explosion values cannot be negative")
+	}
+	return nil
+}
+
+func chainRange(m ProjectileModifiers) float32 {
+	if m.ChainRange > 0 {
+		return m.ChainRange
+	}
+	return DefaultChainRange
+}
+
+func homingRange(m ProjectileModifiers) float32 {
+	if m.HomingRange > 0 {
+		return m.HomingRange
+	}
+	return DefaultHomingRange
+}
+
+func explosionDamage(m ProjectileModifiers) float32 {
+	if m.ExplosionDamage > 0 {
+		return m.ExplosionDamage
+	}
+	return 1
+}
+
+// afterHit decides what the bullet does once it has damaged a target:
+// chain to a new one, pierce through, or stop
+func (b *Bullet) afterHit() {
+	switch {
+	case b.Modifiers.Chains > 0 && b.chain():
+		b.Modifiers.Chains--
+	case b.Modifiers.Pierce > 0:
+		b.Modifiers.Pierce--
+	default:
+		b.stop()
+	}
+}
+
+// stop ends the bullet, exploding it if it has an explosion
+func (b *Bullet) stop() {
+	b.explode()
+	b.Deactivate()
+}
+
+// chain turns the bullet toward the nearest target within chain range it
+// has not hit yet, reporting whether one was found
+func (b *Bullet) chain() bool {
+	target, ok := b.nearestTarget(chainRange(b.Modifiers))
+	if !ok {
+		return false
+	}
+	direction := horizontalDirection(b.Position, target)
+	if direction == (rl.Vector3{}) {
+		return false
+	}
+	b.Velocity = direction
+	return true
+}
+
+// home turns the bullet toward the nearest target in homing range, by at
+// most the homing turn rate
+func (b *Bullet) home(deltaTime float32) {
+	if b.Modifiers.HomingTurnRate <= 0 {
+		return
+	}
+	target, ok := b.nearestTarget(homingRange(b.Modifiers))
+	if !ok {
+		return
+	}
+	desired := horizontalDirection(b.Position, target)
+	if desired == (rl.Vector3{}) {
+		return
+	}
+
+	current := math.Atan2(float64(b.Velocity.Z), float64(b.Velocity.X))
+	wanted := math.Atan2(float64(desired.Z), float64(desired.X))
+	turn := math.Remainder(wanted-current, 2*math.Pi)
+	maxTurn := float64(b.Modifiers.HomingTurnRate * deltaTime)
+	turn = math.Max(-maxTurn, math.Min(maxTurn, turn))
+
+	length := math.Hypot(float64(b.Velocity.X), float64(b.Velocity.Z))
+	b.Velocity.X = float32(math.Cos(current+turn) * length)
+	b.Velocity.Z = float32(math.Sin(current+turn) * length)
+}
+
+// nearestTarget returns the centre of the closest living, hostile,
+// vulnerable entity within radius that the bullet has not hit yet
+func (b *Bullet) nearestTarget(radius float32) (rl.Vector3, bool) {
+	if globals.Collision == nil {
+		return rl.Vector3{}, false
+	}
+
+	var nearest rl.Vector3
+	nearestDistance := float32(math.MaxFloat32)
+	found := false
+	for _, collidable := range globals.Collision.QuerySphere(b.Position, radius, "enemy", "player") {
+		if !b.canDamage(collidable) {
+			continue
+		}
+		box := collidable.GetBoundingBox()
+		center := rl.Vector3Scale(rl.Vector3Add(box.Min, box.Max), 0.5)
+		distance := rl.Vector3Distance(b.Position, center)
+		if distance < nearestDistance {
+			nearest, nearestDistance, found = center, distance, true
+		}
+	}
+	return nearest, found
+}
+
+// canDamage reports whether other is a living, vulnerable target the bullet
+// may hurt and has not already hit
+func (b *Bullet) canDamage(other globals.Collidable) bool {
+	target, ok := other.(Damageable)
+	if !ok || !target.IsAlive() || !b.CanHit(target) || slices.Contains(b.hitIDs, target.GetID()) {
+		return false
+	}
+	if invulnerable, ok := other.(Invulnerable); ok && invulnerable.IsInvulnerable() {
+		return false
+	}
+	return true
+}
+
+// bounce reflects the bullet off obstacle using the contact normal and
+// moves it clear of the surface
+func (b *Bullet) bounce(obstacle *Obstacle) {
+	normal := obstacleContactNormal(obstacle, b.Position)
+	if dot := rl.Vector3DotProduct(b.Velocity, normal); dot < 0 {
+		b.Velocity = rl.Vector3Subtract(b.Velocity, rl.Vector3Scale(normal, 2*dot))
+	}
+
+	clearance := b.Radius + bounceClearance
+	if obstacle.IsCylinder() {
+		distance := obstacle.Radius + clearance
+		b.Position.X = obstacle.Position.X + normal.X*distance
+		b.Position.Z = obstacle.Position.Z + normal.Z*distance
+		return
+	}
+	box := obstacle.GetBoundingBox()
+	switch {
+	case normal.X > 0:
+		b.Position.X = box.Max.X + clearance
+	case normal.X < 0:
+		b.Position.X = box.Min.X - clearance
+	case normal.Z > 0:
+		b.Position.Z = box.Max.Z + clearance
+	default:
+		b.Position.Z = box.Min.Z - clearance
+	}
+}
+
+// obstacleContactNormal returns the ground-plane normal of the obstacle's
+// surface nearest point: radial for cylinders and the face the point is
+// furthest toward, relative to the box's extent, for boxes
+func obstacleContactNormal(obstacle *Obstacle, point rl.Vector3) rl.Vector3 {
+	if obstacle.IsCylinder() {
+		normal := horizontalDirection(obstacle.Position, point)
+		if normal == (rl.Vector3{}) {
+			return rl.Vector3{X: 1}
+		}
+		return normal
+	}
+
+	box := obstacle.GetBoundingBox()
+	center := rl.Vector3Scale(rl.Vector3Add(box.Min, box.Max), 0.5)
+	offsetX := (point.X - center.X) / max((box.Max.X-box.Min.X)/2, 0.001)
+	offsetZ := (point.Z - center.Z) / max((box.Max.Z-box.Min.Z)/2, 0.001)
+	if float32(math.Abs(float64(offsetX))) >= float32(math.Abs(float64(offsetZ))) {
+		return rl.Vector3{X: float32(math.Copysign(1, float64(offsetX)))}
+	}
+	return rl.Vector3{Z: float32(math.Copysign(1, float64(offsetZ)))}
+}
+
+// explode damages every hostile target and destructible obstacle within the
+// explosion radius and reports the explosion on the event bus
+func (b *Bullet) explode() {
+	radius := b.Modifiers.ExplosionRadius
+	if radius <= 0 || globals.Collision == nil {
+		return
+	}
+
+	damage := b.damageInfo()
+	damage.Amount *= explosionDamage(b.Modifiers)
+	for _, collidable := range globals.Collision.QuerySphere(b.Position, radius, "enemy", "player", "obstacle") {
+		target, ok := collidable.(Damageable)
+		if !ok || !target.IsAlive() || !b.CanHit(target) {
+			continue
+		}
+		if invulnerable, ok := collidable.(Invulnerable); ok && invulnerable.IsInvulnerable() {
+			continue
+		}
+		b.dealDamageInfo(target, damage)
+	}
+
+	if b.eventBus != nil {
+		if err := b.eventBus.Notify(events.NewExplosionEvent(b.OwnerID, b.Position, radius)); err != nil {
+			fmt.Printf("Error notifying explosion: %v\n", err)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package entities
+
+import (
+	"math"
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/events"
+)
+
+func TestPiercingBulletPassesThroughTargets(t *testing.T) {
+	// given
+	// ... a player bullet that pierces one target
+	// ... and three enemies in its path
+	bullet := NewBullet(rl.Vector3{}, rl.Vector3{X: 1}, 10, 1, 5)
+	bullet.ID = "bullet_1"
+	bullet.OwnerID = PlayerID
+	bullet.Faction = FactionPlayer
+	bullet.Modifiers.Pierce = 1
+	first := NewEnemy(rl.Vector3{}, 50.0, 2.0)
+	first.ID = "enemy_1"
+	second := NewEnemy(rl.Vector3{}, 50.0, 2.0)
+	second.ID = "enemy_2"
+	third := NewEnemy(rl.Vector3{}, 50.0, 2.0)
+	third.ID = "enemy_3"
+	// when
+	// ... the bullet touches the first enemy twice, then the others
+	bullet.OnCollision(first)
+	bullet.OnCollision(first)
+	bullet.OnCollision(second)
+	bullet.OnCollision(third)
+	// then
+	// ... should hit the first enemy once, stop on the second and spare the third
+	if first.Health != 45.0 || second.Health != 45.0 {
+		t.Fatalf("Expected one hit on each of the first two enemies, got %f and %f", first.Health, second.Health)
+	}
+	if third.Health != 50.0 {
+		t.Fatalf("Expected the third enemy untouched, got %f", third.Health)
+	}
+	if bullet.Active {
+		t.Fatal("Bullet should stop once its pierce is used up")
+	}
+}
+
+func TestBouncingBulletReflectsOffObstacle(t *testing.T) {
+	// given
+	// ... a bullet with one bounce flying +X into the -X face of a box
+	bullet := NewBullet(rl.Vector3{}, rl.Vector3{X: 1}, 10, 1, 5)
+	bullet.ID = "bullet_1"
+	bullet.OwnerID = PlayerID
+	bullet.Faction = FactionPlayer
+	bullet.Modifiers.Bounces = 1
+	bullet.Velocity = rl.Vector3{X: 0.6, Z: 0.8}
+	bullet.Position = rl.Vector3{X: 1.45, Z: 0.2}
+	wall := NewBoxObstacle(rl.Vector3{X: 2}, rl.Vector3{X: 1, Y: 1, Z: 4}, rl.Brown)
+	// when
+	// ... the bullet hits the wall twice
+	bullet.OnCollision(wall)
+	bounced := bullet.Velocity
+	position := bullet.Position
+	bullet.OnCollision(wall)
+	// then
+	// ... should reflect off the face normal and move clear of the wall
+	// ... and stop on the second hit with no bounces left
+	if bounced.X != -0.6 || bounced.Z != 0.8 {
+		t.Fatalf("Expected velocity (-0.6, 0.8), got (%f, %f)", bounced.X, bounced.Z)
+	}
+	if position.X >= 1.5-bullet.Radius {
+		t.Fatalf("Expected the bullet pushed clear of the wall, got x=%f", position.X)
+	}
+	if bullet.Active {
+		t.Fatal("Bullet should stop once its bounces are used up")
+	}
+}
+
+func TestChainingBulletJumpsToNearestUnhitEnemy(t *testing.T) {
+	// given
+	// ... a bullet with one chain hitting an enemy
+	// ... with a second enemy 3 units away and a third 5 units away
+	world := testWorld()
+	var enemies []*Enemy
+	for _, position := range []rl.Vector3{{}, {Z: 3}, {X: -5}} {
+		enemy := NewEnemy(position, 50.0, 2.0)
+		enemy.ID = world.NextID("enemy")
+		if err := world.Spawn(enemy); err != nil {
+			t.Fatalf("Unexpected spawn error: %v", err)
+		}
+		enemies = append(enemies, enemy)
+	}
+	bullet := NewBullet(rl.Vector3{}, rl.Vector3{X: 1}, 10, 1, 5)
+	bullet.ID = "bullet_1"
+	bullet.OwnerID = PlayerID
+	bullet.Faction = FactionPlayer
+	bullet.Modifiers.Chains = 1
+	// when
+	// ... the bullet hits the first enemy
+	bullet.OnCollision(enemies[0])
+	// then
+	// ... should stay alive and turn toward the nearest other enemy
+	if !bullet.Active || bullet.Modifiers.Chains != 0 {
+		t.Fatal("Expected the bullet to chain")
+	}
+	if math.Abs(float64(bullet.Velocity.Z-1)) > 1e-4 || math.Abs(float64(bullet.Velocity.X)) > 1e-4 {
+		t.Fatalf("Expected the bullet to head +Z, got %+v", bullet.Velocity)
+	}
+	// when
+	// ... it hits the second enemy
+	bullet.OnCollision(enemies[1])
+	// then
+	// ... should stop with no chains left
+	if bullet.Active {
+		t.Fatal("Bullet should stop once its chains are used up")
+	}
+}
+
+func TestHomingBulletTurnsTowardTargetAtTurnRate(t *testing.T) {
+	// given
+	// ... a bullet heading +X that turns at most 1 radian per second
+	// ... and an enemy straight along +Z
+	world := testWorld()
+	enemy := NewEnemy(rl.Vector3{Z: 4}, 50.0, 2.0)
+	enemy.ID = "enemy_1"
+	if err := world.Spawn(enemy); err != nil {
+		t.Fatalf("Unexpected spawn error: %v", err)
+	}
+	bullet := NewBullet(rl.Vector3{}, rl.Vector3{X: 1}, 10, 1, 5)
+	bullet.ID = "bullet_1"
+	bullet.OwnerID = PlayerID
+	bullet.Faction = FactionPlayer
+	bullet.Modifiers.HomingTurnRate = 1
+	// when
+	// ... half a second passes
+	bullet.Update(0.5)
+	// then
+	// ... should have turned half a radian toward the enemy
+	angle := math.Atan2(float64(bullet.Velocity.Z), float64(bullet.Velocity.X))
+	if math.Abs(angle-0.5) > 1e-4 {
+		t.Fatalf("Expected a 0.5 radian turn, got %f", angle)
+	}
+	if length := rl.Vector3Length(bullet.Velocity); math.Abs(float64(length-1)) > 1e-4 {
+		t.Fatalf("Expected the bullet to keep its speed, got length %f", length)
+	}
+}
+
+func TestExplodingBulletDamagesAreaOnExpiry(t *testing.T) {
+	// given
+	// ... a bullet exploding for half damage in a 2 unit radius
+	// ... with an enemy 1 unit away and another 4 units away
+	world := testWorld()
+	var enemies []*Enemy
+	for _, position := range []rl.Vector3{{X: 1}, {X: 4}} {
+		enemy := NewEnemy(position, 50.0, 2.0)
+		enemy.ID = world.NextID("enemy")
+		if err := world.Spawn(enemy); err != nil {
+			t.Fatalf("Unexpected spawn error: %v", err)
+		}
+		enemies = append(enemies, enemy)
+	}
+	bus := &MockEventBus{}
+	bullet := NewBullet(rl.Vector3{}, rl.Vector3{X: 1}, 10, 1, 5)
+	bullet.ID = "bullet_1"
+	bullet.OwnerID = PlayerID
+	bullet.Faction = FactionPlayer
+	bullet.Modifiers.ExplosionRadius = 2
+	bullet.Modifiers.ExplosionDamage = 0.5
+	bullet.SetEventBus(bus)
+	// when
+	// ... the bullet's lifetime runs out
+	bullet.Update(bullet.Lifetime)
+	// then
+	// ... should damage only the near enemy and report the explosion
+	if enemies[0].Health != 47.5 {
+		t.Fatalf("Expected the near enemy at 47.5 health, got %f", enemies[0].Health)
+	}
+	if enemies[1].Health != 50.0 {
+		t.Fatalf("Expected the far enemy untouched, got %f", enemies[1].Health)
+	}
+	if bullet.Active || countEvents(bus, events.EventTypeExplosion) != 1 {
+		t.Fatal("Expected the bullet to expire with one explosion event")
+	}
+}
+
+func TestWeaponProjectileModifiersTravelInSpawnEvent(t *testing.T) {
+	// given
+	// ... a player holding a weapon whose projectiles pierce and explode
+	bus := &MockEventBus{}
+	player := NewPlayer(5.0, bus)
+	modifiers := ProjectileModifiers{Pierce: 2, ExplosionRadius: 1.5}
+	player.SetWeapons([]*WeaponDefinition{{
+		ID: "launcher", FireRate: 1, ProjectileCount: 1, DamageMultiplier: 1, SpeedMultiplier: 1,
+		Projectile: modifiers,
+	}})
+	// when
+	// ... the weapon fires
+	player.FireWeapon(rl.Vector3{X: 1})
+	// then
+	// ... the spawn event should carry the modifiers
+	spawns := bulletSpawns(bus)
+	if len(spawns) != 1 {
+		t.Fatalf("Expected 1 bullet spawn, got %d", len(spawns))
+	}
+	if got := spawns[0].Modifiers; got != modifiers {
+		t.Fatalf("Expected modifiers %+v, got %+v", modifiers, got)
+	}
+}
@@ -0,0 +1,401 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/events"
+	"arpg/pkg/globals"
+)
+
+// Skill bar slots. The right mouse button casts slot 0 and the skill keys
+// cast the rest in order.
+const (
+	SkillSlotRightMouse = iota
+	SkillSlotQ
+	SkillSlotE
+	SkillSlotF
+	SkillSlotCount
+)
+
+var (
+	ErrNoSkill         = errors.New("no skill in slot")
+	ErrSkillOnCooldown = errors.New("skill is on cooldown")
+	ErrNotEnoughMana   = errors.New("not enough mana")
+	ErrAlreadyCasting  = errors.New("already casting")
+	ErrNoSkillTarget   = errors.New("skill has nowhere to take effect")
+)
+
+// TargetingMode decides what a skill is aimed at
+type TargetingMode string
+
+const (
+	TargetSelf      TargetingMode = "self"      // Centred on the caster
+	TargetDirection TargetingMode = "direction" // Fired toward the cursor
+	TargetGround    TargetingMode = "ground"    // A point on the ground within range
+)
+
+// SkillTarget is where a skill was aimed. Direction is a unit vector in the
+// ground plane and Point is clamped to the skill's range for ground skills.
+type SkillTarget struct {
+	Direction rl.Vector3
+	Point     rl.Vector3
+}
+
+// SkillEffect is what a skill does once its cast completes
+type SkillEffect interface {
+	Apply(caster *Player, skill *SkillDefinition, target SkillTarget)
+}
+
+// TargetChecker is implemented by skill effects that can have nowhere to
+// take effect, so casting at such a target is refused before mana and the
+// cooldown are spent
+type TargetChecker interface {
+	CanTarget(caster *Player, target SkillTarget) bool
+}
+
+// SkillDefinition describes a castable skill
+type SkillDefinition struct {
+	ID        string
+	Name      string
+	ManaCost  float32
+	Cooldown  float32 // Seconds before the skill can be cast again
+	CastTime  float32 // Seconds the caster stands still before the effect; 0 is instant
+	Targeting TargetingMode
+	Range     float32 // Furthest ground target from the caster
+	Effect    SkillEffect
+}
+
+// Skill is a skill on the skill bar with its remaining cooldown
+type Skill struct {
+	Definition *SkillDefinition
+	cooldown   float32
+}
+
+// Cooldown returns the seconds until the skill can be cast again
+func (s *Skill) Cooldown() float32 {
+	return s.cooldown
+}
+
+// ManaComponent gives an entity a regenerating mana pool
+type ManaComponent struct {
+	Mana      float32
+	MaxMana   float32
+	ManaRegen float32 // Mana restored per second
+}
+
+// SpendMana removes amount if the pool holds enough, reporting success
+func (m *ManaComponent) SpendMana(amount float32) bool {
+	if m.Mana < amount {
+		return false
+	}
+	m.Mana -= amount
+	return true
+}
+
+// RegenerateMana restores mana for deltaTime seconds, up to the maximum
+func (m *ManaComponent) RegenerateMana(deltaTime float32) {
+	m.Mana = min(m.Mana+m.ManaRegen*deltaTime, m.MaxMana)
+}
+
+// pendingCast is a skill waiting out its cast time
+type pendingCast struct {
+	slot      int
+	target    SkillTarget
+	remaining float32
+}
+
+// SkillBarComponent holds the skills bound to the skill bar and any cast in
+// progress
+type SkillBarComponent struct {
+	Skills  [SkillSlotCount]*Skill
+	casting *pendingCast
+}
+
+// IsCasting reports whether a skill is waiting out its cast time
+func (c *SkillBarComponent) IsCasting() bool {
+	return c.casting != nil
+}
+
+// CastProgress returns how far through its cast time the current skill is,
+// from 0 to 1
+func (c *SkillBarComponent) CastProgress() float32 {
+	if c.casting == nil {
+		return 0
+	}
+	castTime := c.Skills[c.casting.slot].Definition.CastTime
+	return 1 - c.casting.remaining/castTime
+}
+
+// SetSkill binds a skill to a skill bar slot; nil clears the slot
+func (p *Player) SetSkill(slot int, definition *SkillDefinition) {
+	if slot < 0 || slot >= SkillSlotCount {
+		return
+	}
+	if definition == nil {
+		p.Skills[slot] = nil
+		return
+	}
+	p.Skills[slot] = &Skill{Definition: definition}
+}
+
+// CastSkill casts the skill in slot at target. Mana is spent and the
+// cooldown starts immediately; skills with a cast time take effect once it
+// has passed. Nothing is spent when the effect has nowhere to take effect.
+func (p *Player) CastSkill(slot int, target SkillTarget) error {
+	if slot < 0 || slot >= SkillSlotCount || p.Skills[slot] == nil {
+		return ErrNoSkill
+	}
+	skill := p.Skills[slot]
+	if p.IsCasting() {
+		return ErrAlreadyCasting
+	}
+	if skill.cooldown > 0 {
+		return ErrSkillOnCooldown
+	}
+	if p.Mana < skill.Definition.ManaCost {
+		return ErrNotEnoughMana
+	}
+	target = p.resolveSkillTarget(skill.Definition, target)
+	if checker, ok := skill.Definition.Effect.(TargetChecker); ok && !checker.CanTarget(p, target) {
+		return ErrNoSkillTarget
+	}
+	p.SpendMana(skill.Definition.ManaCost)
+	skill.cooldown = skill.Definition.Cooldown
+
+	if skill.Definition.CastTime <= 0 {
+		p.applySkill(skill.Definition, target)
+		return nil
+	}
+	p.casting = &pendingCast{slot: slot, target: target, remaining: skill.Definition.CastTime}
+	return nil
+}
+
+// CancelCast abandons the cast in progress. Spent mana and the cooldown are
+// not refunded.
+func (p *Player) CancelCast() {
+	p.casting = nil
+}
+
+// updateSkills regenerates mana, counts down cooldowns and finishes casts
+func (p *Player) updateSkills(deltaTime float32) {
+	p.RegenerateMana(deltaTime)
+	for _, skill := range p.Skills {
+		if skill != nil {
+			skill.cooldown = max(skill.cooldown-deltaTime, 0)
+		}
+	}
+
+	if p.casting == nil {
+		return
+	}
+	p.casting.remaining -= deltaTime
+	if p.casting.remaining <= 0 {
+		cast := p.casting
+		p.casting = nil
+		p.applySkill(p.Skills[cast.slot].Definition, cast.target)
+	}
+}
+
+// resolveSkillTarget fills in the target for the skill's targeting mode
+func (p *Player) resolveSkillTarget(skill *SkillDefinition, target SkillTarget) SkillTarget {
+	switch skill.Targeting {
+	case TargetSelf:
+		target.Point = p.Position
+	case TargetGround:
+		offset := rl.Vector3Subtract(target.Point, p.Position)
+		offset.Y = 0
+		if skill.Range > 0 && rl.Vector3Length(offset) > skill.Range {
+			offset = rl.Vector3Scale(rl.Vector3Normalize(offset), skill.Range)
+		}
+		target.Point = rl.Vector3Add(p.Position, offset)
+	}
+	return target
+}
+
+// applySkill runs a skill's effect
+func (p *Player) applySkill(skill *SkillDefinition, target SkillTarget) {
+	if skill.Effect != nil {
+		skill.Effect.Apply(p, skill, target)
+	}
+}
+
+// reportCastError publishes a failed cast on the event bus. An empty slot,
+// a cooldown, missing mana, a cast in progress or nowhere to land simply
+// mean the skill does not fire, so those are not reported.
+func (p *Player) reportCastError(slot int, err error) {
+	switch {
+	case err == nil,
+		errors.Is(err, ErrNoSkill),
+		errors.Is(err, ErrSkillOnCooldown),
+		errors.Is(err, ErrNotEnoughMana),
+		errors.Is(err, ErrAlreadyCasting),
+		errors.Is(err, ErrNoSkillTarget):
+		return
+	}
+	if p.eventBus == nil {
+		return
+	}
+	if notifyErr := p.eventBus.Notify(events.NewSkillCastFailedEvent(p.ID, slot, err.Error())); notifyErr != nil {
+		fmt.Printf("Error notifying skill cast failed: %v\n", notifyErr)
+	}
+}
+
+// notifySkillCast reports a skill taking effect on the event bus
+func (p *Player) notifySkillCast(skill *SkillDefinition, target rl.Vector3, radius float32) {
+	if p.eventBus == nil {
+		return
+	}
+	event := events.NewSkillCastEvent(p.ID, skill.ID, p.Position, target, radius)
+	if err := p.eventBus.Notify(event); err != nil {
+		fmt.Printf("Error notifying skill cast: %v\n", err)
+	}
+}
+
+// FrostNova damages and slows every enemy within Radius of the caster and
+// damages destructible obstacles caught in it
+type FrostNova struct {
+	Radius           float32
+	DamageMultiplier float32 // Scales the caster's damage
+	SlowAmount       float32
+	SlowDuration     float32
+}
+
+// Apply hits every enemy and obstacle the collision system finds inside the nova
+func (f FrostNova) Apply(caster *Player, skill *SkillDefinition, target SkillTarget) {
+	for _, collidable := range globals.Collision.QuerySphere(caster.Position, f.Radius, "enemy", "obstacle") {
+		victim, ok := collidable.(Damageable)
+		if !ok || !victim.IsAlive() || !caster.GetFaction().IsHostile(victim.GetFaction()) {
+			continue
+		}
+		victim.TakeDamage(NewDamage(caster.BulletDamage*f.DamageMultiplier, DamageCold, caster.ID))
+		if receiver, ok := collidable.(StatusReceiver); ok && victim.IsAlive() {
+			receiver.ApplyStatusEffect(NewStatusEffect(StatusSlow, f.SlowDuration, f.SlowAmount, caster.ID))
+		}
+	}
+	caster.notifySkillCast(skill, caster.Position, f.Radius)
+}
+
+// Fireball launches a fire projectile that sets what it hits alight
+type Fireball struct {
+	SpeedMultiplier  float32 // Scales the caster's projectile speed
+	DamageMultiplier float32 // Scales the caster's damage
+	BurnDamage       float32 // Burn damage per tick
+	BurnDuration     float32
+	Modifiers        ProjectileModifiers
+}
+
+// Apply emits a bullet spawn event carrying fire damage and a burn
+func (f Fireball) Apply(caster *Player, skill *SkillDefinition, target SkillTarget) {
+	if caster.eventBus == nil {
+		return
+	}
+	damage, critical := caster.rollDamage()
+	bulletEvent := events.NewBulletSpawnEventFromData(events.BulletSpawnEvent{
+		Position:   caster.GetGunTip(),
+		Direction:  target.Direction,
+		Speed:      caster.BulletSpeed * f.SpeedMultiplier,
+		Lifetime:   caster.BulletLifetime,
+		Damage:     damage * f.DamageMultiplier,
+		OwnerID:    caster.ID,
+		Faction:    string(FactionPlayer),
+		DamageType: string(DamageFire),
+		Critical:   critical,
+		StatusEffects: []events.StatusEffectSpec{
+			{Type: string(StatusBurn), Duration: f.BurnDuration, Magnitude: f.BurnDamage},
+		},
+		Modifiers: f.Modifiers,
+	})
+	if err := caster.eventBus.Notify(bulletEvent); err != nil {
+		fmt.Printf("Error notifying bullet spawn: %v\n", err)
+	}
+	caster.notifySkillCast(skill, target.Point, 0)
+}
+
+// blinkStep is how far back along its path a blink retries when the target
+// point is blocked
+const blinkStep = 0.25
+
+// Blink teleports the caster to the target point, or the furthest free
+// point short of it
+type Blink struct{}
+
+// CanTarget reports whether any point along the path to the target is free
+func (Blink) CanTarget(caster *Player, target SkillTarget) bool {
+	_, found := blinkDestination(caster, target.Point)
+	return found
+}
+
+// Apply moves the caster to the first point along the path back from the
+// target that the collision system allows
+func (Blink) Apply(caster *Player, skill *SkillDefinition, target SkillTarget) {
+	destination, found := blinkDestination(caster, target.Point)
+	if !found {
+		return // Blocked while casting
+	}
+	caster.Position = destination
+	caster.notifySkillCast(skill, destination, 0)
+}
+
+// blinkDestination returns the furthest free point from the caster toward
+// point, if there is one short of the caster's own position
+func blinkDestination(caster *Player, point rl.Vector3) (rl.Vector3, bool) {
+	origin := caster.Position
+	offset := rl.Vector3Subtract(point, origin)
+	distance := rl.Vector3Length(offset)
+	if distance == 0 {
+		return rl.Vector3{}, false
+	}
+	direction := rl.Vector3Scale(offset, 1/distance)
+
+	steps := int(math.Ceil(float64(distance / blinkStep)))
+	for i := 0; i < steps; i++ {
+		travel := max(distance-float32(i)*blinkStep, 0)
+		destination := rl.Vector3Add(origin, rl.Vector3Scale(direction, travel))
+		if globals.Collision.CheckMovement(caster, destination) {
+			return destination, true
+		}
+	}
+	return rl.Vector3{}, false
+}
+
+// DefaultSkills returns the player's starting skills keyed by skill bar slot
+func DefaultSkills() map[int]*SkillDefinition {
+	return map[int]*SkillDefinition{
+		SkillSlotRightMouse: {
+			ID:        "fireball",
+			Name:      "Fireball",
+			ManaCost:  15,
+			Cooldown:  0.5,
+			CastTime:  0.25,
+			Targeting: TargetDirection,
+			Effect: Fireball{
+				SpeedMultiplier:  0.8,
+				DamageMultiplier: 1.5,
+				BurnDamage:       4,
+				BurnDuration:     3,
+				Modifiers:        ProjectileModifiers{ExplosionRadius: 2, ExplosionDamage: 0.5},
+			},
+		},
+		SkillSlotQ: {
+			ID:        "frost_nova",
+			Name:      "Frost Nova",
+			ManaCost:  30,
+			Cooldown:  6,
+			Targeting: TargetSelf,
+			Effect:    FrostNova{Radius: 4, DamageMultiplier: 0.8, SlowAmount: 0.5, SlowDuration: 3},
+		},
+		SkillSlotE: {
+			ID:        "blink",
+			Name:      "Blink",
+			ManaCost:  20,
+			Cooldown:  3,
+			Targeting: TargetGround,
+			Range:     6,
+			Effect:    Blink{},
+		},
+	}
+}
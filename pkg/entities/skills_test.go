@@ -0,0 +1,223 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/events"
+)
+
+func TestCastSkillSpendsManaAndStartsCooldown(t *testing.T) {
+	// given
+	// ... a player with 100 mana and frost nova on Q
+	testWorld()
+	player := NewPlayer(5.0, &MockEventBus{})
+	cost := player.Skills[SkillSlotQ].Definition.ManaCost
+	// when
+	// ... frost nova is cast twice in a row
+	first := player.CastSkill(SkillSlotQ, SkillTarget{})
+	second := player.CastSkill(SkillSlotQ, SkillTarget{})
+	// then
+	// ... should spend mana once and refuse the second cast
+	if first != nil {
+		t.Fatalf("Expected first cast to succeed, got %v", first)
+	}
+	if !errors.Is(second, ErrSkillOnCooldown) {
+		t.Fatalf("Expected cooldown error, got %v", second)
+	}
+	if player.Mana != player.MaxMana-cost {
+		t.Fatalf("Expected %f mana, got %f", player.MaxMana-cost, player.Mana)
+	}
+}
+
+func TestCastSkillRequiresMana(t *testing.T) {
+	// given
+	// ... a player without enough mana for blink
+	testWorld()
+	player := NewPlayer(5.0, &MockEventBus{})
+	player.Mana = 5
+	// when
+	// ... blink is cast
+	err := player.CastSkill(SkillSlotE, SkillTarget{Point: rl.Vector3{X: 3}})
+	// then
+	// ... should fail without moving or spending mana
+	if !errors.Is(err, ErrNotEnoughMana) {
+		t.Fatalf("Expected not enough mana error, got %v", err)
+	}
+	if player.Mana != 5 || player.Position.X != 0 {
+		t.Fatalf("Expected nothing to change, got mana %f at x %f", player.Mana, player.Position.X)
+	}
+}
+
+func TestManaRegenerates(t *testing.T) {
+	// given
+	// ... a player with empty mana regenerating 5 per second
+	player := NewPlayer(5.0, nil)
+	player.Mana = 0
+	// when
+	// ... two seconds pass
+	player.updateSkills(2)
+	// then
+	// ... should regain 10 mana
+	if player.Mana != 10 {
+		t.Fatalf("Expected 10 mana, got %f", player.Mana)
+	}
+}
+
+func TestFireballWaitsForCastTime(t *testing.T) {
+	// given
+	// ... a player with fireball on the right mouse button
+	bus := &MockEventBus{}
+	player := NewPlayer(5.0, bus)
+	castTime := player.Skills[SkillSlotRightMouse].Definition.CastTime
+	// when
+	// ... fireball is cast toward +X
+	if err := player.CastSkill(SkillSlotRightMouse, SkillTarget{Direction: rl.Vector3{X: 1}}); err != nil {
+		t.Fatalf("Expected cast to succeed, got %v", err)
+	}
+	// then
+	// ... should emit nothing until the cast time has passed
+	// ... and then a fire bullet that burns
+	if len(bulletSpawns(bus)) != 0 || !player.IsCasting() {
+		t.Fatal("Expected fireball to wait for its cast time")
+	}
+	player.updateSkills(castTime)
+	spawns := bulletSpawns(bus)
+	if len(spawns) != 1 {
+		t.Fatalf("Expected 1 fireball, got %d", len(spawns))
+	}
+	if spawns[0].DamageType != string(DamageFire) || len(spawns[0].StatusEffects) != 1 ||
+		spawns[0].StatusEffects[0].Type != string(StatusBurn) {
+		t.Fatalf("Expected burning fire projectile, got %+v", spawns[0])
+	}
+}
+
+func TestFrostNovaHitsEnemiesInRadius(t *testing.T) {
+	// given
+	// ... a player with an enemy 2 units away and another 10 units away
+	world := testWorld()
+	bus := &MockEventBus{}
+	player := NewPlayer(5.0, bus)
+	near := NewEnemy(rl.Vector3{X: 2}, 100, 2)
+	near.ID = "near"
+	far := NewEnemy(rl.Vector3{X: 10}, 100, 2)
+	far.ID = "far"
+	for _, entity := range []Entity{player, near, far} {
+		if err := world.Spawn(entity); err != nil {
+			t.Fatalf("Unexpected spawn error: %v", err)
+		}
+	}
+	// when
+	// ... frost nova is cast
+	if err := player.CastSkill(SkillSlotQ, SkillTarget{}); err != nil {
+		t.Fatalf("Expected cast to succeed, got %v", err)
+	}
+	// then
+	// ... should damage and slow only the near enemy
+	// ... and report the cast with its radius
+	if near.Health >= 100 || !near.HasStatus(StatusSlow) {
+		t.Fatalf("Expected near enemy to be hit and slowed, got health %f", near.Health)
+	}
+	if far.Health != 100 || far.HasStatus(StatusSlow) {
+		t.Fatalf("Expected far enemy untouched, got health %f", far.Health)
+	}
+	var cast *events.SkillCastEvent
+	for _, event := range bus.events {
+		if data, ok := event.Data.(events.SkillCastEvent); ok {
+			cast = &data
+		}
+	}
+	if cast == nil || cast.SkillID != "frost_nova" || cast.Radius == 0 {
+		t.Fatalf("Expected frost nova cast event, got %+v", cast)
+	}
+}
+
+func TestBlinkIsClampedToRangeAndStopsShortOfObstacles(t *testing.T) {
+	// given
+	// ... a player with a 6 unit blink and a wall 4 units away along -Z
+	world := testWorld()
+	player := NewPlayer(5.0, &MockEventBus{})
+	wall := NewBoxObstacle(rl.Vector3{Z: -4}, rl.Vector3{X: 4, Y: 2, Z: 1}, rl.Gray)
+	wall.ID = "wall"
+	for _, entity := range []Entity{player, wall} {
+		if err := world.Spawn(entity); err != nil {
+			t.Fatalf("Unexpected spawn error: %v", err)
+		}
+	}
+	// when
+	// ... the player blinks 20 units along +X
+	if err := player.CastSkill(SkillSlotE, SkillTarget{Point: rl.Vector3{X: 20}}); err != nil {
+		t.Fatalf("Expected cast to succeed, got %v", err)
+	}
+	// then
+	// ... should land at the edge of the blink's range
+	if player.Position.X != 6 {
+		t.Fatalf("Expected blink to land at x 6, got %f", player.Position.X)
+	}
+	// when
+	// ... the player blinks back to the origin and then into the wall
+	player.Position = rl.Vector3{}
+	player.Skills[SkillSlotE].cooldown = 0
+	if err := player.CastSkill(SkillSlotE, SkillTarget{Point: rl.Vector3{Z: -4}}); err != nil {
+		t.Fatalf("Expected cast to succeed, got %v", err)
+	}
+	// then
+	// ... should stop in front of the wall
+	if player.Position.Z >= 0 || player.Position.Z < -3.5 {
+		t.Fatalf("Expected blink to stop short of the wall, got z %f", player.Position.Z)
+	}
+}
+
+func TestBlinkIntoWallSpendsNothing(t *testing.T) {
+	// given
+	// ... a player standing against a wall along -Z
+	world := testWorld()
+	player := NewPlayer(5.0, &MockEventBus{})
+	wall := NewBoxObstacle(rl.Vector3{Z: -2}, rl.Vector3{X: 4, Y: 2, Z: 3}, rl.Gray)
+	wall.ID = "wall"
+	for _, entity := range []Entity{player, wall} {
+		if err := world.Spawn(entity); err != nil {
+			t.Fatalf("Unexpected spawn error: %v", err)
+		}
+	}
+	mana := player.Mana
+	// when
+	// ... the player blinks into the wall and onto the spot it stands on
+	wallErr := player.CastSkill(SkillSlotE, SkillTarget{Point: rl.Vector3{Z: -3}})
+	stayErr := player.CastSkill(SkillSlotE, SkillTarget{Point: player.Position})
+	// then
+	// ... should refuse both casts without spending mana or the cooldown
+	if !errors.Is(wallErr, ErrNoSkillTarget) || !errors.Is(stayErr, ErrNoSkillTarget) {
+		t.Fatalf("Expected ErrNoSkillTarget twice, got %v and %v", wallErr, stayErr)
+	}
+	if player.Mana != mana || player.Skills[SkillSlotE].Cooldown() != 0 {
+		t.Fatalf("Expected mana %f and no cooldown, got %f and %f", mana, player.Mana, player.Skills[SkillSlotE].Cooldown())
+	}
+	if player.Position != (rl.Vector3{}) {
+		t.Fatalf("Expected the player not to move, got %v", player.Position)
+	}
+}
+
+func TestOnlyUnexpectedCastErrorsAreReported(t *testing.T) {
+	// given
+	// ... a player publishing to a mock event bus
+	bus := &MockEventBus{}
+	player := NewPlayer(5.0, bus)
+	// when
+	// ... a cooldown, missing mana and an unexpected failure are reported
+	player.reportCastError(SkillSlotQ, ErrSkillOnCooldown)
+	player.reportCastError(SkillSlotQ, fmt.Errorf("casting: %w", ErrNotEnoughMana))
+	player.reportCastError(SkillSlotQ, errors.New("effect misconfigured"))
+	// then
+	// ... should publish only the unexpected failure
+	if countEvents(bus, events.EventTypeSkillCastFailed) != 1 {
+		t.Fatalf("Expected 1 skill cast failed event, got %+v", bus.events)
+	}
+	failed := bus.events[len(bus.events)-1].Data.(events.SkillCastFailedEvent)
+	if failed.Slot != SkillSlotQ || failed.Reason != "effect misconfigured" {
+		t.Fatalf("Expected the misconfigured effect in slot Q, got %+v", failed)
+	}
+}
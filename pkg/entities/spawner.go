@@ -0,0 +1,196 @@
+package entities
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// SpawnerActivation names when a spawner starts producing enemies
+type SpawnerActivation string
+
+const (
+	ActivationAlways    SpawnerActivation = "always"
+	ActivationProximity SpawnerActivation = "proximity" // Player within ActivationRadius
+	ActivationDelay     SpawnerActivation = "delay"     // ActivationDelay seconds after the scene starts
+	ActivationWave      SpawnerActivation = "wave"      // Once the wave director reaches ActivationWave
+)
+
+// IsKnownActivation reports whether activation is a supported condition
+func IsKnownActivation(activation SpawnerActivation) bool {
+	switch activation {
+	case ActivationAlways, ActivationProximity, ActivationDelay, ActivationWave:
+		return true
+	}
+	return false
+}
+
+// spawnScatterAngle spreads successive spawns around a spawner. It is the
+// golden angle, so spawns never line up.
+const spawnScatterAngle = 2.39996
+
+// EnemyFactory builds an enemy of the named archetype at position. The
+// caller assigns the enemy's ID.
+type EnemyFactory func(archetype string, position rl.Vector3) (*Enemy, error)
+
+// Spawner produces enemies from a pool of archetypes once its activation
+// condition is met. It keeps at most MaxAlive of its enemies alive and
+// deactivates after spawning Budget enemies.
+type Spawner struct {
+	ID string
+	TransformComponent
+	Pool        []string // Archetypes spawned in turn
+	Rate        float32  // Enemies per second
+	MaxAlive    int      // 0 is unlimited
+	Budget      int      // Total enemies to spawn, 0 is unlimited
+	SpawnRadius float32  // Enemies are scattered up to this far away
+
+	Activation       SpawnerActivation
+	ActivationRadius float32
+	ActivationDelay  float32
+	ActivationWave   int
+
+	Active bool
+
+	factory   EnemyFactory
+	activated bool
+	elapsed   float32
+	cooldown  float32
+	spawned   int
+	alive     []string
+}
+
+func NewSpawner(pos rl.Vector3, pool []string, rate float32, factory EnemyFactory) *Spawner {
+	return &Spawner{
+		TransformComponent: TransformComponent{Position: pos},
+		Pool:               pool,
+		Rate:               rate,
+		Activation:         ActivationAlways,
+		Active:             true,
+		factory:            factory,
+	}
+}
+
+func (s *Spawner) Components() Components {
+	return Components{
+		Transform: &s.TransformComponent,
+	}
+}
+
+func (s *Spawner) GetID() string {
+	return s.ID
+}
+
+func (s *Spawner) IsActive() bool {
+	return s.Active
+}
+
+// Activate starts the spawner regardless of its activation condition
+func (s *Spawner) Activate() {
+	s.activated = true
+}
+
+// IsActivated reports whether the spawner has started producing enemies
+func (s *Spawner) IsActivated() bool {
+	return s.activated
+}
+
+// Spawned returns how many enemies the spawner has produced
+func (s *Spawner) Spawned() int {
+	return s.spawned
+}
+
+// Alive returns how many of the spawner's enemies are still in the world
+func (s *Spawner) Alive() int {
+	return len(s.alive)
+}
+
+// Exhausted reports whether the spawner has used up its budget
+func (s *Spawner) Exhausted() bool {
+	return s.Budget > 0 && s.spawned >= s.Budget
+}
+
+// checkActivation reports whether the spawner's condition is met
+func (s *Spawner) checkActivation(player *Player) bool {
+	switch s.Activation {
+	case ActivationAlways:
+		return true
+	case ActivationProximity:
+		return player != nil && rl.Vector3Distance(player.Position, s.Position) <= s.ActivationRadius
+	case ActivationDelay:
+		return s.elapsed >= s.ActivationDelay
+	default:
+		return false // Wave spawners are started by the wave director
+	}
+}
+
+// update activates the spawner when its condition is met and spawns an
+// enemy whenever the cooldown allows and the alive cap has room
+func (s *Spawner) update(w *World, deltaTime float32) {
+	if !s.Active {
+		return
+	}
+	s.elapsed += deltaTime
+	if !s.activated {
+		s.activated = s.checkActivation(w.Player())
+		if !s.activated {
+			return
+		}
+	}
+
+	s.alive = slices.DeleteFunc(s.alive, func(id string) bool {
+		entity, exists := w.Get(id)
+		return !exists || !entity.IsActive()
+	})
+
+	s.cooldown -= deltaTime
+	if s.cooldown > 0 || (s.MaxAlive > 0 && len(s.alive) >= s.MaxAlive) {
+		return
+	}
+	if err := s.spawn(w); err != nil {
+		fmt.Printf("Spawner %s failed to spawn: %v\n", s.ID, err)
+	}
+	if s.Rate > 0 {
+		s.cooldown = 1 / s.Rate
+	}
+	if s.Exhausted() {
+		s.Active = false
+	}
+}
+
+// spawn builds the next archetype from the pool around the spawner
+func (s *Spawner) spawn(w *World) error {
+	if len(s.Pool) == 0 || s.factory == nil {
+		return fmt.Errorf("no archetypes to spawn")
+	}
+	archetype := s.Pool[s.spawned%len(s.Pool)]
+	angle := float64(s.spawned) * spawnScatterAngle
+	position := rl.Vector3{
+		X: s.Position.X + s.SpawnRadius*float32(math.Cos(angle)),
+		Y: s.Position.Y,
+		Z: s.Position.Z + s.SpawnRadius*float32(math.Sin(angle)),
+	}
+	s.spawned++
+
+	enemy, err := s.factory(archetype, position)
+	if err != nil {
+		return err
+	}
+	enemy.ID = w.NextID(s.ID)
+	if err := w.Spawn(enemy); err != nil {
+		return err
+	}
+	s.alive = append(s.alive, enemy.ID)
+	return nil
+}
+
+// NewSpawnerSystem runs every spawner in the world
+func NewSpawnerSystem() System {
+	return SystemFunc(func(w *World, deltaTime float32) {
+		for _, spawner := range OfType[*Spawner](w) {
+			spawner.update(w, deltaTime)
+		}
+	})
+}
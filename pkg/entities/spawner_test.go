@@ -0,0 +1,91 @@
+package entities
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// testEnemyFactory builds plain enemies tagged with their archetype
+func testEnemyFactory(archetype string, position rl.Vector3) (*Enemy, error) {
+	enemy := NewEnemy(position, 50, 2)
+	enemy.Archetype = archetype
+	return enemy, nil
+}
+
+func TestSpawnerRespectsRateMaxAliveAndBudget(t *testing.T) {
+	// given
+	// ... a spawner making one enemy per second, at most 2 alive, 3 in total
+	world := testWorld()
+	world.AddSystem("spawners", OrderTick, NewSpawnerSystem())
+	world.AddSystem("cleanup", OrderCleanup, NewCleanupSystem())
+	spawner := NewSpawner(rl.Vector3{X: 10}, []string{"grunt", "runner"}, 1, testEnemyFactory)
+	spawner.ID = "spawner_1"
+	spawner.MaxAlive = 2
+	spawner.Budget = 3
+	if err := world.Spawn(spawner); err != nil {
+		t.Fatalf("Unexpected spawn error: %v", err)
+	}
+	// when
+	// ... five seconds pass
+	for i := 0; i < 5; i++ {
+		world.Update(1)
+	}
+	// then
+	// ... should stop at 2 alive enemies taken from the pool in turn
+	enemies := OfType[*Enemy](world)
+	if len(enemies) != 2 || spawner.Spawned() != 2 {
+		t.Fatalf("Expected 2 enemies capped by max alive, got %d", len(enemies))
+	}
+	if enemies[0].Archetype != "grunt" || enemies[1].Archetype != "runner" {
+		t.Fatalf("Expected grunt then runner, got %s and %s", enemies[0].Archetype, enemies[1].Archetype)
+	}
+	// when
+	// ... one enemy dies and the world keeps running
+	enemies[0].Active = false
+	for i := 0; i < 3; i++ {
+		world.Update(1)
+	}
+	// then
+	// ... should spawn the last enemy of its budget and despawn itself
+	if spawner.Spawned() != 3 || len(OfType[*Enemy](world)) != 2 {
+		t.Fatalf("Expected the budget of 3 to be spent, got %d spawned", spawner.Spawned())
+	}
+	if _, exists := world.Get("spawner_1"); exists {
+		t.Fatal("Expected exhausted spawner to be despawned")
+	}
+}
+
+func TestProximitySpawnerWaitsForThePlayer(t *testing.T) {
+	// given
+	// ... a spawner that activates when the player is within 5 units
+	world := testWorld()
+	world.AddSystem("spawners", OrderTick, NewSpawnerSystem())
+	player := NewPlayer(5.0, nil)
+	spawner := NewSpawner(rl.Vector3{X: 10}, []string{"grunt"}, 1, testEnemyFactory)
+	spawner.ID = "spawner_1"
+	spawner.Activation = ActivationProximity
+	spawner.ActivationRadius = 5
+	for _, entity := range []Entity{player, spawner} {
+		if err := world.Spawn(entity); err != nil {
+			t.Fatalf("Unexpected spawn error: %v", err)
+		}
+	}
+	// when
+	// ... the player stays 10 units away
+	world.Update(1)
+	// then
+	// ... should not spawn
+	if spawner.IsActivated() || spawner.Spawned() != 0 {
+		t.Fatal("Expected spawner to wait for the player")
+	}
+	// when
+	// ... the player walks within range
+	player.Position = rl.Vector3{X: 6}
+	world.Update(1)
+	// then
+	// ... should activate and spawn an enemy
+	if !spawner.IsActivated() || spawner.Spawned() != 1 {
+		t.Fatalf("Expected spawner to activate and spawn, got %d spawned", spawner.Spawned())
+	}
+}
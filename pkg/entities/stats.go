@@ -0,0 +1,199 @@
+package entities
+
+// StatType names a stat that modifiers can change
+type StatType string
+
+const (
+	StatMaxHealth     StatType = "max_health"
+	StatMoveSpeed     StatType = "move_speed"
+	StatDamage        StatType = "damage"
+	StatCritChance    StatType = "crit_chance"
+	StatArmor         StatType = "armor"
+	StatAllResistance StatType = "all_resistance"
+	StatMaxMana       StatType = "max_mana"
+	StatManaRegen     StatType = "mana_regen"
+)
+
+// IsKnownStat reports whether stat names a supported stat
+func IsKnownStat(stat StatType) bool {
+	switch stat {
+	case StatMaxHealth, StatMoveSpeed, StatDamage, StatCritChance, StatArmor, StatAllResistance,
+		StatMaxMana, StatManaRegen:
+		return true
+	default:
+		return false
+	}
+}
+
+// ModifierKind decides how a modifier combines with the others on a stat.
+// A stat's value is (base + flat) * (1 + sum of increased) * product of (1 + more).
+type ModifierKind int
+
+const (
+	// ModifierFlat adds directly to the base value
+	ModifierFlat ModifierKind = iota
+	// ModifierIncreased adds a percentage, summed with every other increase
+	ModifierIncreased
+	// ModifierMore multiplies the value, compounding with every other more
+	ModifierMore
+)
+
+// Modifier changes one stat on behalf of a source such as an item, buff or
+// attribute. Value is an amount for flat modifiers and a fraction otherwise,
+// so 0.2 is 20% increased or 20% more.
+type Modifier struct {
+	Stat      StatType
+	Kind      ModifierKind
+	Value     float32
+	Source    string
+	Duration  float32 // Seconds the modifier lasts; 0 is permanent
+	Remaining float32
+}
+
+// NewModifier creates a permanent modifier
+func NewModifier(stat StatType, kind ModifierKind, value float32, source string) Modifier {
+	return Modifier{Stat: stat, Kind: kind, Value: value, Source: source}
+}
+
+// NewTimedModifier creates a modifier that expires after duration seconds
+func NewTimedModifier(stat StatType, kind ModifierKind, value float32, source string, duration float32) Modifier {
+	modifier := NewModifier(stat, kind, value, source)
+	modifier.Duration = duration
+	modifier.Remaining = duration
+	return modifier
+}
+
+// StatSheet holds base values and the modifiers layered on them. Final
+// values are computed on demand and cached until the stat changes.
+type StatSheet struct {
+	base      map[StatType]float32
+	modifiers []Modifier
+	cache     map[StatType]float32
+}
+
+// NewStatSheet creates an empty stat sheet
+func NewStatSheet() *StatSheet {
+	return &StatSheet{
+		base:  make(map[StatType]float32),
+		cache: make(map[StatType]float32),
+	}
+}
+
+// SetBase sets the unmodified value of stat
+func (s *StatSheet) SetBase(stat StatType, value float32) {
+	s.base[stat] = value
+	delete(s.cache, stat)
+}
+
+// Base returns the unmodified value of stat
+func (s *StatSheet) Base(stat StatType) float32 {
+	return s.base[stat]
+}
+
+// Get returns the value of stat with every modifier applied
+func (s *StatSheet) Get(stat StatType) float32 {
+	if value, cached := s.cache[stat]; cached {
+		return value
+	}
+
+	flat := s.base[stat]
+	var increased float32
+	more := float32(1)
+	for _, modifier := range s.modifiers {
+		if modifier.Stat != stat {
+			continue
+		}
+		switch modifier.Kind {
+		case ModifierFlat:
+			flat += modifier.Value
+		case ModifierIncreased:
+			increased += modifier.Value
+		case ModifierMore:
+			more *= 1 + modifier.Value
+		}
+	}
+
+	value := flat * max(1+increased, 0) * max(more, 0)
+	s.cache[stat] = value
+	return value
+}
+
+// AddModifier layers a modifier onto its stat
+func (s *StatSheet) AddModifier(modifier Modifier) {
+	if modifier.Duration > 0 && modifier.Remaining <= 0 {
+		modifier.Remaining = modifier.Duration
+	}
+	s.modifiers = append(s.modifiers, modifier)
+	delete(s.cache, modifier.Stat)
+}
+
+// RemoveSource removes every modifier from source, reporting whether any
+// were removed
+func (s *StatSheet) RemoveSource(source string) bool {
+	kept := s.modifiers[:0]
+	removed := false
+	for _, modifier := range s.modifiers {
+		if modifier.Source == source {
+			delete(s.cache, modifier.Stat)
+			removed = true
+			continue
+		}
+		kept = append(kept, modifier)
+	}
+	clear(s.modifiers[len(kept):])
+	s.modifiers = kept
+	return removed
+}
+
+// HasSource reports whether any modifier from source is active
+func (s *StatSheet) HasSource(source string) bool {
+	for _, modifier := range s.modifiers {
+		if modifier.Source == source {
+			return true
+		}
+	}
+	return false
+}
+
+// Modifiers returns the modifiers active on stat
+func (s *StatSheet) Modifiers(stat StatType) []Modifier {
+	var result []Modifier
+	for _, modifier := range s.modifiers {
+		if modifier.Stat == stat {
+			result = append(result, modifier)
+		}
+	}
+	return result
+}
+
+// Tick counts down timed modifiers, removing expired ones. It reports
+// whether any modifier expired.
+func (s *StatSheet) Tick(deltaTime float32) bool {
+	kept := s.modifiers[:0]
+	expired := false
+	for _, modifier := range s.modifiers {
+		if modifier.Duration > 0 {
+			modifier.Remaining -= deltaTime
+			if modifier.Remaining <= 0 {
+				delete(s.cache, modifier.Stat)
+				expired = true
+				continue
+			}
+		}
+		kept = append(kept, modifier)
+	}
+	clear(s.modifiers[len(kept):])
+	s.modifiers = kept
+	return expired
+}
+
+// StatsComponent gives an entity a stat sheet. Entities copy the final
+// values into their plain fields whenever the sheet changes.
+type StatsComponent struct {
+	Stats *StatSheet
+}
+
+// StatHolder is implemented by entities whose timed modifiers need ticking
+type StatHolder interface {
+	UpdateStats(deltaTime float32)
+}
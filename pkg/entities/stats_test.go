@@ -0,0 +1,122 @@
+package entities
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+func TestStatSheetLayersFlatIncreasedAndMore(t *testing.T) {
+	// given
+	// ... a damage stat with base 10
+	// ... +10 flat, 20% and 30% increased, and 50% more
+	sheet := NewStatSheet()
+	sheet.SetBase(StatDamage, 10.0)
+	sheet.AddModifier(NewModifier(StatDamage, ModifierFlat, 10.0, "ring"))
+	sheet.AddModifier(NewModifier(StatDamage, ModifierIncreased, 0.2, "ring"))
+	sheet.AddModifier(NewModifier(StatDamage, ModifierIncreased, 0.3, "passive"))
+	sheet.AddModifier(NewModifier(StatDamage, ModifierMore, 0.5, "buff"))
+	// when
+	// ... the final value is read
+	value := sheet.Get(StatDamage)
+	// then
+	// ... should be (10 + 10) * (1 + 0.5) * 1.5
+	if value != 45.0 {
+		t.Fatalf("Expected damage 45.0, got %f", value)
+	}
+}
+
+func TestRemovingSourceRestoresBaseExactly(t *testing.T) {
+	// given
+	// ... a speed stat with modifiers from one source
+	sheet := NewStatSheet()
+	sheet.SetBase(StatMoveSpeed, 5.3)
+	sheet.AddModifier(NewModifier(StatMoveSpeed, ModifierIncreased, 0.17, "boots"))
+	sheet.AddModifier(NewModifier(StatMoveSpeed, ModifierMore, 0.33, "boots"))
+	if sheet.Get(StatMoveSpeed) == 5.3 {
+		t.Fatal("Expected modifiers to change speed")
+	}
+	// when
+	// ... the source is removed
+	removed := sheet.RemoveSource("boots")
+	// then
+	// ... should restore the exact base value
+	if !removed || sheet.HasSource("boots") {
+		t.Fatal("Expected boots modifiers to be removed")
+	}
+	if sheet.Get(StatMoveSpeed) != 5.3 {
+		t.Fatalf("Expected speed 5.3, got %f", sheet.Get(StatMoveSpeed))
+	}
+}
+
+func TestTimedModifierExpires(t *testing.T) {
+	// given
+	// ... an armor stat with a 2 second flat buff
+	sheet := NewStatSheet()
+	sheet.SetBase(StatArmor, 10.0)
+	sheet.AddModifier(NewTimedModifier(StatArmor, ModifierFlat, 40.0, "shout", 2.0))
+	// when
+	// ... 1 second and then 1.5 more seconds pass
+	firstExpired := sheet.Tick(1.0)
+	during := sheet.Get(StatArmor)
+	secondExpired := sheet.Tick(1.5)
+	// then
+	// ... the buff should apply until it expires
+	if firstExpired || during != 50.0 {
+		t.Fatalf("Expected armor 50.0 during the buff, got %f", during)
+	}
+	if !secondExpired || sheet.Get(StatArmor) != 10.0 {
+		t.Fatalf("Expected armor 10.0 after expiry, got %f", sheet.Get(StatArmor))
+	}
+}
+
+func TestIncreasedCannotMakeStatNegative(t *testing.T) {
+	// given
+	// ... a speed stat reduced by 150%
+	sheet := NewStatSheet()
+	sheet.SetBase(StatMoveSpeed, 4.0)
+	sheet.AddModifier(NewModifier(StatMoveSpeed, ModifierIncreased, -1.5, "curse"))
+	// when
+	// ... the final value is read
+	value := sheet.Get(StatMoveSpeed)
+	// then
+	// ... should bottom out at zero
+	if value != 0 {
+		t.Fatalf("Expected speed 0, got %f", value)
+	}
+}
+
+func TestEnemyModifierUpdatesFields(t *testing.T) {
+	// given
+	// ... an enemy with speed 2 and damage 10
+	enemy := NewEnemy(rl.Vector3{}, 50.0, 2.0)
+	// when
+	// ... a timed haste is applied and then expires
+	enemy.AddModifier(NewTimedModifier(StatMoveSpeed, ModifierMore, 1.0, "haste", 1.0))
+	hasted := enemy.Speed
+	enemy.UpdateStats(1.0)
+	// then
+	// ... speed should double and then return to base
+	if hasted != 4.0 {
+		t.Fatalf("Expected hasted speed 4.0, got %f", hasted)
+	}
+	if enemy.Speed != 2.0 {
+		t.Fatalf("Expected speed 2.0 after haste, got %f", enemy.Speed)
+	}
+}
+
+func TestRemovingMaxHealthModifierClampsHealth(t *testing.T) {
+	// given
+	// ... a player at full health with a +50 max health modifier
+	player := NewPlayer(5.0, nil)
+	player.AddModifier(NewModifier(StatMaxHealth, ModifierFlat, 50.0, "amulet"))
+	player.Health = player.MaxHealth
+	// when
+	// ... the modifier source is removed
+	player.RemoveModifierSource("amulet")
+	// then
+	// ... max health and health should return to the base 100
+	if player.MaxHealth != 100.0 || player.Health != 100.0 {
+		t.Fatalf("Expected health 100/100, got %f/%f", player.Health, player.MaxHealth)
+	}
+}
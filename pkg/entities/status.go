@@ -0,0 +1,293 @@
+package entities
+
+import (
+	"fmt"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/events"
+)
+
+// StatusEffectType names a timed status effect
+type StatusEffectType string
+
+const (
+	StatusBurn   StatusEffectType = "burn"
+	StatusPoison StatusEffectType = "poison"
+	StatusSlow   StatusEffectType = "slow"
+	StatusStun   StatusEffectType = "stun"
+)
+
+// IsKnownStatusEffect reports whether effectType names a supported effect
+func IsKnownStatusEffect(effectType StatusEffectType) bool {
+	switch effectType {
+	case StatusBurn, StatusPoison, StatusSlow, StatusStun:
+		return true
+	default:
+		return false
+	}
+}
+
+// StackingRule decides what happens when an effect is applied to an entity
+// that already has an effect of the same type
+type StackingRule int
+
+const (
+	// StackRefresh keeps one instance, resetting its duration and keeping
+	// the stronger magnitude
+	StackRefresh StackingRule = iota
+	// StackIntensity keeps one instance whose stack count, and so its
+	// strength, grows with each application up to MaxStacks
+	StackIntensity
+	// StackIndependent tracks each application separately
+	StackIndependent
+)
+
+// Status effect tuning
+const (
+	burnTickInterval   = 0.5
+	poisonTickInterval = 1.0
+	poisonMaxStacks    = 5
+	maxSlow            = 0.9 // Slows never root an entity outright
+)
+
+// StatusEffect is a single timed effect on an entity. Magnitude is damage
+// per tick for burn and poison and the fraction of speed removed for slow.
+type StatusEffect struct {
+	Type         StatusEffectType
+	Magnitude    float32
+	Duration     float32
+	Remaining    float32
+	TickInterval float32
+	Stacking     StackingRule
+	Stacks       int
+	MaxStacks    int
+	SourceID     string
+
+	tickTimer float32
+}
+
+// NewStatusEffect creates an effect with the stacking and tick rules of its type
+func NewStatusEffect(effectType StatusEffectType, duration, magnitude float32, sourceID string) StatusEffect {
+	effect := StatusEffect{
+		Type:      effectType,
+		Magnitude: magnitude,
+		Duration:  duration,
+		Remaining: duration,
+		Stacking:  StackRefresh,
+		Stacks:    1,
+		MaxStacks: 1,
+		SourceID:  sourceID,
+	}
+
+	switch effectType {
+	case StatusBurn:
+		effect.TickInterval = burnTickInterval
+	case StatusPoison:
+		effect.TickInterval = poisonTickInterval
+		effect.Stacking = StackIntensity
+		effect.MaxStacks = poisonMaxStacks
+	case StatusSlow:
+		effect.Stacking = StackIndependent
+	}
+
+	return effect
+}
+
+// StatusEffectFromSpec creates an effect described by an event, applied by sourceID
+func StatusEffectFromSpec(spec events.StatusEffectSpec, sourceID string) StatusEffect {
+	return NewStatusEffect(StatusEffectType(spec.Type), spec.Duration, spec.Magnitude, sourceID)
+}
+
+// Spec returns the event representation of the effect
+func (s *StatusEffect) Spec() events.StatusEffectSpec {
+	return events.StatusEffectSpec{
+		Type:      string(s.Type),
+		Duration:  s.Duration,
+		Magnitude: s.Magnitude,
+	}
+}
+
+// DamageType returns the damage type dealt by the effect's ticks
+func (s *StatusEffect) DamageType() DamageType {
+	switch s.Type {
+	case StatusBurn:
+		return DamageFire
+	case StatusPoison:
+		return DamagePoison
+	default:
+		return ""
+	}
+}
+
+// TickDamage returns the damage dealt each tick, accounting for stacks
+func (s *StatusEffect) TickDamage() float32 {
+	if s.TickInterval <= 0 {
+		return 0
+	}
+	return s.Magnitude * float32(s.Stacks)
+}
+
+// StatusComponent holds the status effects currently active on an entity
+type StatusComponent struct {
+	Effects []StatusEffect
+}
+
+// ApplyStatus adds an effect following its stacking rule and returns the
+// resulting effect instance
+func (c *StatusComponent) ApplyStatus(effect StatusEffect) StatusEffect {
+	if effect.Stacks <= 0 {
+		effect.Stacks = 1
+	}
+	effect.Remaining = effect.Duration
+
+	if effect.Stacking != StackIndependent {
+		for i := range c.Effects {
+			existing := &c.Effects[i]
+			if existing.Type != effect.Type {
+				continue
+			}
+
+			existing.Remaining = max(existing.Remaining, effect.Duration)
+			existing.Duration = max(existing.Duration, effect.Duration)
+			existing.SourceID = effect.SourceID
+			if effect.Stacking == StackIntensity {
+				existing.Stacks = min(existing.Stacks+effect.Stacks, existing.MaxStacks)
+			} else {
+				existing.Magnitude = max(existing.Magnitude, effect.Magnitude)
+			}
+			return *existing
+		}
+	}
+
+	c.Effects = append(c.Effects, effect)
+	return effect
+}
+
+// TickStatus advances every effect by deltaTime. It returns the damage
+// each damaging effect dealt this tick and the effects that expired.
+func (c *StatusComponent) TickStatus(deltaTime float32) ([]DamageInfo, []StatusEffect) {
+	var ticks []DamageInfo
+	var expired []StatusEffect
+
+	active := c.Effects[:0]
+	for _, effect := range c.Effects {
+		elapsed := min(deltaTime, effect.Remaining)
+		effect.Remaining -= deltaTime
+
+		if effect.TickInterval > 0 {
+			effect.tickTimer += elapsed
+			for effect.tickTimer >= effect.TickInterval {
+				effect.tickTimer -= effect.TickInterval
+				tick := NewDamage(effect.TickDamage(), effect.DamageType(), effect.SourceID)
+				tick.Periodic = true
+				ticks = append(ticks, tick)
+			}
+		}
+
+		if effect.Remaining <= 0 {
+			expired = append(expired, effect)
+			continue
+		}
+		active = append(active, effect)
+	}
+	c.Effects = active
+
+	return ticks, expired
+}
+
+// HasStatus reports whether an effect of the given type is active
+func (c *StatusComponent) HasStatus(effectType StatusEffectType) bool {
+	for _, effect := range c.Effects {
+		if effect.Type == effectType {
+			return true
+		}
+	}
+	return false
+}
+
+// CanAct reports whether the entity may move, attack or shoot
+func (c *StatusComponent) CanAct() bool {
+	return !c.HasStatus(StatusStun)
+}
+
+// SpeedMultiplier returns the fraction of normal speed the entity moves at.
+// Only the strongest slow applies.
+func (c *StatusComponent) SpeedMultiplier() float32 {
+	if !c.CanAct() {
+		return 0
+	}
+
+	var slow float32
+	for _, effect := range c.Effects {
+		if effect.Type == StatusSlow {
+			slow = max(slow, effect.Magnitude)
+		}
+	}
+	return 1 - min(slow, maxSlow)
+}
+
+// ClearStatus removes every active effect without publishing expiry
+func (c *StatusComponent) ClearStatus() {
+	c.Effects = nil
+}
+
+// StatusColor returns the colour used to show an effect type
+func StatusColor(effectType StatusEffectType) rl.Color {
+	switch effectType {
+	case StatusBurn:
+		return rl.Orange
+	case StatusPoison:
+		return rl.Lime
+	case StatusSlow:
+		return rl.SkyBlue
+	case StatusStun:
+		return rl.Yellow
+	default:
+		return rl.White
+	}
+}
+
+// StatusReceiver is implemented by entities that status effects can be
+// applied to
+type StatusReceiver interface {
+	Damageable
+	ApplyStatusEffect(effect StatusEffect)
+	UpdateStatus(deltaTime float32)
+}
+
+// applyStatusEffect adds effect to status and publishes the application
+func applyStatusEffect(owner Identifiable, status *StatusComponent, bus events.Subject, effect StatusEffect) {
+	applied := status.ApplyStatus(effect)
+
+	if bus != nil {
+		appliedEvent := events.NewStatusAppliedEvent(
+			owner.GetID(), string(applied.Type), applied.Stacks, applied.Remaining, applied.SourceID,
+		)
+		if err := bus.Notify(appliedEvent); err != nil {
+			fmt.Printf("Error notifying status applied: %v\n", err)
+		}
+	}
+}
+
+// updateStatus ticks status, deals tick damage to owner and publishes expiry
+func updateStatus(owner Damageable, status *StatusComponent, bus events.Subject, deltaTime float32) {
+	ticks, expired := status.TickStatus(deltaTime)
+
+	for _, tick := range ticks {
+		if !owner.IsAlive() {
+			break
+		}
+		owner.TakeDamage(tick)
+	}
+
+	if bus == nil {
+		return
+	}
+	for _, effect := range expired {
+		expiredEvent := events.NewStatusExpiredEvent(owner.GetID(), string(effect.Type))
+		if err := bus.Notify(expiredEvent); err != nil {
+			fmt.Printf("Error notifying status expired: %v\n", err)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package entities
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/events"
+)
+
+func TestStatusStackingRules(t *testing.T) {
+	// given
+	// ... an empty status component
+	status := &StatusComponent{}
+	// when
+	// ... burn is applied twice, poison seven times and slow twice
+	status.ApplyStatus(NewStatusEffect(StatusBurn, 2.0, 3.0, ""))
+	status.ApplyStatus(NewStatusEffect(StatusBurn, 4.0, 1.0, ""))
+	for i := 0; i < 7; i++ {
+		status.ApplyStatus(NewStatusEffect(StatusPoison, 3.0, 1.0, ""))
+	}
+	status.ApplyStatus(NewStatusEffect(StatusSlow, 1.0, 0.3, ""))
+	status.ApplyStatus(NewStatusEffect(StatusSlow, 2.0, 0.5, ""))
+	// then
+	// ... burn should refresh to the longer duration and keep the stronger magnitude
+	// ... poison should stack up to its cap
+	// ... each slow should be tracked independently
+	if len(status.Effects) != 4 {
+		t.Fatalf("Expected 4 effect instances, got %d", len(status.Effects))
+	}
+	burn := status.Effects[0]
+	if burn.Remaining != 4.0 || burn.Magnitude != 3.0 {
+		t.Fatalf("Expected refreshed burn of 4s at 3.0, got %fs at %f", burn.Remaining, burn.Magnitude)
+	}
+	poison := status.Effects[1]
+	if poison.Stacks != poisonMaxStacks {
+		t.Fatalf("Expected %d poison stacks, got %d", poisonMaxStacks, poison.Stacks)
+	}
+	if multiplier := status.SpeedMultiplier(); multiplier != 0.5 {
+		t.Fatalf("Expected the strongest slow to halve speed, got %f", multiplier)
+	}
+}
+
+func TestBurnTicksFireDamageThroughResistance(t *testing.T) {
+	// given
+	// ... an enemy with 50% fire resistance burning for 3 per tick
+	enemy := NewEnemy(rl.Vector3{}, 50.0, 2.0)
+	enemy.ID = "enemy_1"
+	enemy.Resistances = map[DamageType]float32{DamageFire: 0.5}
+	enemy.ApplyStatusEffect(NewStatusEffect(StatusBurn, 1.0, 3.0, PlayerID))
+	// when
+	// ... one second passes
+	enemy.UpdateStatus(0.5)
+	enemy.UpdateStatus(0.5)
+	// then
+	// ... should take two resisted ticks and the burn should expire
+	if enemy.Health != 47.0 {
+		t.Fatalf("Expected health 47.0, got %f", enemy.Health)
+	}
+	if enemy.HasStatus(StatusBurn) {
+		t.Fatal("Burn should have expired")
+	}
+	if enemy.LastDamageSource != PlayerID {
+		t.Fatalf("Expected burn damage credited to %s, got %s", PlayerID, enemy.LastDamageSource)
+	}
+}
+
+func TestStatusEventsFireOnApplyAndExpire(t *testing.T) {
+	// given
+	// ... a player with a mock event bus
+	mockEventBus := &MockEventBus{}
+	player := NewPlayer(5.0, mockEventBus)
+	// when
+	// ... a slow is applied and runs out
+	player.ApplyStatusEffect(NewStatusEffect(StatusSlow, 1.0, 0.5, "enemy_1"))
+	player.UpdateStatus(1.0)
+	// then
+	// ... should publish an applied then an expired event
+	if len(mockEventBus.events) != 2 {
+		t.Fatalf("Expected 2 events, got %d", len(mockEventBus.events))
+	}
+	applied, ok := mockEventBus.events[0].Data.(events.StatusAppliedEvent)
+	if !ok || applied.EntityId != PlayerID || applied.EffectType != "slow" || applied.SourceId != "enemy_1" {
+		t.Fatalf("Unexpected applied event: %+v", mockEventBus.events[0])
+	}
+	expired, ok := mockEventBus.events[1].Data.(events.StatusExpiredEvent)
+	if !ok || expired.EntityId != PlayerID || expired.EffectType != "slow" {
+		t.Fatalf("Unexpected expired event: %+v", mockEventBus.events[1])
+	}
+}
+
+func TestStunnedEnemyCannotMoveOrAttack(t *testing.T) {
+	// given
+	// ... a chasing enemy that is stunned
+	// ... a player within reach
+	enemy := NewEnemy(rl.Vector3{}, 50.0, 2.0)
+	enemy.ID = "enemy_1"
+	enemy.State = AIStateChase
+	enemy.ApplyStatusEffect(NewStatusEffect(StatusStun, 1.0, 0, PlayerID))
+	player := NewPlayer(5.0, nil)
+	player.Position = rl.Vector3{X: 3}
+	// when
+	// ... the enemy updates and touches the player
+	enemy.Update(0.1, player)
+	enemy.OnCollision(player)
+	// then
+	// ... should neither move nor deal damage
+	if enemy.Velocity != (rl.Vector3{}) {
+		t.Fatal("Stunned enemy should not move")
+	}
+	if player.Health != player.MaxHealth {
+		t.Fatal("Stunned enemy should not attack")
+	}
+}
+
+func TestSlowedEnemyMovesAtReducedSpeed(t *testing.T) {
+	// given
+	// ... a chasing enemy slowed by 40%
+	enemy := NewEnemy(rl.Vector3{}, 50.0, 2.0)
+	enemy.ID = "enemy_1"
+	enemy.State = AIStateChase
+	enemy.ApplyStatusEffect(NewStatusEffect(StatusSlow, 1.0, 0.4, PlayerID))
+	player := NewPlayer(5.0, nil)
+	player.Position = rl.Vector3{X: 5}
+	// when
+	// ... the enemy updates
+	enemy.Update(0.1, player)
+	// then
+	// ... its velocity should be scaled down
+	if enemy.Velocity.X < 0.59 || enemy.Velocity.X > 0.61 {
+		t.Fatalf("Expected velocity 0.6 toward the player, got %f", enemy.Velocity.X)
+	}
+}
+
+func TestBulletAppliesStatusEffectsOnHit(t *testing.T) {
+	// given
+	// ... an enemy bullet carrying poison
+	player := NewPlayer(5.0, nil)
+	bullet := NewBullet(rl.Vector3{}, rl.Vector3{X: 1}, 10, 1, 5)
+	bullet.ID = "bullet_1"
+	bullet.OwnerID = "enemy_1"
+	bullet.Faction = FactionEnemy
+	bullet.StatusEffects = []StatusEffect{NewStatusEffect(StatusPoison, 3.0, 1.0, "")}
+	// when
+	// ... the bullet hits the player
+	bullet.OnCollision(player)
+	// then
+	// ... the player should be poisoned by the shooter
+	if len(player.Effects) != 1 || player.Effects[0].Type != StatusPoison {
+		t.Fatal("Expected the player to be poisoned")
+	}
+	if player.Effects[0].SourceID != "enemy_1" {
+		t.Fatalf("Expected poison source enemy_1, got %s", player.Effects[0].SourceID)
+	}
+}
+
+func TestHazardReappliesOnlyAfterInterval(t *testing.T) {
+	// given
+	// ... a poison hazard and a player standing in it
+	hazard := NewHazardZone(rl.Vector3{}, 2.0, NewStatusEffect(StatusPoison, 5.0, 1.0, ""))
+	hazard.ID = "hazard_1"
+	player := NewPlayer(5.0, nil)
+	// when
+	// ... the trigger fires on consecutive frames
+	hazard.OnTriggerEnter(player)
+	hazard.Update(0.1)
+	hazard.OnTriggerEnter(player)
+	// then
+	// ... should only apply once
+	if player.Effects[0].Stacks != 1 {
+		t.Fatalf("Expected 1 poison stack, got %d", player.Effects[0].Stacks)
+	}
+	// when
+	// ... the apply interval passes
+	hazard.Update(DefaultHazardInterval)
+	hazard.OnTriggerEnter(player)
+	// then
+	// ... should stack again
+	if player.Effects[0].Stacks != 2 {
+		t.Fatalf("Expected 2 poison stacks, got %d", player.Effects[0].Stacks)
+	}
+}
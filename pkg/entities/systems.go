@@ -0,0 +1,148 @@
+package entities
+
+import (
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// AIAgent is implemented by entities whose behaviour is driven by the AI system
+type AIAgent interface {
+	Think(w *World, deltaTime float32)
+}
+
+// Updatable is implemented by entities with simple per-tick logic
+type Updatable interface {
+	Update(deltaTime float32)
+}
+
+// NewPlayerControlSystem updates the player from input while it is alive
+func NewPlayerControlSystem(camera CameraInterface) System {
+	return SystemFunc(func(w *World, deltaTime float32) {
+		player := w.Player()
+		if player == nil || !player.IsAlive() {
+			return
+		}
+		player.Update(deltaTime, camera)
+	})
+}
+
+// NewAISystem runs Think on every active entity with an AI component
+func NewAISystem() System {
+	return SystemFunc(func(w *World, deltaTime float32) {
+		for _, entity := range w.Query(ComponentAI) {
+			if !entity.IsActive() {
+				continue
+			}
+			if agent, ok := entity.(AIAgent); ok {
+				agent.Think(w, deltaTime)
+			}
+		}
+	})
+}
+
+// NewMovementSystem moves every active entity along its velocity. Walkers
+// hold still rather than climb ground that is too steep.
+func NewMovementSystem() System {
+	return SystemFunc(func(w *World, deltaTime float32) {
+		for _, entity := range w.Query(ComponentTransform | ComponentVelocity) {
+			if !entity.IsActive() {
+				continue
+			}
+
+			components, _ := w.GetComponents(entity.GetID())
+			transform := components.Transform
+			velocity := components.Velocity
+
+			newPos := rl.Vector3{
+				X: transform.Position.X + velocity.Velocity.X*velocity.Speed*deltaTime,
+				Y: transform.Position.Y + velocity.Velocity.Y*velocity.Speed*deltaTime,
+				Z: transform.Position.Z + velocity.Velocity.Z*velocity.Speed*deltaTime,
+			}
+			if w.ground != nil && walksOnGround(entity) && !w.ground.CanClimb(transform.Position, newPos) {
+				continue
+			}
+			transform.Position = newPos
+		}
+	})
+}
+
+// NewStatusSystem ticks status effects on every active entity that has them
+func NewStatusSystem() System {
+	return SystemFunc(func(w *World, deltaTime float32) {
+		for _, entity := range w.Query(ComponentStatus) {
+			if !entity.IsActive() {
+				continue
+			}
+			if receiver, ok := entity.(StatusReceiver); ok {
+				receiver.UpdateStatus(deltaTime)
+			}
+		}
+	})
+}
+
+// NewStatsSystem expires timed stat modifiers on every active entity
+func NewStatsSystem() System {
+	return SystemFunc(func(w *World, deltaTime float32) {
+		for _, entity := range w.Query(ComponentStats) {
+			if !entity.IsActive() {
+				continue
+			}
+			if holder, ok := entity.(StatHolder); ok {
+				holder.UpdateStats(deltaTime)
+			}
+		}
+	})
+}
+
+// NewTickSystem calls Update on every entity implementing Updatable
+func NewTickSystem() System {
+	return SystemFunc(func(w *World, deltaTime float32) {
+		for _, entity := range w.Entities() {
+			if updatable, ok := entity.(Updatable); ok {
+				updatable.Update(deltaTime)
+			}
+		}
+	})
+}
+
+// NewCollisionSystem resolves collisions and triggers for the world
+func NewCollisionSystem() System {
+	return SystemFunc(func(w *World, deltaTime float32) {
+		if w.collision != nil {
+			w.collision.Update()
+		}
+		if w.triggers != nil {
+			w.triggers.Update()
+		}
+	})
+}
+
+// NewCleanupSystem despawns every inactive entity except the player, whose
+// death is handled by the scene. Despawns go through the action queue so
+// they are recorded alongside the hits that caused them.
+func NewCleanupSystem() System {
+	return SystemFunc(func(w *World, deltaTime float32) {
+		for _, entity := range w.Entities() {
+			if entity.IsActive() || entity == Entity(w.Player()) {
+				continue
+			}
+			w.HandleAction(NewDespawnAction(entity.GetID()))
+		}
+		w.FlushActions()
+	})
+}
+
+// AddDefaultSystems registers the standard gameplay systems on the world
+func (w *World) AddDefaultSystems(camera CameraInterface) {
+	w.AddSystem("player_control", OrderInput, NewPlayerControlSystem(camera))
+	w.AddSystem("ai", OrderAI, NewAISystem())
+	w.AddSystem("movement", OrderMovement, NewMovementSystem())
+	w.AddSystem("ground", OrderMovement, NewGroundSystem())
+	w.AddSystem("stats", OrderTick, NewStatsSystem())
+	w.AddSystem("status", OrderTick, NewStatusSystem())
+	w.AddSystem("tick", OrderTick, NewTickSystem())
+	w.AddSystem("pickups", OrderTick, NewPickupSystem())
+	w.AddSystem("spawners", OrderTick, NewSpawnerSystem())
+	w.AddSystem("collision", OrderCollision, NewCollisionSystem())
+	w.AddSystem("actions", OrderActions, NewActionSystem())
+	w.AddSystem("cleanup", OrderCleanup, NewCleanupSystem())
+}
@@ -0,0 +1,262 @@
+package entities
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/events"
+)
+
+// WaveEntry is an archetype the wave director can send. Entries join the
+// mix at FromWave and grow more common by WeightGrowth every wave after.
+type WaveEntry struct {
+	Archetype    string
+	Weight       float32
+	WeightGrowth float32
+	FromWave     int
+}
+
+// weight returns the entry's weight in the given wave, or 0 before it unlocks
+func (e WaveEntry) weight(wave int) float32 {
+	if wave < e.FromWave {
+		return 0
+	}
+	return max(e.Weight+e.WeightGrowth*float32(wave-max(e.FromWave, 1)), 0)
+}
+
+// WaveConfig describes how the wave director escalates
+type WaveConfig struct {
+	Waves         int     // Number of waves, 0 is endless
+	FirstDelay    float32 // Seconds before the first wave
+	Interval      float32 // Seconds between a wave completing and the next starting
+	BaseCount     int     // Enemies in the first wave
+	CountGrowth   int     // Extra enemies in each following wave
+	SpawnInterval float32 // Seconds between spawns within a wave
+	SpawnPoints   []rl.Vector3
+	Composition   []WaveEntry
+}
+
+// Validate checks the config can produce waves
+func (c WaveConfig) Validate() error {
+	if c.Waves < 0 {
+		return fmt.Errorf("wave count cannot be negative, got %d", c.Waves)
+	}
+	if c.FirstDelay < 0 || c.Interval < 0 || c.SpawnInterval < 0 {
+		return fmt.Errorf("wave delays cannot be negative")
+	}
+	if c.BaseCount <= 0 {
+		return fmt.Errorf("base count must be positive, got %d", c.BaseCount)
+	}
+	if c.CountGrowth < 0 {
+		return fmt.Errorf("count growth cannot be negative, got %d", c.CountGrowth)
+	}
+	if len(c.SpawnPoints) == 0 {
+		return fmt.Errorf("at least one spawn point is required")
+	}
+	if len(c.Composition) == 0 {
+		return fmt.Errorf("composition cannot be empty")
+	}
+	firstWave := false
+	for _, entry := range c.Composition {
+		if entry.Weight < 0 {
+			return fmt.Errorf("%s weight cannot be negative, got %f", entry.Archetype, entry.Weight)
+		}
+		if entry.weight(1) > 0 {
+			firstWave = true
+		}
+	}
+	if !firstWave {
+		return fmt.Errorf("composition has nothing to send in wave 1")
+	}
+	return nil
+}
+
+// WaveDirector sends escalating waves of enemies. Between waves it counts
+// down to the next one; a wave completes once all of its enemies have been
+// spawned and killed.
+type WaveDirector struct {
+	config   WaveConfig
+	factory  EnemyFactory
+	rng      *rand.Rand
+	eventBus events.Subject
+
+	wave          int
+	countdown     float32
+	toSpawn       int
+	spawnCooldown float32
+	nextPoint     int
+	alive         []string
+	finished      bool
+}
+
+func NewWaveDirector(config WaveConfig, factory EnemyFactory, rng *rand.Rand, eventBus events.Subject) *WaveDirector {
+	return &WaveDirector{
+		config:    config,
+		factory:   factory,
+		rng:       rng,
+		eventBus:  eventBus,
+		countdown: config.FirstDelay,
+	}
+}
+
+// Wave returns the current wave number, 0 before the first wave
+func (d *WaveDirector) Wave() int {
+	return d.wave
+}
+
+// TotalWaves returns the number of waves, 0 when endless
+func (d *WaveDirector) TotalWaves() int {
+	return d.config.Waves
+}
+
+// InProgress reports whether a wave is being fought
+func (d *WaveDirector) InProgress() bool {
+	return d.wave > 0 && d.countdown <= 0 && !d.finished
+}
+
+// TimeUntilNextWave returns the seconds until the next wave starts, or 0
+// while a wave is in progress or after the last one
+func (d *WaveDirector) TimeUntilNextWave() float32 {
+	if d.finished {
+		return 0
+	}
+	return max(d.countdown, 0)
+}
+
+// Remaining returns how many enemies of the current wave are still to be
+// spawned or alive
+func (d *WaveDirector) Remaining() int {
+	return d.toSpawn + len(d.alive)
+}
+
+// Finished reports whether every wave has been completed
+func (d *WaveDirector) Finished() bool {
+	return d.finished
+}
+
+// WaveSize returns the number of enemies sent in the given wave
+func (d *WaveDirector) WaveSize(wave int) int {
+	return d.config.BaseCount + d.config.CountGrowth*(wave-1)
+}
+
+// Update counts down to the next wave or spawns and tracks the current one
+func (d *WaveDirector) Update(w *World, deltaTime float32) {
+	if d.finished {
+		return
+	}
+
+	if d.countdown > 0 {
+		d.countdown -= deltaTime
+		if d.countdown > 0 {
+			return
+		}
+		d.startWave(w)
+	} else if d.wave == 0 {
+		d.startWave(w) // No first delay
+	}
+
+	d.alive = slices.DeleteFunc(d.alive, func(id string) bool {
+		entity, exists := w.Get(id)
+		return !exists || !entity.IsActive()
+	})
+
+	// Without a spawn interval the whole wave arrives at once
+	d.spawnCooldown -= deltaTime
+	for d.toSpawn > 0 && d.spawnCooldown <= 0 {
+		if err := d.spawn(w); err != nil {
+			fmt.Printf("Wave %d failed to spawn: %v\n", d.wave, err)
+		}
+		d.toSpawn--
+		d.spawnCooldown += d.config.SpawnInterval
+	}
+
+	if d.toSpawn == 0 && len(d.alive) == 0 {
+		d.completeWave()
+	}
+}
+
+// startWave begins the next wave and starts spawners waiting for it
+func (d *WaveDirector) startWave(w *World) {
+	d.wave++
+	d.countdown = 0
+	d.toSpawn = d.WaveSize(d.wave)
+	d.spawnCooldown = 0
+
+	for _, spawner := range OfType[*Spawner](w) {
+		if spawner.Activation == ActivationWave && spawner.ActivationWave <= d.wave {
+			spawner.Activate()
+		}
+	}
+
+	d.notify(events.NewWaveStartedEvent(d.wave, d.toSpawn))
+}
+
+// completeWave finishes the current wave and counts down to the next
+func (d *WaveDirector) completeWave() {
+	if d.config.Waves > 0 && d.wave >= d.config.Waves {
+		d.finished = true
+	} else {
+		// A zero interval still leaves one tick between waves
+		d.countdown = max(d.config.Interval, 1e-6)
+	}
+	d.notify(events.NewWaveCompletedEvent(d.wave, d.TimeUntilNextWave()))
+}
+
+// spawn sends one enemy picked from the composition at the next spawn point
+func (d *WaveDirector) spawn(w *World) error {
+	archetype := d.pickArchetype()
+	if archetype == "" {
+		return fmt.Errorf("no archetype available")
+	}
+	position := d.config.SpawnPoints[d.nextPoint%len(d.config.SpawnPoints)]
+	d.nextPoint++
+
+	enemy, err := d.factory(archetype, position)
+	if err != nil {
+		return err
+	}
+	enemy.ID = w.NextID(fmt.Sprintf("wave_%d", d.wave))
+	if err := w.Spawn(enemy); err != nil {
+		return err
+	}
+	d.alive = append(d.alive, enemy.ID)
+	return nil
+}
+
+// pickArchetype rolls an archetype weighted for the current wave
+func (d *WaveDirector) pickArchetype() string {
+	var total float32
+	for _, entry := range d.config.Composition {
+		total += entry.weight(d.wave)
+	}
+	if total <= 0 {
+		return ""
+	}
+
+	roll := d.rng.Float32() * total
+	picked := ""
+	for _, entry := range d.config.Composition {
+		weight := entry.weight(d.wave)
+		if weight <= 0 {
+			continue
+		}
+		picked = entry.Archetype
+		if roll < weight {
+			break
+		}
+		roll -= weight
+	}
+	return picked
+}
+
+func (d *WaveDirector) notify(event events.Event) {
+	if d.eventBus == nil {
+		return
+	}
+	if err := d.eventBus.Notify(event); err != nil {
+		fmt.Printf("Error notifying wave event: %v\n", err)
+	}
+}
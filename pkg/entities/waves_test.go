@@ -0,0 +1,165 @@
+package entities
+
+import (
+	"math/rand"
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/events"
+)
+
+// killAll deactivates every enemy in the world
+func killAll(world *World) {
+	for _, enemy := range OfType[*Enemy](world) {
+		enemy.Active = false
+	}
+}
+
+func TestWaveDirectorRunsEscalatingWaves(t *testing.T) {
+	// given
+	// ... two waves of 2 and 3 enemies, the second unlocking brutes
+	world := testWorld()
+	bus := &MockEventBus{}
+	config := WaveConfig{
+		Waves:       2,
+		FirstDelay:  3,
+		Interval:    5,
+		BaseCount:   2,
+		CountGrowth: 1,
+		SpawnPoints: []rl.Vector3{{X: 10}, {X: -10}},
+		Composition: []WaveEntry{
+			{Archetype: "grunt", Weight: 1},
+			{Archetype: "brute", Weight: 1, FromWave: 2},
+		},
+	}
+	director := NewWaveDirector(config, testEnemyFactory, rand.New(rand.NewSource(1)), bus)
+	world.AddSystem("waves", OrderTick, director)
+	world.AddSystem("cleanup", OrderCleanup, NewCleanupSystem())
+	// when
+	// ... less than the first delay passes
+	world.Update(2)
+	// then
+	// ... should still be counting down
+	if director.Wave() != 0 || director.TimeUntilNextWave() != 1 {
+		t.Fatalf("Expected 1s until wave 1, got wave %d in %f", director.Wave(), director.TimeUntilNextWave())
+	}
+	// when
+	// ... the delay runs out and the wave finishes spawning
+	world.Update(1)
+	world.Update(0.1)
+	// then
+	// ... should start wave 1 with 2 grunts
+	enemies := OfType[*Enemy](world)
+	if director.Wave() != 1 || !director.InProgress() || len(enemies) != 2 {
+		t.Fatalf("Expected wave 1 in progress with 2 enemies, got wave %d with %d", director.Wave(), len(enemies))
+	}
+	for _, enemy := range enemies {
+		if enemy.Archetype != "grunt" {
+			t.Fatalf("Expected only grunts in wave 1, got %s", enemy.Archetype)
+		}
+	}
+	// when
+	// ... the wave is killed
+	killAll(world)
+	world.Update(0.1)
+	world.Update(0.1)
+	// then
+	// ... should complete wave 1 and count down to wave 2
+	if director.InProgress() || director.TimeUntilNextWave() <= 0 {
+		t.Fatalf("Expected a break before wave 2, got %f", director.TimeUntilNextWave())
+	}
+	// when
+	// ... the break passes and wave 2 is spawned and killed
+	world.Update(5)
+	world.Update(0.1)
+	world.Update(0.1)
+	spawned := len(OfType[*Enemy](world))
+	killAll(world)
+	world.Update(0.1)
+	world.Update(0.1)
+	// then
+	// ... should have sent 3 enemies and finished
+	if spawned != 3 {
+		t.Fatalf("Expected 3 enemies in wave 2, got %d", spawned)
+	}
+	if !director.Finished() || director.Wave() != 2 {
+		t.Fatalf("Expected director finished after wave 2, got wave %d", director.Wave())
+	}
+	started, completed := countEvents(bus, events.EventTypeWaveStarted), countEvents(bus, events.EventTypeWaveCompleted)
+	if started != 2 || completed != 2 {
+		t.Fatalf("Expected 2 started and 2 completed events, got %d and %d", started, completed)
+	}
+}
+
+func TestWaveDirectorActivatesWaveSpawners(t *testing.T) {
+	// given
+	// ... a spawner waiting for wave 2
+	world := testWorld()
+	config := WaveConfig{
+		Waves:       2,
+		FirstDelay:  3,
+		Interval:    5,
+		BaseCount:   2,
+		CountGrowth: 1,
+		SpawnPoints: []rl.Vector3{{X: 10}, {X: -10}},
+		Composition: []WaveEntry{
+			{Archetype: "grunt", Weight: 1},
+			{Archetype: "brute", Weight: 1, FromWave: 2},
+		},
+	}
+	config.FirstDelay = 0
+	director := NewWaveDirector(config, testEnemyFactory, rand.New(rand.NewSource(1)), nil)
+	spawner := NewSpawner(rl.Vector3{Z: 10}, []string{"grunt"}, 1, testEnemyFactory)
+	spawner.ID = "spawner_1"
+	spawner.Activation = ActivationWave
+	spawner.ActivationWave = 2
+	if err := world.Spawn(spawner); err != nil {
+		t.Fatalf("Unexpected spawn error: %v", err)
+	}
+	// when
+	// ... wave 1 starts
+	director.Update(world, 0.1)
+	// then
+	// ... should leave the spawner waiting
+	if director.Wave() != 1 || spawner.IsActivated() {
+		t.Fatal("Expected spawner to wait for wave 2")
+	}
+	// when
+	// ... wave 1 is cleared and wave 2 starts
+	for director.Wave() < 2 {
+		killAll(world)
+		director.Update(world, 1)
+	}
+	// then
+	// ... should activate the spawner
+	if !spawner.IsActivated() {
+		t.Fatal("Expected spawner to be activated by wave 2")
+	}
+}
+
+func TestWaveConfigValidation(t *testing.T) {
+	// given
+	// ... a config whose only archetype unlocks in wave 3
+	config := WaveConfig{
+		Waves:       2,
+		FirstDelay:  3,
+		Interval:    5,
+		BaseCount:   2,
+		CountGrowth: 1,
+		SpawnPoints: []rl.Vector3{{X: 10}, {X: -10}},
+		Composition: []WaveEntry{
+			{Archetype: "grunt", Weight: 1},
+			{Archetype: "brute", Weight: 1, FromWave: 2},
+		},
+	}
+	config.Composition = []WaveEntry{{Archetype: "brute", Weight: 1, FromWave: 3}}
+	// when
+	// ... it is validated
+	err := config.Validate()
+	// then
+	// ... should fail because wave 1 would be empty
+	if err == nil {
+		t.Fatal("Expected error for a composition with nothing in wave 1")
+	}
+}
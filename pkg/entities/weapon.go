@@ -0,0 +1,147 @@
+package entities
+
+import (
+	"math"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// WeaponDefinition describes how a weapon fires. Damage, speed and lifetime
+// scale the wielder's projectile values so items and config still apply.
+type WeaponDefinition struct {
+	ID               string
+	Name             string
+	FireRate         float32 // Shots per second while the trigger is held
+	ProjectileCount  int     // Projectiles per shot
+	SpreadAngle      float32 // Degrees between the outermost projectiles of a shot
+	MagazineSize     int     // Shots before reloading; 0 never reloads
+	ReloadTime       float32 // Seconds to refill the magazine
+	DamageMultiplier float32 // Scales the wielder's damage per projectile
+	SpeedMultiplier  float32 // Scales the wielder's projectile speed
+	Projectile       ProjectileModifiers
+}
+
+// DefaultWeapon is a single-shot sidearm used when no weapons are configured
+func DefaultWeapon() *WeaponDefinition {
+	return &WeaponDefinition{
+		ID:               "pistol",
+		Name:             "Pistol",
+		FireRate:         4,
+		ProjectileCount:  1,
+		DamageMultiplier: 1,
+		SpeedMultiplier:  1,
+	}
+}
+
+// Weapon is a wielded copy of a weapon definition with its own ammunition
+// and timers
+type Weapon struct {
+	Definition *WeaponDefinition
+	Ammo       int
+
+	cooldown        float32
+	reloadRemaining float32
+}
+
+// NewWeapon creates a weapon with a full magazine
+func NewWeapon(definition *WeaponDefinition) *Weapon {
+	return &Weapon{Definition: definition, Ammo: definition.MagazineSize}
+}
+
+// Update counts down the time between shots and any reload in progress
+func (w *Weapon) Update(deltaTime float32) {
+	w.cooldown = max(w.cooldown-deltaTime, 0)
+	if w.reloadRemaining > 0 {
+		w.reloadRemaining -= deltaTime
+		if w.reloadRemaining <= 0 {
+			w.reloadRemaining = 0
+			w.Ammo = w.Definition.MagazineSize
+		}
+	}
+}
+
+// CanFire reports whether the weapon is off cooldown, not reloading and has
+// ammunition
+func (w *Weapon) CanFire() bool {
+	if w.cooldown > 0 || w.IsReloading() {
+		return false
+	}
+	return w.Definition.MagazineSize == 0 || w.Ammo > 0
+}
+
+// IsReloading reports whether the magazine is being refilled
+func (w *Weapon) IsReloading() bool {
+	return w.reloadRemaining > 0
+}
+
+// ReloadProgress returns how far through its reload the weapon is, from 0 to 1
+func (w *Weapon) ReloadProgress() float32 {
+	if !w.IsReloading() || w.Definition.ReloadTime <= 0 {
+		return 0
+	}
+	return 1 - w.reloadRemaining/w.Definition.ReloadTime
+}
+
+// Reload starts refilling the magazine unless it is full or already reloading
+func (w *Weapon) Reload() {
+	if w.Definition.MagazineSize == 0 || w.Ammo >= w.Definition.MagazineSize || w.IsReloading() {
+		return
+	}
+	if w.Definition.ReloadTime <= 0 {
+		w.Ammo = w.Definition.MagazineSize
+		return
+	}
+	w.reloadRemaining = w.Definition.ReloadTime
+}
+
+// Refill adds rounds to the magazine, or fills it when rounds is 0, and
+// cancels any reload in progress. It reports false for weapons without a
+// magazine and for full magazines.
+func (w *Weapon) Refill(rounds int) bool {
+	if w.Definition.MagazineSize == 0 || (w.Ammo >= w.Definition.MagazineSize && !w.IsReloading()) {
+		return false
+	}
+	if rounds <= 0 {
+		rounds = w.Definition.MagazineSize
+	}
+	w.Ammo = min(w.Ammo+rounds, w.Definition.MagazineSize)
+	w.reloadRemaining = 0
+	return true
+}
+
+// consumeShot starts the cooldown for the next shot and spends a round,
+// reloading automatically once the magazine is empty
+func (w *Weapon) consumeShot() {
+	if w.Definition.FireRate > 0 {
+		w.cooldown = 1 / w.Definition.FireRate
+	}
+	if w.Definition.MagazineSize > 0 {
+		w.Ammo--
+		if w.Ammo <= 0 {
+			w.Reload()
+		}
+	}
+}
+
+// SpreadDirections fans count directions evenly across spreadDegrees in the
+// ground plane, centred on direction
+func SpreadDirections(direction rl.Vector3, count int, spreadDegrees float32) []rl.Vector3 {
+	count = max(count, 1)
+	if count == 1 || spreadDegrees == 0 {
+		directions := make([]rl.Vector3, count)
+		for i := range directions {
+			directions[i] = direction
+		}
+		return directions
+	}
+
+	base := math.Atan2(float64(direction.Z), float64(direction.X))
+	spread := float64(spreadDegrees) * math.Pi / 180
+	step := spread / float64(count-1)
+	directions := make([]rl.Vector3, count)
+	for i := range directions {
+		angle := base - spread/2 + step*float64(i)
+		directions[i] = rl.Vector3{X: float32(math.Cos(angle)), Y: 0, Z: float32(math.Sin(angle))}
+	}
+	return directions
+}
@@ -0,0 +1,128 @@
+package entities
+
+import (
+	"math"
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/events"
+)
+
+func bulletSpawns(bus *MockEventBus) []events.BulletSpawnEvent {
+	var spawns []events.BulletSpawnEvent
+	for _, event := range bus.events {
+		if event.Type == events.EventTypeBulletSpawn {
+			spawns = append(spawns, event.Data.(events.BulletSpawnEvent))
+		}
+	}
+	return spawns
+}
+
+func TestWeaponFiresAtFireRate(t *testing.T) {
+	// given
+	// ... a player holding a weapon firing 4 shots per second
+	bus := &MockEventBus{}
+	player := NewPlayer(5.0, bus)
+	player.SetWeapons([]*WeaponDefinition{{ID: "gun", FireRate: 4, ProjectileCount: 1, DamageMultiplier: 1, SpeedMultiplier: 1}})
+	direction := rl.Vector3{X: 1}
+	// when
+	// ... the trigger is held for one second in 0.05s frames
+	for range 20 {
+		player.FireWeapon(direction)
+		player.ActiveWeapon().Update(0.05)
+	}
+	// then
+	// ... should fire four shots
+	if shots := len(bulletSpawns(bus)); shots != 4 {
+		t.Fatalf("Expected 4 shots, got %d", shots)
+	}
+}
+
+func TestMultishotFansProjectilesAcrossSpread(t *testing.T) {
+	// given
+	// ... a player with a 5 projectile, 40 degree shotgun and half damage
+	bus := &MockEventBus{}
+	player := NewPlayer(5.0, bus)
+	player.BulletSpeed = 20
+	player.BulletLifetime = 2
+	player.SetWeapons([]*WeaponDefinition{{
+		ID: "shotgun", FireRate: 1, ProjectileCount: 5, SpreadAngle: 40, DamageMultiplier: 0.5, SpeedMultiplier: 0.5,
+	}})
+	// when
+	// ... the shotgun fires along +X
+	player.FireWeapon(rl.Vector3{X: 1})
+	// then
+	// ... should emit five bullets from -20 to +20 degrees
+	// ... with scaled speed and the player's lifetime
+	spawns := bulletSpawns(bus)
+	if len(spawns) != 5 {
+		t.Fatalf("Expected 5 bullets, got %d", len(spawns))
+	}
+	for i, spawn := range spawns {
+		angle := math.Atan2(float64(spawn.Direction.Z), float64(spawn.Direction.X)) * 180 / math.Pi
+		expected := -20 + 10*float64(i)
+		if math.Abs(angle-expected) > 0.01 {
+			t.Fatalf("Expected bullet %d at %f degrees, got %f", i, expected, angle)
+		}
+		if spawn.Speed != 10 || spawn.Lifetime != 2 {
+			t.Fatalf("Expected speed 10 and lifetime 2, got %f and %f", spawn.Speed, spawn.Lifetime)
+		}
+	}
+}
+
+func TestEmptyMagazineReloads(t *testing.T) {
+	// given
+	// ... a weapon with a 2 round magazine and a 1 second reload
+	bus := &MockEventBus{}
+	player := NewPlayer(5.0, bus)
+	player.SetWeapons([]*WeaponDefinition{{ID: "gun", FireRate: 100, ProjectileCount: 1, MagazineSize: 2, ReloadTime: 1, DamageMultiplier: 1, SpeedMultiplier: 1}})
+	weapon := player.ActiveWeapon()
+	// when
+	// ... both rounds are fired
+	player.FireWeapon(rl.Vector3{X: 1})
+	weapon.Update(0.1)
+	player.FireWeapon(rl.Vector3{X: 1})
+	weapon.Update(0.1)
+	// then
+	// ... should reload and refuse to fire until the reload finishes
+	if !weapon.IsReloading() {
+		t.Fatal("Expected weapon to reload after emptying its magazine")
+	}
+	if player.FireWeapon(rl.Vector3{X: 1}) {
+		t.Fatal("Expected weapon not to fire while reloading")
+	}
+	weapon.Update(1)
+	if weapon.IsReloading() || weapon.Ammo != 2 {
+		t.Fatalf("Expected full magazine after reload, got %d rounds", weapon.Ammo)
+	}
+	if !player.FireWeapon(rl.Vector3{X: 1}) {
+		t.Fatal("Expected weapon to fire after reloading")
+	}
+}
+
+func TestSwitchWeaponChangesFiredBullets(t *testing.T) {
+	// given
+	// ... a player with a single shot pistol and a triple shot weapon
+	bus := &MockEventBus{}
+	player := NewPlayer(5.0, bus)
+	player.SetWeapons([]*WeaponDefinition{
+		{ID: "pistol", FireRate: 1, ProjectileCount: 1, DamageMultiplier: 1, SpeedMultiplier: 1},
+		{ID: "triple", FireRate: 1, ProjectileCount: 3, SpreadAngle: 20, DamageMultiplier: 1, SpeedMultiplier: 1},
+	})
+	// when
+	// ... the player switches to the second weapon and fires
+	if !player.SwitchWeapon(1) {
+		t.Fatal("Expected switch to succeed")
+	}
+	player.FireWeapon(rl.Vector3{X: 1})
+	// then
+	// ... should fire three bullets
+	// ... and switching to a missing slot should fail
+	if shots := len(bulletSpawns(bus)); shots != 3 {
+		t.Fatalf("Expected 3 bullets, got %d", shots)
+	}
+	if player.SwitchWeapon(2) {
+		t.Fatal("Expected switch to a missing weapon to fail")
+	}
+}
@@ -0,0 +1,305 @@
+package entities
+
+import (
+	"fmt"
+	"slices"
+
+	"arpg/pkg/events"
+	"arpg/pkg/globals"
+	"arpg/pkg/navigation"
+)
+
+// Entity is implemented by everything that lives in a World
+type Entity interface {
+	Identifiable
+	IsActive() bool
+	Components() Components
+}
+
+// System updates entities in a World once per tick
+type System interface {
+	Update(w *World, deltaTime float32)
+}
+
+// SystemFunc adapts a plain function to the System interface
+type SystemFunc func(w *World, deltaTime float32)
+
+func (f SystemFunc) Update(w *World, deltaTime float32) {
+	f(w, deltaTime)
+}
+
+// System ordering slots. Systems with a lower order run first; systems
+// sharing an order run in the order they were added.
+const (
+	OrderInput     = 100
+	OrderAI        = 200
+	OrderMovement  = 300
+	OrderTick      = 400
+	OrderCollision = 500
+	OrderActions   = 800
+	OrderCleanup   = 900
+)
+
+type systemEntry struct {
+	name   string
+	order  int
+	system System
+}
+
+// World owns every runtime entity, stores their components by ID and runs
+// systems over them in a fixed order
+type World struct {
+	registry   *Registry
+	entities   []Entity
+	components map[string]Components
+	systems    []systemEntry
+	player     *Player
+	navGrid    *navigation.Grid
+	ground     globals.Ground
+
+	collision *globals.CollisionSystem
+	triggers  *globals.TriggerSystem
+
+	actions  *ActionQueue
+	eventBus events.Subject
+
+	onSpawn   []func(Entity)
+	onDespawn []func(Entity)
+	onAction  []func(Action)
+}
+
+// NewWorld creates an empty world. Entities spawned into it are registered
+// with the given collision and trigger systems, either of which may be nil.
+func NewWorld(collision *globals.CollisionSystem, triggers *globals.TriggerSystem) *World {
+	return &World{
+		registry:   NewRegistry(),
+		entities:   make([]Entity, 0),
+		components: make(map[string]Components),
+		systems:    make([]systemEntry, 0),
+		actions:    NewActionQueue(),
+		collision:  collision,
+		triggers:   triggers,
+	}
+}
+
+// Spawn adds an entity to the world and registers it with the collision and
+// trigger systems according to the interfaces it implements. Walkers are
+// set down on the ground.
+func (w *World) Spawn(entity Entity) error {
+	if err := w.registry.Register(entity); err != nil {
+		return fmt.Errorf("failed to spawn entity: %w", err)
+	}
+
+	w.entities = append(w.entities, entity)
+	w.components[entity.GetID()] = entity.Components()
+
+	if w.ground != nil && walksOnGround(entity) {
+		transform := w.components[entity.GetID()].Transform
+		transform.Position.Y = w.ground.HeightAt(transform.Position.X, transform.Position.Z)
+	}
+
+	if player, ok := entity.(*Player); ok {
+		w.player = player
+		if w.triggers != nil {
+			w.triggers.RegisterCollidable(player) // Player can activate triggers
+		}
+	}
+	if collidable, ok := entity.(globals.Collidable); ok && w.collision != nil {
+		w.collision.RegisterCollidable(collidable)
+	}
+	if trigger, ok := entity.(globals.Triggerable); ok && w.triggers != nil {
+		w.triggers.RegisterTrigger(trigger)
+	}
+
+	for _, hook := range w.onSpawn {
+		hook(entity)
+	}
+
+	return nil
+}
+
+// Despawn removes the entity with the given ID from the world. Removing a
+// killed entity hands out rewards through the despawn hooks, which undo
+// cannot take back, so the action history is cleared.
+func (w *World) Despawn(id string) {
+	entity, exists := w.Get(id)
+	if !exists {
+		return
+	}
+	if w.wasKilled(id) {
+		w.actions.history = nil
+	}
+
+	w.registry.Unregister(id)
+	delete(w.components, id)
+	w.entities = slices.DeleteFunc(w.entities, func(e Entity) bool {
+		return e.GetID() == id
+	})
+
+	if player, ok := entity.(*Player); ok {
+		if w.triggers != nil {
+			w.triggers.UnregisterCollidable(player)
+		}
+		if w.player == player {
+			w.player = nil
+		}
+	}
+	if collidable, ok := entity.(globals.Collidable); ok && w.collision != nil {
+		w.collision.UnregisterCollidable(collidable)
+	}
+	if trigger, ok := entity.(globals.Triggerable); ok && w.triggers != nil {
+		w.triggers.UnregisterTrigger(trigger)
+	}
+
+	for _, hook := range w.onDespawn {
+		hook(entity)
+	}
+}
+
+// Get returns the entity with the given ID
+func (w *World) Get(id string) (Entity, bool) {
+	entity, exists := w.registry.Get(id)
+	if !exists {
+		return nil, false
+	}
+	return entity.(Entity), true
+}
+
+// GetComponents returns the components stored for the given entity ID
+func (w *World) GetComponents(id string) (Components, bool) {
+	components, exists := w.components[id]
+	return components, exists
+}
+
+// NextID generates a unique ID for an entity spawned at runtime
+func (w *World) NextID(prefix string) string {
+	return w.registry.NextID(prefix)
+}
+
+// Player returns the player entity, or nil if none has been spawned
+func (w *World) Player() *Player {
+	return w.player
+}
+
+// SetNavGrid sets the navigation grid AI agents path over
+func (w *World) SetNavGrid(grid *navigation.Grid) {
+	w.navGrid = grid
+}
+
+// NavGrid returns the navigation grid, or nil if none has been built
+func (w *World) NavGrid() *navigation.Grid {
+	return w.navGrid
+}
+
+// SetGround sets the terrain entities stand on, or nil for flat ground at
+// zero height. The collision system checks movement against it too.
+func (w *World) SetGround(ground globals.Ground) {
+	w.ground = ground
+	if w.collision != nil {
+		w.collision.SetGround(ground)
+	}
+}
+
+// Ground returns the terrain entities stand on, or nil for flat ground
+func (w *World) Ground() globals.Ground {
+	return w.ground
+}
+
+// GroundHeight returns the height of the ground at x, z
+func (w *World) GroundHeight(x, z float32) float32 {
+	if w.ground == nil {
+		return 0
+	}
+	return w.ground.HeightAt(x, z)
+}
+
+// Entities returns all entities in spawn order
+func (w *World) Entities() []Entity {
+	return slices.Clone(w.entities)
+}
+
+// Query returns every entity that has all components in mask, in spawn order
+func (w *World) Query(mask ComponentMask) []Entity {
+	result := make([]Entity, 0)
+	for _, entity := range w.entities {
+		if w.components[entity.GetID()].Mask()&mask == mask {
+			result = append(result, entity)
+		}
+	}
+	return result
+}
+
+// Len returns the number of entities in the world
+func (w *World) Len() int {
+	return len(w.entities)
+}
+
+// AddSystem registers a system to run at the given order slot
+func (w *World) AddSystem(name string, order int, system System) {
+	entry := systemEntry{name: name, order: order, system: system}
+
+	index := len(w.systems)
+	for i, existing := range w.systems {
+		if existing.order > order {
+			index = i
+			break
+		}
+	}
+	w.systems = slices.Insert(w.systems, index, entry)
+}
+
+// SystemNames returns the names of registered systems in execution order
+func (w *World) SystemNames() []string {
+	names := make([]string, 0, len(w.systems))
+	for _, entry := range w.systems {
+		names = append(names, entry.name)
+	}
+	return names
+}
+
+// Update runs every system once, in order
+func (w *World) Update(deltaTime float32) {
+	for _, entry := range w.systems {
+		entry.system.Update(w, deltaTime)
+	}
+}
+
+// OnSpawn registers a callback invoked after an entity is spawned
+func (w *World) OnSpawn(hook func(Entity)) {
+	w.onSpawn = append(w.onSpawn, hook)
+}
+
+// OnDespawn registers a callback invoked after an entity is despawned
+func (w *World) OnDespawn(hook func(Entity)) {
+	w.onDespawn = append(w.onDespawn, hook)
+}
+
+// Clear removes all entities without running despawn hooks. Systems and
+// hooks stay registered.
+func (w *World) Clear() {
+	w.registry.Clear()
+	w.entities = make([]Entity, 0)
+	w.components = make(map[string]Components)
+	w.player = nil
+	w.navGrid = nil
+	w.SetGround(nil)
+	w.actions.clear()
+
+	if w.collision != nil {
+		w.collision.ClearAll()
+	}
+	if w.triggers != nil {
+		w.triggers.ClearAll()
+	}
+}
+
+// OfType returns every entity in the world of concrete type T, in spawn order
+func OfType[T Entity](w *World) []T {
+	result := make([]T, 0)
+	for _, entity := range w.entities {
+		if typed, ok := entity.(T); ok {
+			result = append(result, typed)
+		}
+	}
+	return result
+}
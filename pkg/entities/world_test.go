@@ -0,0 +1,173 @@
+package entities
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/globals"
+)
+
+// testWorld returns an empty world over freshly initialised collision and
+// trigger systems
+func testWorld() *World {
+	globals.InitCollision()
+	globals.InitTriggers()
+	return NewWorld(globals.Collision, globals.Triggers)
+}
+
+func TestWorldQueryByComponents(t *testing.T) {
+	// given
+	// ... a world with a player, an enemy, a bullet and an obstacle
+	world := testWorld()
+	enemy := NewEnemy(rl.Vector3{X: 5}, 50.0, 2.0)
+	enemy.ID = "enemy_1"
+	bullet := NewBullet(rl.Vector3{}, rl.Vector3{X: 1}, 10, 1, 5)
+	bullet.ID = "bullet_1"
+	obstacle := NewBoxObstacle(rl.Vector3{X: 2}, rl.Vector3{X: 1, Y: 1, Z: 1}, rl.Brown)
+	obstacle.ID = "box_1"
+	for _, entity := range []Entity{NewPlayer(5.0, nil), enemy, bullet, obstacle} {
+		if err := world.Spawn(entity); err != nil {
+			t.Fatalf("Unexpected spawn error: %v", err)
+		}
+	}
+	// when
+	// ... querying for health, velocity and AI components
+	withHealth := world.Query(ComponentHealth)
+	moving := world.Query(ComponentTransform | ComponentVelocity)
+	thinking := world.Query(ComponentAI)
+	// then
+	// ... should return matching entities in spawn order
+	if len(withHealth) != 2 || withHealth[0].GetID() != PlayerID || withHealth[1] != Entity(enemy) {
+		t.Fatalf("Expected player and enemy with health, got %d entities", len(withHealth))
+	}
+	if len(moving) != 3 {
+		t.Fatalf("Expected 3 entities with velocity, got %d", len(moving))
+	}
+	if len(thinking) != 1 || thinking[0] != Entity(enemy) {
+		t.Fatal("Expected only the enemy to have an AI component")
+	}
+	if obstacles := OfType[*Obstacle](world); len(obstacles) != 1 || obstacles[0] != obstacle {
+		t.Fatal("Expected OfType to return the single obstacle")
+	}
+}
+
+func TestWorldSpawnRegistersWithCollisionAndTriggers(t *testing.T) {
+	// given
+	// ... a world with a player and a health pickup next to each other
+	world := testWorld()
+	player := NewPlayer(5.0, nil)
+	player.Health = 50.0
+	pickup := NewHealthPickup(rl.Vector3{X: 0, Y: 0.5, Z: 0})
+	pickup.ID = "health_pickup_1"
+	world.AddSystem("collision", OrderCollision, NewCollisionSystem())
+	if err := world.Spawn(player); err != nil {
+		t.Fatalf("Unexpected spawn error: %v", err)
+	}
+	if err := world.Spawn(pickup); err != nil {
+		t.Fatalf("Unexpected spawn error: %v", err)
+	}
+	// when
+	// ... the world is updated
+	world.Update(0.016)
+	// then
+	// ... the pickup trigger should have healed the player
+	if player.Health != 75.0 {
+		t.Fatalf("Expected player health 75.0, got %f", player.Health)
+	}
+	if pickup.IsActive() {
+		t.Fatal("Pickup should be consumed")
+	}
+}
+
+func TestWorldSystemsRunInOrder(t *testing.T) {
+	// given
+	// ... systems added out of order
+	world := testWorld()
+	var ran []string
+	record := func(name string) System {
+		return SystemFunc(func(w *World, deltaTime float32) {
+			ran = append(ran, name)
+		})
+	}
+	world.AddSystem("cleanup", OrderCleanup, record("cleanup"))
+	world.AddSystem("ai", OrderAI, record("ai"))
+	world.AddSystem("movement", OrderMovement, record("movement"))
+	world.AddSystem("ai_late", OrderAI, record("ai_late"))
+	// when
+	// ... the world is updated
+	world.Update(0.016)
+	// then
+	// ... systems should run by order slot, then by insertion
+	expected := []string{"ai", "ai_late", "movement", "cleanup"}
+	if len(ran) != len(expected) {
+		t.Fatalf("Expected %d systems to run, got %d", len(expected), len(ran))
+	}
+	for i, name := range expected {
+		if ran[i] != name {
+			t.Fatalf("Expected system %d to be %s, got %s", i, name, ran[i])
+		}
+	}
+}
+
+func TestMovementSystemMovesBullets(t *testing.T) {
+	// given
+	// ... a world with a movement system and a bullet heading along +X
+	world := testWorld()
+	world.AddSystem("movement", OrderMovement, NewMovementSystem())
+	bullet := NewBullet(rl.Vector3{}, rl.Vector3{X: 1}, 10, 1, 5)
+	bullet.ID = "bullet_1"
+	if err := world.Spawn(bullet); err != nil {
+		t.Fatalf("Unexpected spawn error: %v", err)
+	}
+	// when
+	// ... the world is updated for half a second
+	world.Update(0.5)
+	// then
+	// ... the bullet should have travelled speed * time along X
+	if bullet.Position.X != 5.0 {
+		t.Fatalf("Expected bullet at X=5.0, got %f", bullet.Position.X)
+	}
+}
+
+func TestCleanupSystemDespawnsInactiveEntities(t *testing.T) {
+	// given
+	// ... a world with a dead enemy, a live enemy and a dead player
+	// ... a despawn hook recording removed IDs
+	world := testWorld()
+	world.AddSystem("cleanup", OrderCleanup, NewCleanupSystem())
+	player := NewPlayer(5.0, nil)
+	player.Health = 0
+	dead := NewEnemy(rl.Vector3{}, 50.0, 2.0)
+	dead.ID = "enemy_dead"
+	alive := NewEnemy(rl.Vector3{X: 4}, 50.0, 2.0)
+	alive.ID = "enemy_alive"
+	for _, entity := range []Entity{player, dead, alive} {
+		if err := world.Spawn(entity); err != nil {
+			t.Fatalf("Unexpected spawn error: %v", err)
+		}
+	}
+	var despawned []string
+	world.OnDespawn(func(e Entity) {
+		despawned = append(despawned, e.GetID())
+	})
+	dead.TakeDamage(NewDamage(100.0, DamagePhysical, PlayerID))
+	// when
+	// ... the world is updated
+	world.Update(0.016)
+	// then
+	// ... only the dead enemy should be removed
+	// ... and the player should be kept for the scene to handle
+	if len(despawned) != 1 || despawned[0] != "enemy_dead" {
+		t.Fatalf("Expected only enemy_dead to despawn, got %v", despawned)
+	}
+	if _, ok := world.Get("enemy_dead"); ok {
+		t.Fatal("Dead enemy should no longer be in the world")
+	}
+	if world.Player() != player {
+		t.Fatal("Player should remain in the world")
+	}
+	if world.Len() != 2 {
+		t.Fatalf("Expected 2 entities left, got %d", world.Len())
+	}
+}
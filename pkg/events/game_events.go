@@ -12,30 +12,98 @@ const (
 	EventTypeHealthPickup  = "health_pickup"
 	EventTypeGameOver      = "game_over"
 	EventTypeVictory       = "victory"
+
+	EventTypeEnemyStateChanged = "enemy_state_changed"
+	EventTypeEnemyDamaged      = "enemy_damaged"
+	EventTypeStatusApplied     = "status_applied"
+	EventTypeStatusExpired     = "status_expired"
+	EventTypePlayerLevelUp     = "player_level_up"
+	EventTypeEquipmentChanged  = "equipment_changed"
+	EventTypeSkillCast         = "skill_cast"
+	EventTypeSkillCastFailed   = "skill_cast_failed"
+	EventTypeDodgeStarted      = "dodge_started"
+	EventTypeDodgeEnded        = "dodge_ended"
+	EventTypePickupCollected   = "pickup_collected"
+	EventTypePickupExpired     = "pickup_expired"
+	EventTypeObstacleDestroyed = "obstacle_destroyed"
+	EventTypeExplosion         = "explosion"
+	EventTypePlaySound         = "play_sound"
+	EventTypeWaveStarted       = "wave_started"
+	EventTypeWaveCompleted     = "wave_completed"
+	EventTypeBossPhaseChanged  = "boss_phase_changed"
+	EventTypeBossDefeated      = "boss_defeated"
 )
 
 // BulletSpawnEvent represents data for bullet spawning
 type BulletSpawnEvent struct {
-	Position  rl.Vector3
-	Direction rl.Vector3
-	Speed     float32
-	Lifetime  float32
-	Damage    float32
+	Position      rl.Vector3
+	Direction     rl.Vector3
+	Speed         float32
+	Lifetime      float32
+	Damage        float32
+	OwnerID       string // ID of the entity that fired the bullet
+	Faction       string // "player" or "enemy"
+	DamageType    string // Defaults to physical when empty
+	Critical      bool
+	StatusEffects []StatusEffectSpec  // Applied to whatever the bullet hits
+	Modifiers     ProjectileModifiers // Pierce, bounce, chain, homing and explosion
+}
+
+// ProjectileModifiers are behaviours layered on a projectile. They combine
+// freely: a projectile chains to a new target while it has chains left, then
+// pierces while it has pierce left, bounces off obstacles, steers toward
+// targets and explodes once it is spent or expires. Weapons, skills, spawn
+// actions and bullet spawn events all carry them in this one form.
+type ProjectileModifiers struct {
+	Pierce          int     `json:"pierce,omitempty"`  // Targets passed through after a hit
+	Bounces         int     `json:"bounces,omitempty"` // Reflections off obstacles
+	Chains          int     `json:"chains,omitempty"`  // Redirects to the nearest target not yet hit
+	ChainRange      float32 `json:"chain_range,omitempty"`
+	HomingTurnRate  float32 `json:"homing_turn_rate,omitempty"` // Radians per second turned toward the nearest target; 0 disables
+	HomingRange     float32 `json:"homing_range,omitempty"`
+	ExplosionRadius float32 `json:"explosion_radius,omitempty"` // Area damaged when the projectile ends; 0 disables
+	ExplosionDamage float32 `json:"explosion_damage,omitempty"` // Fraction of the projectile's damage; 0 deals full damage
+}
+
+// StatusEffectSpec describes a status effect carried by an event
+type StatusEffectSpec struct {
+	Type      string // "burn", "poison", "slow" or "stun"
+	Duration  float32
+	Magnitude float32
 }
 
 // EnemyKilledEvent represents data when an enemy is defeated
 type EnemyKilledEvent struct {
 	EnemyId  string
 	Position rl.Vector3
-	Killer   string // "player" or "environment"
+	Killer   string // ID of the entity that landed the killing blow, or "environment"
+}
+
+// DamageBreakdown describes how a hit was reduced by the target's defences
+type DamageBreakdown struct {
+	RawDamage  float32 // Damage before armor and resistances
+	Mitigated  float32 // Damage removed by armor and resistances
+	DamageType string  // "physical", "fire", "cold" or "poison"
+	Critical   bool
 }
 
 // PlayerDamagedEvent represents data when player takes damage
 type PlayerDamagedEvent struct {
-	Damage     float32
-	Source     string
+	Damage     float32 // Health actually lost
+	Source     string  // ID of the entity that dealt the damage
 	NewHealth  float32
 	Position   rl.Vector3
+	Breakdown  DamageBreakdown
+}
+
+// EnemyDamagedEvent represents data when an enemy takes damage
+type EnemyDamagedEvent struct {
+	EnemyId   string
+	Damage    float32 // Health actually lost
+	Source    string  // ID of the entity that dealt the damage
+	NewHealth float32
+	Position  rl.Vector3
+	Breakdown DamageBreakdown
 }
 
 // HealthPickupEvent represents data when player picks up health
@@ -60,8 +128,129 @@ type VictoryEvent struct {
 	Score         int
 }
 
+// EnemyStateChangedEvent represents an enemy AI changing state
+type EnemyStateChangedEvent struct {
+	EnemyId   string
+	FromState string
+	ToState   string
+	Position  rl.Vector3
+}
+
+// PlayerLevelUpEvent represents the player reaching a new level
+type PlayerLevelUpEvent struct {
+	NewLevel        int
+	AttributePoints int // Unspent points after the level up
+	MaxHealth       float32
+}
+
+// EquipmentChangedEvent represents an item being equipped or unequipped.
+// Either UID is empty when the slot was or became empty.
+type EquipmentChangedEvent struct {
+	EntityID      string
+	Slot          string
+	EquippedUID   string
+	UnequippedUID string
+}
+
+// SkillCastEvent represents a skill taking effect. Origin is where the
+// caster stood and Target is the resolved target point.
+type SkillCastEvent struct {
+	CasterID string
+	SkillID  string
+	Origin   rl.Vector3
+	Target   rl.Vector3
+	Radius   float32 // Area of effect, 0 for skills without one
+}
+
+// SkillCastFailedEvent represents a cast that failed for a reason other
+// than the cooldown, mana or target that gameplay expects
+type SkillCastFailedEvent struct {
+	CasterID string
+	Slot     int
+	Reason   string
+}
+
+// DodgeEvent represents an entity starting or finishing a dodge roll.
+// Position is where the dodge started or ended.
+type DodgeEvent struct {
+	EntityID  string
+	Position  rl.Vector3
+	Direction rl.Vector3
+	Duration  float32 // Seconds the dodge lasts
+}
+
+// ExplosionEvent represents a projectile exploding
+type ExplosionEvent struct {
+	SourceID string // ID of the entity that fired the projectile
+	Position rl.Vector3
+	Radius   float32
+}
+
+// WaveEvent represents a wave starting or being completed. Enemies is the
+// size of a starting wave; NextWaveIn is the break after a completed one.
+type WaveEvent struct {
+	Wave       int
+	Enemies    int
+	NextWaveIn float32
+}
+
+// BossPhaseEvent represents a boss entering a new phase
+type BossPhaseEvent struct {
+	BossID    string
+	Name      string
+	Phase     int // Index of the new phase
+	PhaseName string
+	Position  rl.Vector3
+}
+
+// BossDefeatedEvent represents a boss being killed
+type BossDefeatedEvent struct {
+	BossID   string
+	Name     string
+	Killer   string
+	Position rl.Vector3
+}
+
+// PlaySoundEvent represents a request to play a sound at a position
+type PlaySoundEvent struct {
+	Sound    string
+	Position rl.Vector3
+	Volume   float32
+}
+
+// ObstacleDestroyedEvent represents a destructible obstacle being broken
+type ObstacleDestroyedEvent struct {
+	ObstacleID string
+	Position   rl.Vector3
+	Destroyer  string // ID of the entity that landed the final hit, or "environment"
+}
+
+// PickupEvent represents a pickup being collected or despawning uncollected.
+// Amount is what the pickup gave, or would have given.
+type PickupEvent struct {
+	PickupID   string
+	PickupType string // "health", "mana", "ammo", "gold", "speed_buff", "damage_buff", "xp" or "item"
+	Amount     float32
+	Position   rl.Vector3
+}
+
+// StatusAppliedEvent represents a status effect being applied or re-applied
+type StatusAppliedEvent struct {
+	EntityId   string
+	EffectType string
+	Stacks     int
+	Remaining  float32 // Seconds left on the effect
+	SourceId   string
+}
+
+// StatusExpiredEvent represents a status effect running out
+type StatusExpiredEvent struct {
+	EntityId   string
+	EffectType string
+}
+
 // NewBulletSpawnEvent creates a new bullet spawn event
-func NewBulletSpawnEvent(pos, dir rl.Vector3, speed, lifetime, damage float32) Event {
+func NewBulletSpawnEvent(pos, dir rl.Vector3, speed, lifetime, damage float32, ownerID, faction string) Event {
 	return Event{
 		Type: EventTypeBulletSpawn,
 		Data: BulletSpawnEvent{
@@ -70,10 +259,21 @@ func NewBulletSpawnEvent(pos, dir rl.Vector3, speed, lifetime, damage float32) E
 			Speed:     speed,
 			Lifetime:  lifetime,
 			Damage:    damage,
+			OwnerID:   ownerID,
+			Faction:   faction,
 		},
 	}
 }
 
+// NewBulletSpawnEventFromData creates a bullet spawn event from fully
+// populated spawn data
+func NewBulletSpawnEventFromData(data BulletSpawnEvent) Event {
+	return Event{
+		Type: EventTypeBulletSpawn,
+		Data: data,
+	}
+}
+
 // NewEnemyKilledEvent creates a new enemy killed event
 func NewEnemyKilledEvent(enemyId string, pos rl.Vector3, killer string) Event {
 	return Event{
@@ -87,7 +287,13 @@ func NewEnemyKilledEvent(enemyId string, pos rl.Vector3, killer string) Event {
 }
 
 // NewPlayerDamagedEvent creates a new player damaged event
-func NewPlayerDamagedEvent(damage float32, source string, newHealth float32, pos rl.Vector3) Event {
+func NewPlayerDamagedEvent(
+	damage float32,
+	source string,
+	newHealth float32,
+	pos rl.Vector3,
+	breakdown DamageBreakdown,
+) Event {
 	return Event{
 		Type: EventTypePlayerDamaged,
 		Data: PlayerDamagedEvent{
@@ -95,6 +301,29 @@ func NewPlayerDamagedEvent(damage float32, source string, newHealth float32, pos
 			Source:    source,
 			NewHealth: newHealth,
 			Position:  pos,
+			Breakdown: breakdown,
+		},
+	}
+}
+
+// NewEnemyDamagedEvent creates a new enemy damaged event
+func NewEnemyDamagedEvent(
+	enemyId string,
+	damage float32,
+	source string,
+	newHealth float32,
+	pos rl.Vector3,
+	breakdown DamageBreakdown,
+) Event {
+	return Event{
+		Type: EventTypeEnemyDamaged,
+		Data: EnemyDamagedEvent{
+			EnemyId:   enemyId,
+			Damage:    damage,
+			Source:    source,
+			NewHealth: newHealth,
+			Position:  pos,
+			Breakdown: breakdown,
 		},
 	}
 }
@@ -134,4 +363,229 @@ func NewVictoryEvent(enemiesKilled int, timeElapsed float32, score int) Event {
 			Score:         score,
 		},
 	}
-}
\ No newline at end of file
+}
+
+// NewEnemyStateChangedEvent creates a new enemy state changed event
+func NewEnemyStateChangedEvent(enemyId, fromState, toState string, pos rl.Vector3) Event {
+	return Event{
+		Type: EventTypeEnemyStateChanged,
+		Data: EnemyStateChangedEvent{
+			EnemyId:   enemyId,
+			FromState: fromState,
+			ToState:   toState,
+			Position:  pos,
+		},
+	}
+}
+
+// NewStatusAppliedEvent creates a new status applied event
+func NewStatusAppliedEvent(entityId, effectType string, stacks int, remaining float32, sourceId string) Event {
+	return Event{
+		Type: EventTypeStatusApplied,
+		Data: StatusAppliedEvent{
+			EntityId:   entityId,
+			EffectType: effectType,
+			Stacks:     stacks,
+			Remaining:  remaining,
+			SourceId:   sourceId,
+		},
+	}
+}
+
+// NewStatusExpiredEvent creates a new status expired event
+func NewStatusExpiredEvent(entityId, effectType string) Event {
+	return Event{
+		Type: EventTypeStatusExpired,
+		Data: StatusExpiredEvent{
+			EntityId:   entityId,
+			EffectType: effectType,
+		},
+	}
+}
+
+// NewPlayerLevelUpEvent creates a new player level up event
+func NewPlayerLevelUpEvent(newLevel, attributePoints int, maxHealth float32) Event {
+	return Event{
+		Type: EventTypePlayerLevelUp,
+		Data: PlayerLevelUpEvent{
+			NewLevel:        newLevel,
+			AttributePoints: attributePoints,
+			MaxHealth:       maxHealth,
+		},
+	}
+}
+
+// NewEquipmentChangedEvent creates a new equipment changed event
+func NewEquipmentChangedEvent(entityID, slot, equippedUID, unequippedUID string) Event {
+	return Event{
+		Type: EventTypeEquipmentChanged,
+		Data: EquipmentChangedEvent{
+			EntityID:      entityID,
+			Slot:          slot,
+			EquippedUID:   equippedUID,
+			UnequippedUID: unequippedUID,
+		},
+	}
+}
+
+// NewSkillCastEvent creates a new skill cast event
+func NewSkillCastEvent(casterID, skillID string, origin, target rl.Vector3, radius float32) Event {
+	return Event{
+		Type: EventTypeSkillCast,
+		Data: SkillCastEvent{
+			CasterID: casterID,
+			SkillID:  skillID,
+			Origin:   origin,
+			Target:   target,
+			Radius:   radius,
+		},
+	}
+}
+
+// NewSkillCastFailedEvent creates a new skill cast failed event
+func NewSkillCastFailedEvent(casterID string, slot int, reason string) Event {
+	return Event{
+		Type: EventTypeSkillCastFailed,
+		Data: SkillCastFailedEvent{
+			CasterID: casterID,
+			Slot:     slot,
+			Reason:   reason,
+		},
+	}
+}
+
+// NewDodgeStartedEvent creates a new dodge started event
+func NewDodgeStartedEvent(entityID string, position, direction rl.Vector3, duration float32) Event {
+	return Event{
+		Type: EventTypeDodgeStarted,
+		Data: DodgeEvent{
+			EntityID:  entityID,
+			Position:  position,
+			Direction: direction,
+			Duration:  duration,
+		},
+	}
+}
+
+// NewDodgeEndedEvent creates a new dodge ended event
+func NewDodgeEndedEvent(entityID string, position, direction rl.Vector3) Event {
+	return Event{
+		Type: EventTypeDodgeEnded,
+		Data: DodgeEvent{
+			EntityID:  entityID,
+			Position:  position,
+			Direction: direction,
+		},
+	}
+}
+
+// NewPickupCollectedEvent creates a new pickup collected event
+func NewPickupCollectedEvent(pickupID, pickupType string, amount float32, position rl.Vector3) Event {
+	return Event{
+		Type: EventTypePickupCollected,
+		Data: PickupEvent{
+			PickupID:   pickupID,
+			PickupType: pickupType,
+			Amount:     amount,
+			Position:   position,
+		},
+	}
+}
+
+// NewPickupExpiredEvent creates a new pickup expired event
+func NewPickupExpiredEvent(pickupID, pickupType string, amount float32, position rl.Vector3) Event {
+	return Event{
+		Type: EventTypePickupExpired,
+		Data: PickupEvent{
+			PickupID:   pickupID,
+			PickupType: pickupType,
+			Amount:     amount,
+			Position:   position,
+		},
+	}
+}
+
+// NewObstacleDestroyedEvent creates a new obstacle destroyed event
+func NewObstacleDestroyedEvent(obstacleID string, position rl.Vector3, destroyer string) Event {
+	return Event{
+		Type: EventTypeObstacleDestroyed,
+		Data: ObstacleDestroyedEvent{
+			ObstacleID: obstacleID,
+			Position:   position,
+			Destroyer:  destroyer,
+		},
+	}
+}
+
+// NewExplosionEvent creates a new explosion event
+func NewExplosionEvent(sourceID string, position rl.Vector3, radius float32) Event {
+	return Event{
+		Type: EventTypeExplosion,
+		Data: ExplosionEvent{
+			SourceID: sourceID,
+			Position: position,
+			Radius:   radius,
+		},
+	}
+}
+
+// NewPlaySoundEvent creates a new play sound event
+func NewPlaySoundEvent(sound string, position rl.Vector3, volume float32) Event {
+	return Event{
+		Type: EventTypePlaySound,
+		Data: PlaySoundEvent{
+			Sound:    sound,
+			Position: position,
+			Volume:   volume,
+		},
+	}
+}
+
+// NewWaveStartedEvent creates a new wave started event
+func NewWaveStartedEvent(wave, enemies int) Event {
+	return Event{
+		Type: EventTypeWaveStarted,
+		Data: WaveEvent{
+			Wave:    wave,
+			Enemies: enemies,
+		},
+	}
+}
+
+// NewWaveCompletedEvent creates a new wave completed event
+func NewWaveCompletedEvent(wave int, nextWaveIn float32) Event {
+	return Event{
+		Type: EventTypeWaveCompleted,
+		Data: WaveEvent{
+			Wave:       wave,
+			NextWaveIn: nextWaveIn,
+		},
+	}
+}
+
+// NewBossPhaseChangedEvent creates a new boss phase changed event
+func NewBossPhaseChangedEvent(bossID, name string, phase int, phaseName string, position rl.Vector3) Event {
+	return Event{
+		Type: EventTypeBossPhaseChanged,
+		Data: BossPhaseEvent{
+			BossID:    bossID,
+			Name:      name,
+			Phase:     phase,
+			PhaseName: phaseName,
+			Position:  position,
+		},
+	}
+}
+
+// NewBossDefeatedEvent creates a new boss defeated event
+func NewBossDefeatedEvent(bossID, name, killer string, position rl.Vector3) Event {
+	return Event{
+		Type: EventTypeBossDefeated,
+		Data: BossDefeatedEvent{
+			BossID:   bossID,
+			Name:     name,
+			Killer:   killer,
+			Position: position,
+		},
+	}
+}
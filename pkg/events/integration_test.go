@@ -29,6 +29,8 @@ func TestObserverPatternIntegration(t *testing.T) {
 		10.0,
 		5.0,
 		25.0,
+		"player",
+		"player",
 	)
 	err = eventBus.Notify(bulletEvent)
 	if err != nil {
@@ -76,6 +78,7 @@ func TestMultipleObserversIntegration(t *testing.T) {
 		"enemy",
 		75.0,
 		rl.Vector3{X: 0, Y: 0, Z: 0},
+		DamageBreakdown{RawDamage: 25.0, DamageType: "physical"},
 	)
 	err := eventBus.Notify(damageEvent)
 	if err != nil {
@@ -346,7 +346,7 @@ func TestGameEventCreation(t *testing.T) {
 	damage := float32(25.0)
 	// when
 	// ... a bullet spawn event is created
-	event := NewBulletSpawnEvent(position, direction, speed, lifetime, damage)
+	event := NewBulletSpawnEvent(position, direction, speed, lifetime, damage, "player", "player")
 	// then
 	// ... should have correct event type
 	// ... and should have correct data
@@ -372,5 +372,8 @@ func TestGameEventCreation(t *testing.T) {
 	if bulletData.Damage != damage {
 		t.Fatal("Damage should match")
 	}
+	if bulletData.OwnerID != "player" || bulletData.Faction != "player" {
+		t.Fatal("Owner and faction should match")
+	}
 }
 
@@ -8,6 +8,7 @@ import (
 
 type CollisionSystem struct {
 	collidables []Collidable
+	ground      Ground
 }
 
 var Collision *CollisionSystem
@@ -25,6 +26,19 @@ type Collidable interface {
 	IsActive() bool
 }
 
+// Ground is the terrain under the world. Movement checks consult it so
+// walkers cannot climb slopes that are too steep.
+type Ground interface {
+	HeightAt(x, z float32) float32
+	CanClimb(from, to rl.Vector3) bool
+}
+
+// SetGround sets the terrain movement is checked against, or nil for flat
+// ground everywhere
+func (cs *CollisionSystem) SetGround(ground Ground) {
+	cs.ground = ground
+}
+
 func (cs *CollisionSystem) RegisterCollidable(obj Collidable) {
 	cs.collidables = append(cs.collidables, obj)
 }
@@ -80,11 +94,10 @@ func (cs *CollisionSystem) shouldCollide(objA, objB Collidable) bool {
 	tagsB := objB.GetCollisionTags()
 
 	collisionRules := map[string][]string{
-		"player":        {"obstacle", "enemy", "health_pickup"},
-		"bullet":        {"obstacle", "enemy"},
-		"enemy":         {"player", "bullet", "obstacle"},
-		"obstacle":      {"player", "bullet", "enemy", "obstacle"},
-		"health_pickup": {"player"},
+		"player":   {"obstacle", "enemy", "bullet"},
+		"bullet":   {"obstacle", "enemy", "player"},
+		"enemy":    {"player", "bullet", "obstacle"},
+		"obstacle": {"player", "bullet", "enemy", "obstacle"},
 	}
 
 	for _, tagA := range tagsA {
@@ -135,8 +148,39 @@ func (cs *CollisionSystem) isSpherelike(box rl.BoundingBox) bool {
 	return width < 1.0 && height < 1.0 && depth < 1.0
 }
 
+// QuerySphere returns the active collidables whose bounds touch the sphere
+// and carry any of tags. With no tags every collidable matches.
+func (cs *CollisionSystem) QuerySphere(center rl.Vector3, radius float32, tags ...string) []Collidable {
+	var result []Collidable
+	for _, collidable := range cs.collidables {
+		if !collidable.IsActive() {
+			continue
+		}
+		if len(tags) > 0 && !slices.ContainsFunc(collidable.GetCollisionTags(), func(tag string) bool {
+			return slices.Contains(tags, tag)
+		}) {
+			continue
+		}
+		if rl.CheckCollisionBoxSphere(collidable.GetBoundingBox(), center, radius) {
+			result = append(result, collidable)
+		}
+	}
+	return result
+}
+
 func (cs *CollisionSystem) CheckMovement(obj Collidable, newPos rl.Vector3) bool {
 	originalBox := obj.GetBoundingBox()
+
+	if cs.ground != nil && WalksOnGround(obj) {
+		from := rl.Vector3{
+			X: (originalBox.Min.X + originalBox.Max.X) / 2,
+			Z: (originalBox.Min.Z + originalBox.Max.Z) / 2,
+		}
+		if !cs.ground.CanClimb(from, newPos) {
+			return false // Too steep to walk up
+		}
+	}
+
 	offset := rl.Vector3{
 		X: newPos.X - (originalBox.Min.X+originalBox.Max.X)/2,
 		Y: newPos.Y - (originalBox.Min.Y+originalBox.Max.Y)/2,
@@ -165,6 +209,9 @@ func (cs *CollisionSystem) CheckMovement(obj Collidable, newPos rl.Vector3) bool
 			otherBox := other.GetBoundingBox()
 			if rl.CheckCollisionBoxes(tempBox, otherBox) {
 				otherTags := other.GetCollisionTags()
+				if slices.Contains(otherTags, "bullet") {
+					continue // Projectiles never block movement
+				}
 				isEnemy := slices.Contains(otherTags, "enemy")
 
 				if isEnemy && rl.CheckCollisionBoxes(originalBox, otherBox) {
@@ -181,6 +228,13 @@ func (cs *CollisionSystem) CheckMovement(obj Collidable, newPos rl.Vector3) bool
 	return true
 }
 
+// WalksOnGround reports whether obj moves on foot, so steep ground blocks it.
+// Players and enemies, bosses included, walk; projectiles and obstacles do not.
+func WalksOnGround(obj Collidable) bool {
+	tags := obj.GetCollisionTags()
+	return slices.Contains(tags, "player") || slices.Contains(tags, "enemy")
+}
+
 func (cs *CollisionSystem) isMovementIncreasingPenetration(
 	originalBox, newBox, targetBox rl.BoundingBox,
 ) bool {
@@ -206,26 +206,26 @@ func TestCollisionRules(t *testing.T) {
 		t.Fatal("Enemy should collide with player (bidirectional)")
 	}
 	// given
-	// ... a health pickup collidable
-	healthPickup := &MockCollidable{
-		CollisionTags: []string{"health_pickup"},
+	// ... a pickup collidable
+	pickup := &MockCollidable{
+		CollisionTags: []string{"pickup"},
 		ActiveState:   true,
 	}
 	// when
-	// ... checking if player should collide with health pickup
-	playerHealthCollision := Collision.shouldCollide(player, healthPickup)
+	// ... checking if player should collide with pickup
+	playerPickupCollision := Collision.shouldCollide(player, pickup)
 	// then
-	// ... should allow collision
-	if !playerHealthCollision {
-		t.Fatal("Player should collide with health pickup")
+	// ... should not allow collision, pickups are collected by triggers
+	if playerPickupCollision {
+		t.Fatal("Player should NOT collide with pickup")
 	}
 	// when
-	// ... checking if enemy should collide with health pickup
-	enemyHealthCollision := Collision.shouldCollide(enemy, healthPickup)
+	// ... checking if enemy should collide with pickup
+	enemyPickupCollision := Collision.shouldCollide(enemy, pickup)
 	// then
 	// ... should not allow collision
-	if enemyHealthCollision {
-		t.Fatal("Enemy should NOT collide with health pickup")
+	if enemyPickupCollision {
+		t.Fatal("Enemy should NOT collide with pickup")
 	}
 	// when
 	// ... checking if object collides with itself
@@ -436,6 +436,67 @@ func TestCheckMovementAllowed(t *testing.T) {
 	}
 }
 
+// Mock ground that only allows climbing up to a fixed rise per step
+type MockGround struct {
+	MaxRise float32
+	Height  func(x, z float32) float32
+}
+
+func (m *MockGround) HeightAt(x, z float32) float32 {
+	return m.Height(x, z)
+}
+
+func (m *MockGround) CanClimb(from, to rl.Vector3) bool {
+	return m.HeightAt(to.X, to.Z)-m.HeightAt(from.X, from.Z) <= m.MaxRise
+}
+
+func TestCheckMovementBlockedBySteepGround(t *testing.T) {
+	// given
+	// ... an initialized collision system on ground with a cliff at x 1
+	// ... a player and a bullet next to the cliff
+	InitCollision()
+	Collision.SetGround(&MockGround{
+		MaxRise: 0.5,
+		Height: func(x, z float32) float32 {
+			if x > 1 {
+				return 3
+			}
+			return 0
+		},
+	})
+	box := rl.BoundingBox{
+		Min: rl.Vector3{X: 0, Y: 0, Z: 0},
+		Max: rl.Vector3{X: 1, Y: 1, Z: 1},
+	}
+	player := &MockCollidable{BoundingBox: box, CollisionTags: []string{"player"}, ActiveState: true}
+	bullet := &MockCollidable{
+		BoundingBox: rl.BoundingBox{
+			Min: rl.Vector3{X: 0, Y: 0, Z: 5},
+			Max: rl.Vector3{X: 0.5, Y: 0.5, Z: 5.5},
+		},
+		CollisionTags: []string{"bullet"},
+		ActiveState:   true,
+	}
+	Collision.RegisterCollidable(player)
+	Collision.RegisterCollidable(bullet)
+	// when
+	// ... both check a move up the cliff and one along its foot
+	playerUp := Collision.CheckMovement(player, rl.Vector3{X: 1.5, Y: 0.5, Z: 0.5})
+	playerAlong := Collision.CheckMovement(player, rl.Vector3{X: 0.5, Y: 0.5, Z: 1.5})
+	bulletUp := Collision.CheckMovement(bullet, rl.Vector3{X: 1.5, Y: 0.25, Z: 5.25})
+	// then
+	// ... should block only the player climbing the cliff
+	if playerUp {
+		t.Fatal("Expected the cliff to block the player")
+	}
+	if !playerAlong {
+		t.Fatal("Expected the player to walk along the foot of the cliff")
+	}
+	if !bulletUp {
+		t.Fatal("Expected the cliff not to block a bullet")
+	}
+}
+
 func TestSphereLikeCollision(t *testing.T) {
 	// given
 	// ... an initialized collision system
@@ -683,3 +744,28 @@ func TestObstacleBlocksAllMovementWhenTouching(t *testing.T) {
 		t.Fatal("Player should be able to move away from obstacle")
 	}
 }
+
+func TestQuerySphereFiltersByTagAndDistance(t *testing.T) {
+	// given
+	// ... an enemy inside the sphere, an enemy outside it,
+	// ... an obstacle inside it and an inactive enemy inside it
+	InitCollision()
+	box := func(x float32) rl.BoundingBox {
+		return rl.BoundingBox{Min: rl.Vector3{X: x - 0.5, Y: 0, Z: -0.5}, Max: rl.Vector3{X: x + 0.5, Y: 1, Z: 0.5}}
+	}
+	near := &MockCollidable{BoundingBox: box(2), CollisionTags: []string{"enemy"}, ActiveState: true}
+	far := &MockCollidable{BoundingBox: box(10), CollisionTags: []string{"enemy"}, ActiveState: true}
+	wall := &MockCollidable{BoundingBox: box(1), CollisionTags: []string{"obstacle"}, ActiveState: true}
+	dead := &MockCollidable{BoundingBox: box(1), CollisionTags: []string{"enemy"}, ActiveState: false}
+	for _, collidable := range []*MockCollidable{near, far, wall, dead} {
+		Collision.RegisterCollidable(collidable)
+	}
+	// when
+	// ... enemies are queried within 3 units of the origin
+	found := Collision.QuerySphere(rl.Vector3{}, 3, "enemy")
+	// then
+	// ... should find only the near, active enemy
+	if len(found) != 1 || found[0] != near {
+		t.Fatalf("Expected only the near enemy, got %d collidables", len(found))
+	}
+}
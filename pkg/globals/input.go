@@ -23,15 +23,27 @@ type Input interface {
 	IsDownDown() bool
 	IsLeftDown() bool
 	IsRightDown() bool
+	GetMoveAxis() rl.Vector2 // Analog stick, X right and Y down, zero inside the dead zone
 
 	// Mouse inputs
 	IsMouseLeftPressed() bool
 	IsMouseLeftDown() bool
+	IsMouseRightPressed() bool
 	GetMousePosition() rl.Vector2
+
+	// Character inputs
+	IsCharacterPressed() bool
+	IsNumberPressed(n int) bool // Top-row number keys 1-9
+	IsInventoryPressed() bool
+	IsSkillKeyPressed(n int) bool // Skill bar keys Q, E and F for 1-3
+	IsDodgePressed() bool
 }
 
 var InputSystem Input
 
+// gamepadDeadZone is the stick deflection below which input is ignored
+const gamepadDeadZone = 0.2
+
 type DefaultInput struct{}
 
 func InitInput() {
@@ -101,6 +113,22 @@ func (di *DefaultInput) IsRightDown() bool {
 	return rl.IsKeyDown(rl.KeyD) || rl.IsKeyDown(rl.KeyRight)
 }
 
+// GetMoveAxis returns the first gamepad's left stick, or zero when no
+// gamepad is connected or the stick rests inside the dead zone
+func (di *DefaultInput) GetMoveAxis() rl.Vector2 {
+	if !rl.IsGamepadAvailable(0) {
+		return rl.Vector2{}
+	}
+	axis := rl.Vector2{
+		X: rl.GetGamepadAxisMovement(0, rl.GamepadAxisLeftX),
+		Y: rl.GetGamepadAxisMovement(0, rl.GamepadAxisLeftY),
+	}
+	if rl.Vector2Length(axis) < gamepadDeadZone {
+		return rl.Vector2{}
+	}
+	return axis
+}
+
 // Mouse inputs
 func (di *DefaultInput) IsMouseLeftPressed() bool {
 	return rl.IsMouseButtonPressed(rl.MouseLeftButton)
@@ -110,6 +138,41 @@ func (di *DefaultInput) IsMouseLeftDown() bool {
 	return rl.IsMouseButtonDown(rl.MouseLeftButton)
 }
 
+func (di *DefaultInput) IsMouseRightPressed() bool {
+	return rl.IsMouseButtonPressed(rl.MouseRightButton)
+}
+
 func (di *DefaultInput) GetMousePosition() rl.Vector2 {
 	return rl.GetMousePosition()
 }
+
+// Character inputs
+func (di *DefaultInput) IsCharacterPressed() bool {
+	return rl.IsKeyPressed(rl.KeyC)
+}
+
+func (di *DefaultInput) IsDodgePressed() bool {
+	return rl.IsKeyPressed(rl.KeyLeftShift)
+}
+
+func (di *DefaultInput) IsInventoryPressed() bool {
+	return rl.IsKeyPressed(rl.KeyI)
+}
+
+func (di *DefaultInput) IsNumberPressed(n int) bool {
+	if n < 1 || n > 9 {
+		return false
+	}
+	return rl.IsKeyPressed(int32(rl.KeyOne) + int32(n-1))
+}
+
+// skillKeys are the skill bar keys after the right mouse button. W and R
+// are taken by movement and restart.
+var skillKeys = []int32{rl.KeyQ, rl.KeyE, rl.KeyF}
+
+func (di *DefaultInput) IsSkillKeyPressed(n int) bool {
+	if n < 1 || n > len(skillKeys) {
+		return false
+	}
+	return rl.IsKeyPressed(skillKeys[n-1])
+}
@@ -178,10 +178,17 @@ type MockInput struct {
 	DownDown          bool
 	LeftDown          bool
 	RightDown         bool
+	MoveAxis          rl.Vector2
 	MouseLeftPressed  bool
 	MouseLeftDown     bool
+	MouseRightPressed bool
 	MouseX            float32
 	MouseY            float32
+	CharacterPressed  bool
+	NumberPressed     int // Number key pressed this frame, 0 for none
+	InventoryPressed  bool
+	SkillKeyPressed   int // Skill key pressed this frame, 0 for none
+	DodgePressed      bool
 }
 
 func (m *MockInput) IsSpacePressed() bool { return m.SpacePressed }
@@ -214,14 +221,28 @@ func (m *MockInput) IsLeftDown() bool { return m.LeftDown }
 
 func (m *MockInput) IsRightDown() bool { return m.RightDown }
 
+func (m *MockInput) GetMoveAxis() rl.Vector2 { return m.MoveAxis }
+
 func (m *MockInput) IsMouseLeftPressed() bool { return m.MouseLeftPressed }
 
 func (m *MockInput) IsMouseLeftDown() bool { return m.MouseLeftDown }
 
+func (m *MockInput) IsMouseRightPressed() bool { return m.MouseRightPressed }
+
 func (m *MockInput) GetMousePosition() rl.Vector2 {
 	return rl.Vector2{X: m.MouseX, Y: m.MouseY}
 }
 
+func (m *MockInput) IsCharacterPressed() bool { return m.CharacterPressed }
+
+func (m *MockInput) IsNumberPressed(n int) bool { return m.NumberPressed == n }
+
+func (m *MockInput) IsInventoryPressed() bool { return m.InventoryPressed }
+
+func (m *MockInput) IsSkillKeyPressed(n int) bool { return m.SkillKeyPressed == n }
+
+func (m *MockInput) IsDodgePressed() bool { return m.DodgePressed }
+
 func TestInputSystemMockability(t *testing.T) {
 	// given
 	// ... the original input system
@@ -55,7 +55,7 @@ func TestCollisionTriggerIntegration(t *testing.T) {
 			Min: rl.Vector3{X: 0, Y: 0, Z: 0},
 			Max: rl.Vector3{X: 1, Y: 1, Z: 1},
 		},
-		TriggerTags: []string{"health_pickup"},
+		TriggerTags: []string{"pickup"},
 		ActiveState: true,
 	}
 	player := &MockCollidable{
@@ -126,7 +126,7 @@ func TestSystemIndependence(t *testing.T) {
 			Min: rl.Vector3{X: 0, Y: 0, Z: 0},
 			Max: rl.Vector3{X: 1, Y: 1, Z: 1},
 		},
-		TriggerTags: []string{"health_pickup"},
+		TriggerTags: []string{"pickup"},
 		ActiveState: true,
 	}
 	Collision.RegisterCollidable(collidable)
@@ -210,7 +210,7 @@ func TestRealisticGameScenario(t *testing.T) {
 			Min: rl.Vector3{X: 1, Y: 0, Z: 0},
 			Max: rl.Vector3{X: 2, Y: 1, Z: 1},
 		},
-		TriggerTags: []string{"health_pickup"},
+		TriggerTags: []string{"pickup"},
 		ActiveState: true,
 	}
 	Collision.RegisterCollidable(player)
@@ -316,7 +316,7 @@ func TestDualSystemObject(t *testing.T) {
 			Max: rl.Vector3{X: 1, Y: 1, Z: 1},
 		},
 		CollisionTags: []string{"obstacle"},
-		TriggerTags:   []string{"health_pickup"},
+		TriggerTags:   []string{"pickup"},
 		ActiveState:   true,
 	}
 	player := &MockCollidable{
@@ -85,7 +85,9 @@ func (ts *TriggerSystem) shouldTrigger(trigger Triggerable, collidable Collidabl
 	collidableTags := collidable.GetCollisionTags()
 
 	triggerRules := map[string][]string{
-		"health_pickup": {"player"},
+		"hazard":    {"player"},
+		"item_drop": {"player"},
+		"pickup":    {"player"},
 	}
 
 	for _, triggerTag := range triggerTags {
@@ -65,7 +65,7 @@ func TestTriggerSystemRegistration(t *testing.T) {
 			Min: rl.Vector3{X: 0, Y: 0, Z: 0},
 			Max: rl.Vector3{X: 1, Y: 1, Z: 1},
 		},
-		TriggerTags: []string{"health_pickup"},
+		TriggerTags: []string{"pickup"},
 		ActiveState: true,
 	}
 	// when
@@ -92,7 +92,7 @@ func TestTriggerSystemUnregistration(t *testing.T) {
 			Min: rl.Vector3{X: 0, Y: 0, Z: 0},
 			Max: rl.Vector3{X: 1, Y: 1, Z: 1},
 		},
-		TriggerTags: []string{"health_pickup"},
+		TriggerTags: []string{"pickup"},
 		ActiveState: true,
 	}
 	trigger2 := &MockTriggerable{
@@ -124,7 +124,7 @@ func TestTriggerSystemClearAll(t *testing.T) {
 	// ... an initialized trigger system
 	// ... two triggers registered in the system
 	InitTriggers()
-	trigger1 := &MockTriggerable{TriggerTags: []string{"health_pickup"}, ActiveState: true}
+	trigger1 := &MockTriggerable{TriggerTags: []string{"pickup"}, ActiveState: true}
 	trigger2 := &MockTriggerable{TriggerTags: []string{"damage_zone"}, ActiveState: true}
 	Triggers.RegisterTrigger(trigger1)
 	Triggers.RegisterTrigger(trigger2)
@@ -145,7 +145,7 @@ func TestTriggerRules(t *testing.T) {
 	// ... a player collidable
 	InitTriggers()
 	healthPickup := &MockTriggerable{
-		TriggerTags: []string{"health_pickup"},
+		TriggerTags: []string{"pickup"},
 		ActiveState: true,
 	}
 	player := &MockCollidable{
@@ -205,7 +205,7 @@ func TestTriggerActivationWithCollisionSystem(t *testing.T) {
 			Min: rl.Vector3{X: 0, Y: 0, Z: 0},
 			Max: rl.Vector3{X: 1, Y: 1, Z: 1},
 		},
-		TriggerTags: []string{"health_pickup"},
+		TriggerTags: []string{"pickup"},
 		ActiveState: true,
 	}
 	player := &MockCollidable{
@@ -246,7 +246,7 @@ func TestTriggerNoActivationWhenNotOverlapping(t *testing.T) {
 			Min: rl.Vector3{X: 0, Y: 0, Z: 0},
 			Max: rl.Vector3{X: 1, Y: 1, Z: 1},
 		},
-		TriggerTags: []string{"health_pickup"},
+		TriggerTags: []string{"pickup"},
 		ActiveState: true,
 	}
 	player := &MockCollidable{
@@ -285,7 +285,7 @@ func TestTriggerInactiveObjects(t *testing.T) {
 			Min: rl.Vector3{X: 0, Y: 0, Z: 0},
 			Max: rl.Vector3{X: 1, Y: 1, Z: 1},
 		},
-		TriggerTags: []string{"health_pickup"},
+		TriggerTags: []string{"pickup"},
 		ActiveState: false, // Inactive
 	}
 	player := &MockCollidable{
@@ -319,7 +319,7 @@ func TestTriggerInactiveObjects(t *testing.T) {
 			Min: rl.Vector3{X: 0, Y: 0, Z: 0},
 			Max: rl.Vector3{X: 1, Y: 1, Z: 1},
 		},
-		TriggerTags: []string{"health_pickup"},
+		TriggerTags: []string{"pickup"},
 		ActiveState: true,
 	}
 	inactivePlayer := &MockCollidable{
@@ -360,7 +360,7 @@ func TestTriggerMultipleUpdates(t *testing.T) {
 			Min: rl.Vector3{X: 0, Y: 0, Z: 0},
 			Max: rl.Vector3{X: 1, Y: 1, Z: 1},
 		},
-		TriggerTags: []string{"health_pickup"},
+		TriggerTags: []string{"pickup"},
 		ActiveState: true,
 	}
 	player := &MockCollidable{
@@ -467,7 +467,7 @@ func TestTriggerSystemEdgeCases(t *testing.T) {
 			Min: rl.Vector3{X: 0, Y: 0, Z: 0},
 			Max: rl.Vector3{X: 1, Y: 1, Z: 1},
 		},
-		TriggerTags: []string{"health_pickup"},
+		TriggerTags: []string{"pickup"},
 		ActiveState: true,
 	}
 	// ... registered with the trigger system
@@ -490,7 +490,7 @@ func TestTriggerSystemEdgeCases(t *testing.T) {
 			Min: rl.Vector3{X: 0, Y: 0, Z: 0},
 			Max: rl.Vector3{X: 1, Y: 1, Z: 1},
 		},
-		TriggerTags: []string{"health_pickup"},
+		TriggerTags: []string{"pickup"},
 		ActiveState: true,
 	}
 	// ... a player overlapping both triggers
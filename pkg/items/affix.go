@@ -0,0 +1,211 @@
+package items
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// Rarity decides how many random affixes an item rolls
+type Rarity string
+
+const (
+	RarityNormal Rarity = "normal"
+	RarityMagic  Rarity = "magic"
+	RarityRare   Rarity = "rare"
+	RarityUnique Rarity = "unique"
+)
+
+// AllRarities lists every rarity from most to least common
+var AllRarities = []Rarity{RarityNormal, RarityMagic, RarityRare, RarityUnique}
+
+// IsKnownRarity reports whether rarity names a supported rarity
+func IsKnownRarity(rarity Rarity) bool {
+	return slices.Contains(AllRarities, rarity)
+}
+
+// affixLimits returns the fewest and most affixes in total, and the most of
+// each of prefixes and suffixes, an item of the rarity rolls
+func affixLimits(rarity Rarity) (minTotal, maxTotal, maxPerType int) {
+	switch rarity {
+	case RarityMagic:
+		return 1, 2, 1
+	case RarityRare:
+		return 3, 6, 3
+	default:
+		return 0, 0, 0
+	}
+}
+
+// AffixType is where an affix sits in a generated item's name
+type AffixType string
+
+const (
+	AffixPrefix AffixType = "prefix"
+	AffixSuffix AffixType = "suffix"
+)
+
+// AffixTier is one strength band of an affix. Higher tiers need a higher
+// item level to roll.
+type AffixTier struct {
+	Tier         int
+	MinItemLevel int
+	Min          float32
+	Max          float32
+	Weight       int
+}
+
+// AffixDefinition describes a random modifier that magic and rare items can roll
+type AffixDefinition struct {
+	ID    string
+	Name  string // e.g. "Sturdy" for prefixes, "of the Bear" for suffixes
+	Type  AffixType
+	Group string // An item rolls at most one affix from each group
+	Stat  string
+	Kind  ModifierKind
+	Slots []Slot // Item slots the affix can roll on; empty allows every slot
+	Tiers []AffixTier
+}
+
+// AllowsSlot reports whether the affix can roll on items of slot
+func (a *AffixDefinition) AllowsSlot(slot Slot) bool {
+	return len(a.Slots) == 0 || slices.Contains(a.Slots, slot)
+}
+
+// Tier returns the tier with the given number
+func (a *AffixDefinition) Tier(tier int) (AffixTier, bool) {
+	for _, candidate := range a.Tiers {
+		if candidate.Tier == tier {
+			return candidate, true
+		}
+	}
+	return AffixTier{}, false
+}
+
+// eligibleTiers returns the tiers that can roll at itemLevel
+func (a *AffixDefinition) eligibleTiers(itemLevel int) []AffixTier {
+	var tiers []AffixTier
+	for _, tier := range a.Tiers {
+		if tier.MinItemLevel <= itemLevel {
+			tiers = append(tiers, tier)
+		}
+	}
+	return tiers
+}
+
+// RolledAffix is an affix rolled onto an item with its tier and value
+type RolledAffix struct {
+	Affix *AffixDefinition
+	Tier  int
+	Value float32
+}
+
+// Modifier returns the stat modifier the rolled affix grants
+func (r RolledAffix) Modifier() StatModifier {
+	return StatModifier{Stat: r.Affix.Stat, Kind: r.Affix.Kind, Value: r.Value}
+}
+
+// UniqueDefinition is a named item with fixed modifiers on a base type
+type UniqueDefinition struct {
+	ID           string
+	Name         string
+	Base         string // Item definition ID the unique is built on
+	MinItemLevel int
+	Modifiers    []StatModifier
+}
+
+// AffixPool holds every affix and unique item that generated loot can roll
+type AffixPool struct {
+	affixes map[string]*AffixDefinition
+	uniques map[string]*UniqueDefinition
+}
+
+// NewAffixPool creates an empty affix pool
+func NewAffixPool() *AffixPool {
+	return &AffixPool{
+		affixes: make(map[string]*AffixDefinition),
+		uniques: make(map[string]*UniqueDefinition),
+	}
+}
+
+// AddAffix registers an affix. IDs must be unique and tiers must be numbered
+// uniquely with a valid value range.
+func (p *AffixPool) AddAffix(affix AffixDefinition) error {
+	if affix.ID == "" {
+		return fmt.Errorf("affix ID cannot be empty")
+	}
+	if _, exists := p.affixes[affix.ID]; exists {
+		return fmt.Errorf("duplicate affix: %s", affix.ID)
+	}
+	if affix.Type != AffixPrefix && affix.Type != AffixSuffix {
+		return fmt.Errorf("affix %s: type must be prefix or suffix, got %q", affix.ID, affix.Type)
+	}
+	if len(affix.Tiers) == 0 {
+		return fmt.Errorf("affix %s: tiers cannot be empty", affix.ID)
+	}
+	seen := make(map[int]bool)
+	for _, tier := range affix.Tiers {
+		if seen[tier.Tier] {
+			return fmt.Errorf("affix %s: duplicate tier %d", affix.ID, tier.Tier)
+		}
+		seen[tier.Tier] = true
+		if tier.Min > tier.Max {
+			return fmt.Errorf("affix %s tier %d: min %f exceeds max %f", affix.ID, tier.Tier, tier.Min, tier.Max)
+		}
+		if tier.Weight <= 0 {
+			return fmt.Errorf("affix %s tier %d: weight must be positive, got %d", affix.ID, tier.Tier, tier.Weight)
+		}
+	}
+	if affix.Group == "" {
+		affix.Group = affix.ID
+	}
+	p.affixes[affix.ID] = &affix
+	return nil
+}
+
+// AddUnique registers a unique item. IDs must be unique.
+func (p *AffixPool) AddUnique(unique UniqueDefinition) error {
+	if unique.ID == "" {
+		return fmt.Errorf("unique ID cannot be empty")
+	}
+	if _, exists := p.uniques[unique.ID]; exists {
+		return fmt.Errorf("duplicate unique: %s", unique.ID)
+	}
+	p.uniques[unique.ID] = &unique
+	return nil
+}
+
+// Affix returns the affix with the given ID
+func (p *AffixPool) Affix(id string) (*AffixDefinition, bool) {
+	affix, exists := p.affixes[id]
+	return affix, exists
+}
+
+// Unique returns the unique item with the given ID
+func (p *AffixPool) Unique(id string) (*UniqueDefinition, bool) {
+	unique, exists := p.uniques[id]
+	return unique, exists
+}
+
+// uniquesFor returns the uniques built on base that can drop at itemLevel,
+// sorted by ID
+func (p *AffixPool) uniquesFor(base string, itemLevel int) []*UniqueDefinition {
+	var uniques []*UniqueDefinition
+	for _, unique := range p.uniques {
+		if unique.Base == base && unique.MinItemLevel <= itemLevel {
+			uniques = append(uniques, unique)
+		}
+	}
+	sort.Slice(uniques, func(i, j int) bool { return uniques[i].ID < uniques[j].ID })
+	return uniques
+}
+
+// sortedAffixes returns every affix sorted by ID so rolls are reproducible
+func (p *AffixPool) sortedAffixes() []*AffixDefinition {
+	affixes := make([]*AffixDefinition, 0, len(p.affixes))
+	for _, affix := range p.affixes {
+		affixes = append(affixes, affix)
+	}
+	sort.Slice(affixes, func(i, j int) bool { return affixes[i].ID < affixes[j].ID })
+	return affixes
+}
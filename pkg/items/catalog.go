@@ -0,0 +1,66 @@
+package items
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Catalog holds every item definition keyed by ID and creates item instances
+type Catalog struct {
+	definitions map[string]*Definition
+	nextUID     int
+}
+
+// NewCatalog creates an empty item catalog
+func NewCatalog() *Catalog {
+	return &Catalog{
+		definitions: make(map[string]*Definition),
+	}
+}
+
+// Add registers a definition. IDs must be unique.
+func (c *Catalog) Add(definition Definition) error {
+	if definition.ID == "" {
+		return fmt.Errorf("item definition ID cannot be empty")
+	}
+	if _, exists := c.definitions[definition.ID]; exists {
+		return fmt.Errorf("duplicate item definition: %s", definition.ID)
+	}
+	c.definitions[definition.ID] = &definition
+	return nil
+}
+
+// Get returns the definition with the given ID
+func (c *Catalog) Get(id string) (*Definition, bool) {
+	definition, exists := c.definitions[id]
+	return definition, exists
+}
+
+// IDs returns every definition ID in sorted order
+func (c *Catalog) IDs() []string {
+	ids := make([]string, 0, len(c.definitions))
+	for id := range c.definitions {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// NewItem creates an item instance with a unique UID
+func (c *Catalog) NewItem(id string, quantity int) (*Item, error) {
+	definition, exists := c.definitions[id]
+	if !exists {
+		return nil, fmt.Errorf("unknown item %q", id)
+	}
+	if quantity < 1 || quantity > max(definition.MaxStack, 1) {
+		return nil, fmt.Errorf("item %s: quantity must be in [1, %d], got %d",
+			id, max(definition.MaxStack, 1), quantity)
+	}
+
+	c.nextUID++
+	return &Item{
+		UID:        fmt.Sprintf("item_%d", c.nextUID),
+		Definition: definition,
+		Quantity:   quantity,
+	}, nil
+}
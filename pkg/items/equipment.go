@@ -0,0 +1,111 @@
+package items
+
+import "fmt"
+
+// EquipSlot is a concrete equipment slot on a character. Characters have
+// two ring slots, so slots differ from the item Slot kinds.
+type EquipSlot string
+
+const (
+	EquipWeapon    EquipSlot = "weapon"
+	EquipHelmet    EquipSlot = "helmet"
+	EquipChest     EquipSlot = "chest"
+	EquipGloves    EquipSlot = "gloves"
+	EquipBoots     EquipSlot = "boots"
+	EquipRingLeft  EquipSlot = "ring_left"
+	EquipRingRight EquipSlot = "ring_right"
+	EquipAmulet    EquipSlot = "amulet"
+)
+
+// AllEquipSlots lists every equipment slot in display order
+var AllEquipSlots = []EquipSlot{
+	EquipWeapon,
+	EquipHelmet,
+	EquipChest,
+	EquipGloves,
+	EquipBoots,
+	EquipRingLeft,
+	EquipRingRight,
+	EquipAmulet,
+}
+
+// Accepts reports whether items of the given slot kind fit in s
+func (s EquipSlot) Accepts(slot Slot) bool {
+	switch s {
+	case EquipRingLeft, EquipRingRight:
+		return slot == SlotRing
+	default:
+		return slot != SlotNone && Slot(s) == slot
+	}
+}
+
+// Equipment holds the items worn in each slot
+type Equipment struct {
+	slots map[EquipSlot]*Item
+}
+
+// NewEquipment creates empty equipment
+func NewEquipment() *Equipment {
+	return &Equipment{
+		slots: make(map[EquipSlot]*Item),
+	}
+}
+
+// Equip wears item in the slot it fits, preferring an empty ring slot, and
+// returns the slot used and the item it replaced, if any
+func (e *Equipment) Equip(item *Item) (EquipSlot, *Item, error) {
+	slot, err := e.slotFor(item)
+	if err != nil {
+		return "", nil, err
+	}
+	previous, err := e.EquipTo(slot, item)
+	return slot, previous, err
+}
+
+// EquipTo wears item in slot and returns the item it replaced, if any
+func (e *Equipment) EquipTo(slot EquipSlot, item *Item) (*Item, error) {
+	if !slot.Accepts(item.Definition.Slot) {
+		return nil, fmt.Errorf("%s cannot be equipped as %s", item.Name(), slot)
+	}
+	previous := e.slots[slot]
+	e.slots[slot] = item
+	return previous, nil
+}
+
+// Unequip removes and returns the item in slot, or nil when it is empty
+func (e *Equipment) Unequip(slot EquipSlot) *Item {
+	item := e.slots[slot]
+	delete(e.slots, slot)
+	return item
+}
+
+// Get returns the item in slot, or nil when it is empty
+func (e *Equipment) Get(slot EquipSlot) *Item {
+	return e.slots[slot]
+}
+
+// Equipped returns every worn item in slot display order
+func (e *Equipment) Equipped() []*Item {
+	var worn []*Item
+	for _, slot := range AllEquipSlots {
+		if item := e.slots[slot]; item != nil {
+			worn = append(worn, item)
+		}
+	}
+	return worn
+}
+
+// slotFor picks the slot item should go in
+func (e *Equipment) slotFor(item *Item) (EquipSlot, error) {
+	switch item.Definition.Slot {
+	case SlotNone:
+		return "", fmt.Errorf("%s cannot be equipped", item.Name())
+	case SlotRing:
+		if e.slots[EquipRingLeft] != nil && e.slots[EquipRingRight] == nil {
+			return EquipRingRight, nil
+		}
+		return EquipRingLeft, nil
+	default:
+		return EquipSlot(item.Definition.Slot), nil
+	}
+}
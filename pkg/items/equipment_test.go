@@ -0,0 +1,59 @@
+package items
+
+import "testing"
+
+func TestEquipRingsFillBothSlots(t *testing.T) {
+	// given
+	// ... empty equipment and three rings
+	catalog := testGenerator(t).Catalog()
+	equipment := NewEquipment()
+	first, _ := catalog.NewItem("ring", 1)
+	second, _ := catalog.NewItem("ring", 1)
+	third, _ := catalog.NewItem("ring", 1)
+	// when
+	// ... the rings are equipped in turn
+	firstSlot, _, _ := equipment.Equip(first)
+	secondSlot, _, _ := equipment.Equip(second)
+	thirdSlot, replaced, _ := equipment.Equip(third)
+	// then
+	// ... should fill the left then right slot
+	// ... and the third should replace the left ring
+	if firstSlot != EquipRingLeft || secondSlot != EquipRingRight {
+		t.Fatalf("Expected left then right ring slots, got %s and %s", firstSlot, secondSlot)
+	}
+	if thirdSlot != EquipRingLeft || replaced != first {
+		t.Fatalf("Expected third ring to replace the left ring, got %s", thirdSlot)
+	}
+}
+
+func TestEquipRejectsUnequippableItems(t *testing.T) {
+	// given
+	// ... empty equipment and a potion
+	catalog := testGenerator(t).Catalog()
+	equipment := NewEquipment()
+	// when
+	// ... the potion is equipped
+	potion, _ := catalog.NewItem("potion", 1)
+	_, _, err := equipment.Equip(potion)
+	// then
+	// ... should fail
+	if err == nil {
+		t.Fatal("Expected error equipping a potion")
+	}
+}
+
+func TestEquipToRejectsWrongSlot(t *testing.T) {
+	// given
+	// ... empty equipment and a helm
+	catalog := testGenerator(t).Catalog()
+	equipment := NewEquipment()
+	// when
+	// ... the helm is equipped as a weapon
+	helm, _ := catalog.NewItem("helm", 1)
+	_, err := equipment.EquipTo(EquipWeapon, helm)
+	// then
+	// ... should fail and leave the slot empty
+	if err == nil || equipment.Get(EquipWeapon) != nil {
+		t.Fatal("Expected helm to be rejected from the weapon slot")
+	}
+}
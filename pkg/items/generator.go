@@ -0,0 +1,187 @@
+package items
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// DefaultRarityWeights are the relative chances of each rarity for
+// generated equipment
+var DefaultRarityWeights = map[Rarity]int{
+	RarityNormal: 60,
+	RarityMagic:  30,
+	RarityRare:   9,
+	RarityUnique: 1,
+}
+
+// Words combined into rare item names, e.g. "Storm Grasp"
+var (
+	rareNameFirst  = []string{"Doom", "Grim", "Storm", "Blood", "Dread", "Rune", "Ash", "Gale"}
+	rareNameSecond = []string{"Bane", "Grasp", "Song", "Ward", "Coil", "Spire", "Mark", "Veil"}
+)
+
+// Generator creates items with randomly rolled rarities and affixes
+type Generator struct {
+	catalog       *Catalog
+	pool          *AffixPool
+	RarityWeights map[Rarity]int
+}
+
+// NewGenerator creates a generator rolling affixes and uniques from pool
+func NewGenerator(catalog *Catalog, pool *AffixPool) *Generator {
+	return &Generator{
+		catalog:       catalog,
+		pool:          pool,
+		RarityWeights: DefaultRarityWeights,
+	}
+}
+
+// Catalog returns the catalog the generator creates items from
+func (g *Generator) Catalog() *Catalog {
+	return g.catalog
+}
+
+// Generate creates an item, rolling a rarity and affixes for equipment.
+// Items that cannot be equipped are always normal.
+func (g *Generator) Generate(id string, quantity, itemLevel int, rng *rand.Rand) (*Item, error) {
+	definition, exists := g.catalog.Get(id)
+	if !exists {
+		return nil, fmt.Errorf("unknown item %q", id)
+	}
+	rarity := RarityNormal
+	if definition.Equippable() {
+		rarity = g.rollRarity(rng)
+	}
+	return g.GenerateWithRarity(id, quantity, itemLevel, rarity, rng)
+}
+
+// GenerateWithRarity creates an item of the given rarity. A unique is
+// chosen among those built on the item that can drop at itemLevel; when
+// there are none a rare is generated instead.
+func (g *Generator) GenerateWithRarity(id string, quantity, itemLevel int, rarity Rarity, rng *rand.Rand) (*Item, error) {
+	item, err := g.catalog.NewItem(id, quantity)
+	if err != nil {
+		return nil, err
+	}
+	item.ItemLevel = itemLevel
+	item.Rarity = RarityNormal
+	if !item.Definition.Equippable() {
+		return item, nil
+	}
+
+	if rarity == RarityUnique {
+		uniques := g.pool.uniquesFor(id, itemLevel)
+		if len(uniques) > 0 {
+			item.Rarity = RarityUnique
+			item.Unique = uniques[rng.Intn(len(uniques))]
+			return item, nil
+		}
+		rarity = RarityRare
+	}
+
+	item.Rarity = rarity
+	g.rollAffixes(item, rng)
+	if rarity == RarityRare {
+		item.RareName = rareNameFirst[rng.Intn(len(rareNameFirst))] + " " +
+			rareNameSecond[rng.Intn(len(rareNameSecond))]
+	}
+	return item, nil
+}
+
+// rollRarity picks a rarity by weight
+func (g *Generator) rollRarity(rng *rand.Rand) Rarity {
+	total := 0
+	for _, rarity := range AllRarities {
+		total += g.RarityWeights[rarity]
+	}
+	if total <= 0 {
+		return RarityNormal
+	}
+
+	roll := rng.Intn(total)
+	for _, rarity := range AllRarities {
+		if roll < g.RarityWeights[rarity] {
+			return rarity
+		}
+		roll -= g.RarityWeights[rarity]
+	}
+	return RarityNormal
+}
+
+// rollAffixes adds random affixes allowed by the item's rarity, slot and
+// level. Each pick is weighted by the affix's eligible tiers and never
+// repeats an affix group.
+func (g *Generator) rollAffixes(item *Item, rng *rand.Rand) {
+	minTotal, maxTotal, maxPerType := affixLimits(item.Rarity)
+	if maxTotal == 0 {
+		return
+	}
+	count := minTotal + rng.Intn(maxTotal-minTotal+1)
+
+	perType := make(map[AffixType]int)
+	groups := make(map[string]bool)
+	for len(item.Affixes) < count {
+		var candidates []*AffixDefinition
+		var weights []int
+		total := 0
+		for _, affix := range g.pool.sortedAffixes() {
+			if !affix.AllowsSlot(item.Definition.Slot) || groups[affix.Group] || perType[affix.Type] >= maxPerType {
+				continue
+			}
+			weight := 0
+			for _, tier := range affix.eligibleTiers(item.ItemLevel) {
+				weight += tier.Weight
+			}
+			if weight == 0 {
+				continue
+			}
+			candidates = append(candidates, affix)
+			weights = append(weights, weight)
+			total += weight
+		}
+		if len(candidates) == 0 {
+			return
+		}
+
+		roll := rng.Intn(total)
+		chosen := candidates[len(candidates)-1]
+		for i, weight := range weights {
+			if roll < weight {
+				chosen = candidates[i]
+				break
+			}
+			roll -= weight
+		}
+
+		tier := rollTier(chosen.eligibleTiers(item.ItemLevel), rng)
+		item.Affixes = append(item.Affixes, RolledAffix{
+			Affix: chosen,
+			Tier:  tier.Tier,
+			Value: roundAffixValue(tier.Min + rng.Float32()*(tier.Max-tier.Min)),
+		})
+		perType[chosen.Type]++
+		groups[chosen.Group] = true
+	}
+}
+
+// rollTier picks one of tiers by weight
+func rollTier(tiers []AffixTier, rng *rand.Rand) AffixTier {
+	total := 0
+	for _, tier := range tiers {
+		total += tier.Weight
+	}
+	roll := rng.Intn(total)
+	for _, tier := range tiers {
+		if roll < tier.Weight {
+			return tier
+		}
+		roll -= tier.Weight
+	}
+	return tiers[len(tiers)-1]
+}
+
+// roundAffixValue keeps two decimal places so rolled values serialize exactly
+func roundAffixValue(value float32) float32 {
+	return float32(math.Round(float64(value)*100) / 100)
+}
@@ -0,0 +1,264 @@
+package items
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+)
+
+// testGenerator returns a generator over a small catalog of gear and potions
+// with a pool of tiered affixes and one unique sword
+func testGenerator(t *testing.T) *Generator {
+	t.Helper()
+	catalog := NewCatalog()
+	definitions := []Definition{
+		{ID: "sword", Name: "Sword", Slot: SlotWeapon, Width: 1, Height: 3, MaxStack: 1},
+		{ID: "helm", Name: "Helm", Slot: SlotHelmet, Width: 2, Height: 2, MaxStack: 1},
+		{ID: "ring", Name: "Ring", Slot: SlotRing, Width: 1, Height: 1, MaxStack: 1},
+		{ID: "potion", Name: "Potion", Width: 1, Height: 1, MaxStack: 10},
+	}
+	for _, definition := range definitions {
+		if err := catalog.Add(definition); err != nil {
+			t.Fatalf("Failed to add %s: %v", definition.ID, err)
+		}
+	}
+	pool := NewAffixPool()
+	affixes := []AffixDefinition{
+		{ID: "heavy", Name: "Heavy", Type: AffixPrefix, Stat: "damage", Kind: ModifierFlat, Tiers: []AffixTier{
+			{Tier: 1, MinItemLevel: 1, Min: 1, Max: 3, Weight: 100},
+			{Tier: 2, MinItemLevel: 5, Min: 10, Max: 20, Weight: 100},
+		}},
+		{ID: "hale", Name: "Hale", Type: AffixPrefix, Stat: "max_health", Kind: ModifierFlat, Tiers: []AffixTier{
+			{Tier: 1, MinItemLevel: 1, Min: 5, Max: 10, Weight: 100},
+		}},
+		{ID: "sturdy", Name: "Sturdy", Type: AffixPrefix, Stat: "armor", Kind: ModifierFlat, Tiers: []AffixTier{
+			{Tier: 1, MinItemLevel: 1, Min: 5, Max: 10, Weight: 100},
+		}},
+		{ID: "of_haste", Name: "of Haste", Type: AffixSuffix, Stat: "move_speed", Kind: ModifierIncreased, Tiers: []AffixTier{
+			{Tier: 1, MinItemLevel: 1, Min: 0.05, Max: 0.1, Weight: 100},
+		}},
+		{ID: "of_precision", Name: "of Precision", Type: AffixSuffix, Stat: "crit_chance", Kind: ModifierFlat, Tiers: []AffixTier{
+			{Tier: 1, MinItemLevel: 1, Min: 0.01, Max: 0.03, Weight: 100},
+		}},
+		{ID: "of_the_ward", Name: "of the Ward", Type: AffixSuffix, Stat: "all_resistance", Kind: ModifierFlat, Tiers: []AffixTier{
+			{Tier: 1, MinItemLevel: 1, Min: 0.03, Max: 0.06, Weight: 100},
+		}},
+	}
+	for _, affix := range affixes {
+		if err := pool.AddAffix(affix); err != nil {
+			t.Fatalf("Failed to add %s: %v", affix.ID, err)
+		}
+	}
+	if err := pool.AddUnique(UniqueDefinition{
+		ID:           "doomblade",
+		Name:         "Doomblade",
+		Base:         "sword",
+		MinItemLevel: 3,
+		Modifiers: []StatModifier{
+			{Stat: "damage", Kind: ModifierMore, Value: 0.5},
+			{Stat: "max_health", Kind: ModifierFlat, Value: -10},
+		},
+	}); err != nil {
+		t.Fatalf("Failed to add unique: %v", err)
+	}
+	return NewGenerator(catalog, pool)
+}
+
+func TestGeneratedAffixCountsMatchRarity(t *testing.T) {
+	// given
+	// ... a generator with three prefixes and three suffixes for swords
+	generator := testGenerator(t)
+	rng := rand.New(rand.NewSource(7))
+	limits := map[Rarity][2]int{RarityNormal: {0, 0}, RarityMagic: {1, 2}, RarityRare: {3, 6}}
+	for rarity, limit := range limits {
+		for range 200 {
+			// when
+			// ... a sword of the rarity is generated
+			item, err := generator.GenerateWithRarity("sword", 1, 10, rarity, rng)
+			if err != nil {
+				t.Fatalf("Expected generation to succeed, got %v", err)
+			}
+			// then
+			// ... should roll an affix count within the rarity's limits
+			// ... with no more prefixes or suffixes than allowed
+			if len(item.Affixes) < limit[0] || len(item.Affixes) > limit[1] {
+				t.Fatalf("Expected %s item to have %d-%d affixes, got %d", rarity, limit[0], limit[1], len(item.Affixes))
+			}
+			perType := make(map[AffixType]int)
+			for _, affix := range item.Affixes {
+				perType[affix.Affix.Type]++
+			}
+			_, _, maxPerType := affixLimits(rarity)
+			if perType[AffixPrefix] > maxPerType || perType[AffixSuffix] > maxPerType {
+				t.Fatalf("Expected at most %d of each affix type on %s item, got %v", maxPerType, rarity, perType)
+			}
+		}
+	}
+}
+
+func TestAffixTiersAreGatedByItemLevel(t *testing.T) {
+	// given
+	// ... a generator whose tier 2 "heavy" needs item level 5
+	generator := testGenerator(t)
+	rng := rand.New(rand.NewSource(11))
+	sawHighTier := false
+	for range 500 {
+		// when
+		// ... rare swords are generated at item levels 1 and 10
+		low, err := generator.GenerateWithRarity("sword", 1, 1, RarityRare, rng)
+		if err != nil {
+			t.Fatalf("Expected generation to succeed, got %v", err)
+		}
+		high, err := generator.GenerateWithRarity("sword", 1, 10, RarityRare, rng)
+		if err != nil {
+			t.Fatalf("Expected generation to succeed, got %v", err)
+		}
+		// then
+		// ... low level items should never roll tier 2
+		// ... and every value should sit within its tier's range
+		for _, affix := range low.Affixes {
+			if affix.Tier != 1 {
+				t.Fatalf("Expected only tier 1 affixes at item level 1, got %s tier %d", affix.Affix.ID, affix.Tier)
+			}
+		}
+		for _, affix := range append(low.Affixes, high.Affixes...) {
+			tier, _ := affix.Affix.Tier(affix.Tier)
+			if affix.Value < tier.Min || affix.Value > tier.Max {
+				t.Fatalf("Expected %s value in [%f, %f], got %f", affix.Affix.ID, tier.Min, tier.Max, affix.Value)
+			}
+			if affix.Tier == 2 {
+				sawHighTier = true
+			}
+		}
+	}
+	// ... and high level items should be able to
+	if !sawHighTier {
+		t.Fatal("Expected tier 2 affixes to roll at item level 10")
+	}
+}
+
+func TestUniqueItemsHaveFixedModifiers(t *testing.T) {
+	// given
+	// ... a generator with a unique sword
+	generator := testGenerator(t)
+	rng := rand.New(rand.NewSource(3))
+	// when
+	// ... a unique sword is generated
+	item, err := generator.GenerateWithRarity("sword", 1, 5, RarityUnique, rng)
+	if err != nil {
+		t.Fatalf("Expected generation to succeed, got %v", err)
+	}
+	// then
+	// ... should be the unique with its exact modifiers and no affixes
+	if item.Unique == nil || item.Name() != "Doomblade" {
+		t.Fatalf("Expected Doomblade, got %q", item.Name())
+	}
+	if len(item.Affixes) != 0 {
+		t.Fatalf("Expected no rolled affixes, got %d", len(item.Affixes))
+	}
+	modifiers := item.Modifiers()
+	if len(modifiers) != 2 || modifiers[0].Value != 0.5 || modifiers[1].Value != -10 {
+		t.Fatalf("Expected the unique's fixed modifiers, got %v", modifiers)
+	}
+}
+
+func TestUniqueFallsBackToRareBelowItemLevel(t *testing.T) {
+	// given
+	// ... a unique sword that needs item level 3
+	generator := testGenerator(t)
+	rng := rand.New(rand.NewSource(3))
+	// when
+	// ... a unique sword is requested at item level 1
+	item, err := generator.GenerateWithRarity("sword", 1, 1, RarityUnique, rng)
+	if err != nil {
+		t.Fatalf("Expected generation to succeed, got %v", err)
+	}
+	// then
+	// ... should generate a rare instead
+	if item.GetRarity() != RarityRare || item.Unique != nil {
+		t.Fatalf("Expected rare fallback, got %s", item.GetRarity())
+	}
+}
+
+func TestNonEquippableItemsStayNormal(t *testing.T) {
+	// given
+	// ... a generator that only rolls rares
+	generator := testGenerator(t)
+	generator.RarityWeights = map[Rarity]int{RarityRare: 1}
+	rng := rand.New(rand.NewSource(1))
+	// when
+	// ... a potion stack is generated
+	item, err := generator.Generate("potion", 3, 10, rng)
+	if err != nil {
+		t.Fatalf("Expected generation to succeed, got %v", err)
+	}
+	// then
+	// ... should be a normal item without affixes
+	if item.GetRarity() != RarityNormal || len(item.Affixes) != 0 || item.Quantity != 3 {
+		t.Fatalf("Expected 3 normal potions, got %d %s with %d affixes", item.Quantity, item.GetRarity(), len(item.Affixes))
+	}
+}
+
+func TestGeneratedItemSurvivesSerialization(t *testing.T) {
+	// given
+	// ... a rare and a unique sword
+	generator := testGenerator(t)
+	rng := rand.New(rand.NewSource(99))
+	for _, rarity := range []Rarity{RarityMagic, RarityRare, RarityUnique} {
+		item, err := generator.GenerateWithRarity("sword", 1, 8, rarity, rng)
+		if err != nil {
+			t.Fatalf("Expected generation to succeed, got %v", err)
+		}
+		// when
+		// ... the item is serialized, restored and serialized again
+		data, err := MarshalItem(item)
+		if err != nil {
+			t.Fatalf("Expected marshal to succeed, got %v", err)
+		}
+		restored, err := generator.UnmarshalItem(data)
+		if err != nil {
+			t.Fatalf("Expected unmarshal to succeed, got %v", err)
+		}
+		again, err := MarshalItem(restored)
+		if err != nil {
+			t.Fatalf("Expected marshal to succeed, got %v", err)
+		}
+		// then
+		// ... should produce identical bytes, name and modifiers
+		if !bytes.Equal(data, again) {
+			t.Fatalf("Expected identical serialization, got %s and %s", data, again)
+		}
+		if restored.Name() != item.Name() {
+			t.Fatalf("Expected name %q, got %q", item.Name(), restored.Name())
+		}
+		original, roundTripped := item.Modifiers(), restored.Modifiers()
+		if len(original) != len(roundTripped) {
+			t.Fatalf("Expected %d modifiers, got %d", len(original), len(roundTripped))
+		}
+		for i := range original {
+			if original[i] != roundTripped[i] {
+				t.Fatalf("Expected modifier %v, got %v", original[i], roundTripped[i])
+			}
+		}
+	}
+}
+
+func TestRestoreRejectsUnknownAffix(t *testing.T) {
+	// given
+	// ... a saved sword referencing an affix that no longer exists
+	generator := testGenerator(t)
+	record := ItemRecord{
+		Item:     "sword",
+		Quantity: 1,
+		Rarity:   RarityMagic,
+		Affixes:  []AffixRecord{{Affix: "removed", Tier: 1, Value: 2}},
+	}
+	// when
+	// ... the item is restored
+	_, err := generator.Restore(record)
+	// then
+	// ... should report the missing affix
+	if err == nil {
+		t.Fatal("Expected error for unknown affix")
+	}
+}
@@ -0,0 +1,286 @@
+package items
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Inventory errors
+var (
+	ErrInventoryFull = errors.New("inventory full")
+	ErrOutOfBounds   = errors.New("position out of bounds")
+	ErrCellOccupied  = errors.New("cells occupied")
+	ErrItemNotFound  = errors.New("item not found")
+)
+
+// Position addresses the top-left cell an item occupies
+type Position struct {
+	X int
+	Y int
+}
+
+// Placement is an item and where it sits in the inventory
+type Placement struct {
+	Item *Item
+	Position
+}
+
+// Inventory is a grid where each item occupies a rectangle of cells sized
+// by its definition
+type Inventory struct {
+	Width      int
+	Height     int
+	cells      []string // UID occupying each cell, "" when free
+	placements map[string]*Placement
+}
+
+// NewInventory creates an empty inventory grid
+func NewInventory(width, height int) *Inventory {
+	return &Inventory{
+		Width:      width,
+		Height:     height,
+		cells:      make([]string, width*height),
+		placements: make(map[string]*Placement),
+	}
+}
+
+// Add puts item into the inventory, topping up existing stacks of the same
+// definition first and placing any remainder in the first free space. A
+// remainder larger than MaxStack is split into full stacks, the first being
+// item itself and the rest copies of it. If the remainder does not fit
+// nothing changes and ErrInventoryFull is returned. Items merged entirely
+// into stacks are not placed themselves.
+func (inv *Inventory) Add(item *Item) error {
+	if !item.Definition.Stackable() {
+		position, found := inv.findSpace(item)
+		if !found {
+			return ErrInventoryFull
+		}
+		inv.place(item, position)
+		return nil
+	}
+
+	stacks := inv.stacksOf(item.Definition.ID)
+	room := 0
+	for _, stack := range stacks {
+		room += item.Definition.MaxStack - stack.Item.Quantity
+	}
+	topUp := min(item.Quantity, room)
+	if remainder := item.Quantity - topUp; remainder > 0 {
+		if err := inv.placeStacks(item, remainder); err != nil {
+			return err
+		}
+	} else {
+		item.Quantity = 0
+	}
+
+	for _, stack := range stacks {
+		moved := min(item.Definition.MaxStack-stack.Item.Quantity, topUp)
+		stack.Item.Quantity += moved
+		topUp -= moved
+	}
+	return nil
+}
+
+// placeStacks places quantity of item in stacks of at most MaxStack, item
+// itself holding the first. Either every stack is placed or none are.
+func (inv *Inventory) placeStacks(item *Item, quantity int) error {
+	original := item.Quantity
+	maxStack := item.Definition.MaxStack
+	var placed []*Item
+	for quantity > 0 {
+		stack := item
+		if len(placed) > 0 {
+			split := *item
+			split.UID = inv.splitUID(item.UID, len(placed))
+			stack = &split
+		}
+		stack.Quantity = min(quantity, maxStack)
+
+		position, found := inv.findSpace(stack)
+		if !found {
+			for _, stack := range placed {
+				inv.Remove(stack.UID)
+			}
+			item.Quantity = original
+			return ErrInventoryFull
+		}
+		inv.place(stack, position)
+		placed = append(placed, stack)
+		quantity -= stack.Quantity
+	}
+	return nil
+}
+
+// splitUID returns an unused UID for the nth stack split off the item with
+// the given UID
+func (inv *Inventory) splitUID(uid string, n int) string {
+	for {
+		split := fmt.Sprintf("%s_%d", uid, n)
+		if _, exists := inv.placements[split]; !exists {
+			return split
+		}
+		n++
+	}
+}
+
+// PlaceAt puts item with its top-left corner at (x, y)
+func (inv *Inventory) PlaceAt(item *Item, x, y int) error {
+	if _, exists := inv.placements[item.UID]; exists {
+		return fmt.Errorf("item %s is already in the inventory", item.UID)
+	}
+	if err := inv.checkSpace(item, Position{X: x, Y: y}); err != nil {
+		return err
+	}
+	inv.place(item, Position{X: x, Y: y})
+	return nil
+}
+
+// Move relocates the item with the given UID so its top-left corner is at (x, y)
+func (inv *Inventory) Move(uid string, x, y int) error {
+	placement, exists := inv.placements[uid]
+	if !exists {
+		return ErrItemNotFound
+	}
+	if err := inv.checkSpace(placement.Item, Position{X: x, Y: y}); err != nil {
+		return err
+	}
+	inv.clearCells(placement)
+	inv.place(placement.Item, Position{X: x, Y: y})
+	return nil
+}
+
+// Remove takes the item with the given UID out of the inventory
+func (inv *Inventory) Remove(uid string) (*Item, error) {
+	placement, exists := inv.placements[uid]
+	if !exists {
+		return nil, ErrItemNotFound
+	}
+	inv.clearCells(placement)
+	delete(inv.placements, uid)
+	return placement.Item, nil
+}
+
+// Get returns where the item with the given UID is placed
+func (inv *Inventory) Get(uid string) (Placement, bool) {
+	placement, exists := inv.placements[uid]
+	if !exists {
+		return Placement{}, false
+	}
+	return *placement, true
+}
+
+// ItemAt returns the item covering cell (x, y), or nil
+func (inv *Inventory) ItemAt(x, y int) *Item {
+	if !inv.inBounds(x, y) {
+		return nil
+	}
+	uid := inv.cells[y*inv.Width+x]
+	if uid == "" {
+		return nil
+	}
+	return inv.placements[uid].Item
+}
+
+// Placements returns every item in reading order, top row first
+func (inv *Inventory) Placements() []Placement {
+	result := make([]Placement, 0, len(inv.placements))
+	for _, placement := range inv.placements {
+		result = append(result, *placement)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Y != result[j].Y {
+			return result[i].Y < result[j].Y
+		}
+		return result[i].X < result[j].X
+	})
+	return result
+}
+
+// Count returns the total quantity held of the given definition
+func (inv *Inventory) Count(definitionID string) int {
+	total := 0
+	for _, placement := range inv.placements {
+		if placement.Item.Definition.ID == definitionID {
+			total += placement.Item.Quantity
+		}
+	}
+	return total
+}
+
+// Len returns the number of placed items and stacks
+func (inv *Inventory) Len() int {
+	return len(inv.placements)
+}
+
+// stacksOf returns the non-full stacks of a definition in reading order
+func (inv *Inventory) stacksOf(definitionID string) []Placement {
+	var stacks []Placement
+	for _, placement := range inv.Placements() {
+		item := placement.Item
+		if item.Definition.ID == definitionID && item.Quantity < item.Definition.MaxStack {
+			stacks = append(stacks, placement)
+		}
+	}
+	return stacks
+}
+
+// findSpace returns the first position, scanning rows top to bottom, where
+// item fits
+func (inv *Inventory) findSpace(item *Item) (Position, bool) {
+	for y := 0; y < inv.Height; y++ {
+		for x := 0; x < inv.Width; x++ {
+			position := Position{X: x, Y: y}
+			if inv.checkSpace(item, position) == nil {
+				return position, true
+			}
+		}
+	}
+	return Position{}, false
+}
+
+// checkSpace reports why item cannot be placed at position, ignoring cells
+// the item itself already covers
+func (inv *Inventory) checkSpace(item *Item, position Position) error {
+	width, height := footprint(item)
+	if !inv.inBounds(position.X, position.Y) || !inv.inBounds(position.X+width-1, position.Y+height-1) {
+		return ErrOutOfBounds
+	}
+	for y := position.Y; y < position.Y+height; y++ {
+		for x := position.X; x < position.X+width; x++ {
+			if uid := inv.cells[y*inv.Width+x]; uid != "" && uid != item.UID {
+				return ErrCellOccupied
+			}
+		}
+	}
+	return nil
+}
+
+func (inv *Inventory) place(item *Item, position Position) {
+	placement := &Placement{Item: item, Position: position}
+	inv.placements[item.UID] = placement
+	inv.fillCells(placement, item.UID)
+}
+
+func (inv *Inventory) clearCells(placement *Placement) {
+	inv.fillCells(placement, "")
+}
+
+func (inv *Inventory) fillCells(placement *Placement, uid string) {
+	width, height := footprint(placement.Item)
+	for y := placement.Y; y < placement.Y+height; y++ {
+		for x := placement.X; x < placement.X+width; x++ {
+			inv.cells[y*inv.Width+x] = uid
+		}
+	}
+}
+
+func (inv *Inventory) inBounds(x, y int) bool {
+	return x >= 0 && y >= 0 && x < inv.Width && y < inv.Height
+}
+
+// footprint returns the cells an item covers, treating unset sizes as 1x1
+func footprint(item *Item) (int, int) {
+	return max(item.Definition.Width, 1), max(item.Definition.Height, 1)
+}
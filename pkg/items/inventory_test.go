@@ -0,0 +1,256 @@
+package items
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAddPlacesItemsInFirstFreeSpace(t *testing.T) {
+	// given
+	// ... a 4x3 inventory holding a 2x2 helm in the top-left corner
+	catalog := testGenerator(t).Catalog()
+	inventory := NewInventory(4, 3)
+	helm, _ := catalog.NewItem("helm", 1)
+	if err := inventory.Add(helm); err != nil {
+		t.Fatalf("Expected helm to fit, got %v", err)
+	}
+	sword, _ := catalog.NewItem("sword", 1)
+	// when
+	// ... a 1x3 sword is added
+	err := inventory.Add(sword)
+	// then
+	// ... should be placed in the first column right of the helm
+	if err != nil {
+		t.Fatalf("Expected sword to fit, got %v", err)
+	}
+	placement, _ := inventory.Get(sword.UID)
+	if placement.X != 2 || placement.Y != 0 {
+		t.Fatalf("Expected sword at (2, 0), got (%d, %d)", placement.X, placement.Y)
+	}
+	if inventory.ItemAt(2, 2) != sword {
+		t.Fatal("Expected sword to cover (2, 2)")
+	}
+}
+
+func TestAddFailsWhenNoSpaceFits(t *testing.T) {
+	// given
+	// ... a 2x2 inventory
+	catalog := testGenerator(t).Catalog()
+	inventory := NewInventory(2, 2)
+	// when
+	// ... a 1x3 sword is added
+	sword, _ := catalog.NewItem("sword", 1)
+	err := inventory.Add(sword)
+	// then
+	// ... should report the inventory as full
+	if !errors.Is(err, ErrInventoryFull) {
+		t.Fatalf("Expected ErrInventoryFull, got %v", err)
+	}
+	if inventory.Len() != 0 {
+		t.Fatalf("Expected empty inventory, got %d items", inventory.Len())
+	}
+}
+
+func TestAddMergesStacksBeforePlacing(t *testing.T) {
+	// given
+	// ... an inventory holding a stack of 8 potions
+	catalog := testGenerator(t).Catalog()
+	inventory := NewInventory(4, 4)
+	existing, _ := catalog.NewItem("potion", 8)
+	if err := inventory.Add(existing); err != nil {
+		t.Fatalf("Expected potions to fit, got %v", err)
+	}
+	// when
+	// ... 5 more potions are added
+	incoming, _ := catalog.NewItem("potion", 5)
+	err := inventory.Add(incoming)
+	// then
+	// ... should fill the first stack to 10 and start a new stack of 3
+	if err != nil {
+		t.Fatalf("Expected potions to fit, got %v", err)
+	}
+	if existing.Quantity != 10 {
+		t.Fatalf("Expected first stack of 10, got %d", existing.Quantity)
+	}
+	if inventory.Len() != 2 || inventory.Count("potion") != 13 {
+		t.Fatalf("Expected 2 stacks totalling 13, got %d stacks totalling %d",
+			inventory.Len(), inventory.Count("potion"))
+	}
+}
+
+func TestAddIsAtomicWhenRemainderDoesNotFit(t *testing.T) {
+	// given
+	// ... a full 1x1 inventory holding 8 potions
+	catalog := testGenerator(t).Catalog()
+	inventory := NewInventory(1, 1)
+	existing, _ := catalog.NewItem("potion", 8)
+	if err := inventory.Add(existing); err != nil {
+		t.Fatalf("Expected potions to fit, got %v", err)
+	}
+	incoming, _ := catalog.NewItem("potion", 5)
+	// when
+	// ... 5 more potions are added
+	err := inventory.Add(incoming)
+	// then
+	// ... should fail without topping up the existing stack
+	if !errors.Is(err, ErrInventoryFull) {
+		t.Fatalf("Expected ErrInventoryFull, got %v", err)
+	}
+	if existing.Quantity != 8 || incoming.Quantity != 5 {
+		t.Fatalf("Expected quantities unchanged, got %d and %d", existing.Quantity, incoming.Quantity)
+	}
+}
+
+func TestAddSplitsOversizedStacks(t *testing.T) {
+	// given
+	// ... an empty inventory and 25 potions, more than fit one stack of 10
+	catalog := testGenerator(t).Catalog()
+	inventory := NewInventory(4, 4)
+	incoming, _ := catalog.NewItem("potion", 10)
+	incoming.Quantity = 25
+	// when
+	// ... the potions are added
+	err := inventory.Add(incoming)
+	// then
+	// ... should place stacks of 10, 10 and 5
+	if err != nil {
+		t.Fatalf("Expected potions to fit, got %v", err)
+	}
+	if inventory.Len() != 3 || inventory.Count("potion") != 25 {
+		t.Fatalf("Expected 3 stacks totalling 25, got %d stacks totalling %d",
+			inventory.Len(), inventory.Count("potion"))
+	}
+	for _, placement := range inventory.Placements() {
+		if placement.Item.Quantity > 10 {
+			t.Fatalf("Expected stacks of at most 10, got %d", placement.Item.Quantity)
+		}
+	}
+}
+
+func TestAddSplitIsAtomicWhenStacksDoNotFit(t *testing.T) {
+	// given
+	// ... a 2x1 inventory and 25 potions needing three stacks
+	catalog := testGenerator(t).Catalog()
+	inventory := NewInventory(2, 1)
+	incoming, _ := catalog.NewItem("potion", 10)
+	incoming.Quantity = 25
+	// when
+	// ... the potions are added
+	err := inventory.Add(incoming)
+	// then
+	// ... should fail leaving the inventory empty and the potions untouched
+	if !errors.Is(err, ErrInventoryFull) {
+		t.Fatalf("Expected ErrInventoryFull, got %v", err)
+	}
+	if inventory.Len() != 0 || incoming.Quantity != 25 {
+		t.Fatalf("Expected nothing placed, got %d stacks and %d potions left", inventory.Len(), incoming.Quantity)
+	}
+}
+
+func TestUnstackableItemsDoNotMerge(t *testing.T) {
+	// given
+	// ... an inventory holding a ring
+	catalog := testGenerator(t).Catalog()
+	inventory := NewInventory(4, 4)
+	ring, _ := catalog.NewItem("ring", 1)
+	if err := inventory.Add(ring); err != nil {
+		t.Fatalf("Expected ring to fit, got %v", err)
+	}
+	// when
+	// ... a second ring is added
+	another, _ := catalog.NewItem("ring", 1)
+	err := inventory.Add(another)
+	// then
+	// ... should be placed separately
+	if err != nil || inventory.Len() != 2 {
+		t.Fatalf("Expected 2 separate rings, got %d (err %v)", inventory.Len(), err)
+	}
+}
+
+func TestPlaceAtRejectsOverlapAndOutOfBounds(t *testing.T) {
+	// given
+	// ... a 4x4 inventory with a helm at (0, 0)
+	catalog := testGenerator(t).Catalog()
+	inventory := NewInventory(4, 4)
+	helm, _ := catalog.NewItem("helm", 1)
+	if err := inventory.PlaceAt(helm, 0, 0); err != nil {
+		t.Fatalf("Expected helm to be placed, got %v", err)
+	}
+	// when
+	// ... a sword is placed over the helm and past the bottom edge
+	sword, _ := catalog.NewItem("sword", 1)
+	overlapErr := inventory.PlaceAt(sword, 1, 1)
+	boundsErr := inventory.PlaceAt(sword, 3, 2)
+	// then
+	// ... both placements should be rejected
+	if !errors.Is(overlapErr, ErrCellOccupied) {
+		t.Fatalf("Expected ErrCellOccupied, got %v", overlapErr)
+	}
+	if !errors.Is(boundsErr, ErrOutOfBounds) {
+		t.Fatalf("Expected ErrOutOfBounds, got %v", boundsErr)
+	}
+}
+
+func TestMoveAllowsOverlappingItsOwnCells(t *testing.T) {
+	// given
+	// ... a helm at (0, 0)
+	catalog := testGenerator(t).Catalog()
+	inventory := NewInventory(4, 4)
+	helm, _ := catalog.NewItem("helm", 1)
+	if err := inventory.PlaceAt(helm, 0, 0); err != nil {
+		t.Fatalf("Expected helm to be placed, got %v", err)
+	}
+	// when
+	// ... the helm is moved one cell right
+	err := inventory.Move(helm.UID, 1, 0)
+	// then
+	// ... should free its old column and cover the new one
+	if err != nil {
+		t.Fatalf("Expected move to succeed, got %v", err)
+	}
+	if inventory.ItemAt(0, 0) != nil || inventory.ItemAt(2, 1) != helm {
+		t.Fatal("Expected helm to cover columns 1 and 2 only")
+	}
+}
+
+func TestRemoveFreesCells(t *testing.T) {
+	// given
+	// ... an inventory holding a sword
+	catalog := testGenerator(t).Catalog()
+	inventory := NewInventory(4, 4)
+	sword, _ := catalog.NewItem("sword", 1)
+	if err := inventory.Add(sword); err != nil {
+		t.Fatalf("Expected sword to fit, got %v", err)
+	}
+	// when
+	// ... the sword is removed, then removed again
+	removed, err := inventory.Remove(sword.UID)
+	_, missingErr := inventory.Remove(sword.UID)
+	// then
+	// ... should return the sword and free its cells
+	// ... and the second removal should fail
+	if err != nil || removed != sword {
+		t.Fatalf("Expected sword to be removed, got %v", err)
+	}
+	if inventory.ItemAt(0, 0) != nil || inventory.Len() != 0 {
+		t.Fatal("Expected inventory to be empty")
+	}
+	if !errors.Is(missingErr, ErrItemNotFound) {
+		t.Fatalf("Expected ErrItemNotFound, got %v", missingErr)
+	}
+}
+
+func TestCatalogRejectsInvalidQuantity(t *testing.T) {
+	// given
+	// ... a catalog where swords do not stack
+	catalog := testGenerator(t).Catalog()
+	// when
+	// ... two swords and an unknown item are created
+	_, quantityErr := catalog.NewItem("sword", 2)
+	_, unknownErr := catalog.NewItem("dragon_scale", 1)
+	// then
+	// ... both should fail
+	if quantityErr == nil || unknownErr == nil {
+		t.Fatal("Expected errors for invalid quantity and unknown item")
+	}
+}
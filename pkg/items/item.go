@@ -0,0 +1,135 @@
+package items
+
+// Slot is the kind of equipment slot an item fits. Items with no slot,
+// such as potions, cannot be equipped.
+type Slot string
+
+const (
+	SlotNone   Slot = ""
+	SlotWeapon Slot = "weapon"
+	SlotHelmet Slot = "helmet"
+	SlotChest  Slot = "chest"
+	SlotGloves Slot = "gloves"
+	SlotBoots  Slot = "boots"
+	SlotRing   Slot = "ring"
+	SlotAmulet Slot = "amulet"
+)
+
+// IsKnownSlot reports whether slot names a supported item slot
+func IsKnownSlot(slot Slot) bool {
+	switch slot {
+	case SlotNone, SlotWeapon, SlotHelmet, SlotChest, SlotGloves, SlotBoots, SlotRing, SlotAmulet:
+		return true
+	default:
+		return false
+	}
+}
+
+// ModifierKind mirrors the stat modifier kinds items can grant
+type ModifierKind string
+
+const (
+	ModifierFlat      ModifierKind = "flat"
+	ModifierIncreased ModifierKind = "increased"
+	ModifierMore      ModifierKind = "more"
+)
+
+// IsKnownModifierKind reports whether kind names a supported modifier kind
+func IsKnownModifierKind(kind ModifierKind) bool {
+	switch kind {
+	case ModifierFlat, ModifierIncreased, ModifierMore:
+		return true
+	default:
+		return false
+	}
+}
+
+// StatModifier is a stat change granted by an item while it is equipped
+type StatModifier struct {
+	Stat  string
+	Kind  ModifierKind
+	Value float32
+}
+
+// Definition describes an item base type shared by every copy of the item
+type Definition struct {
+	ID        string
+	Name      string
+	BaseType  string // e.g. "sword", "ring", "potion"
+	Slot      Slot
+	Width     int // Inventory cells taken horizontally
+	Height    int // Inventory cells taken vertically
+	MaxStack  int // Largest stack size; 1 for unstackable items
+	Modifiers []StatModifier
+}
+
+// Stackable reports whether copies of the item merge into stacks
+func (d *Definition) Stackable() bool {
+	return d.MaxStack > 1
+}
+
+// Equippable reports whether the item fits an equipment slot
+func (d *Definition) Equippable() bool {
+	return d.Slot != SlotNone
+}
+
+// Item is a single item instance or stack in the game
+type Item struct {
+	UID        string
+	Definition *Definition
+	Quantity   int
+	Rarity     Rarity // Empty is treated as normal
+	ItemLevel  int
+	Affixes    []RolledAffix
+	Unique     *UniqueDefinition // Set for unique items
+	RareName   string            // Rolled name of a rare item
+}
+
+// GetRarity returns the item's rarity, defaulting to normal
+func (i *Item) GetRarity() Rarity {
+	if i.Rarity == "" {
+		return RarityNormal
+	}
+	return i.Rarity
+}
+
+// Name returns the display name of the item. Uniques and rares use their
+// own names and magic items wrap the base name in their affix names.
+func (i *Item) Name() string {
+	switch {
+	case i.Unique != nil:
+		return i.Unique.Name
+	case i.RareName != "":
+		return i.RareName
+	case i.GetRarity() == RarityMagic:
+		name := i.Definition.Name
+		for _, affix := range i.Affixes {
+			if affix.Affix.Type == AffixPrefix {
+				name = affix.Affix.Name + " " + name
+			} else {
+				name = name + " " + affix.Affix.Name
+			}
+		}
+		return name
+	default:
+		return i.Definition.Name
+	}
+}
+
+// Modifiers returns the stat modifiers the item grants when equipped: the
+// base type's, then a unique's fixed modifiers, then every rolled affix
+func (i *Item) Modifiers() []StatModifier {
+	if i.Unique == nil && len(i.Affixes) == 0 {
+		return i.Definition.Modifiers
+	}
+
+	modifiers := make([]StatModifier, 0, len(i.Definition.Modifiers)+len(i.Affixes))
+	modifiers = append(modifiers, i.Definition.Modifiers...)
+	if i.Unique != nil {
+		modifiers = append(modifiers, i.Unique.Modifiers...)
+	}
+	for _, affix := range i.Affixes {
+		modifiers = append(modifiers, affix.Modifier())
+	}
+	return modifiers
+}
@@ -0,0 +1,201 @@
+package items
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+// maxLootDepth bounds how deeply loot tables may nest
+const maxLootDepth = 8
+
+// LootEntry is one weighted outcome of a loot table roll. An entry naming
+// neither an item nor a table drops nothing.
+type LootEntry struct {
+	Weight      int
+	Item        string // Item definition dropped
+	Table       string // Nested table rolled instead of dropping an item
+	MinQuantity int    // Defaults to 1
+	MaxQuantity int    // Defaults to MinQuantity
+}
+
+// LootTable picks Rolls entries by weight each time it is rolled
+type LootTable struct {
+	Rolls   int
+	Entries []LootEntry
+}
+
+// Drop is an item and quantity produced by a loot roll
+type Drop struct {
+	ItemID   string
+	Quantity int
+}
+
+// LootTables holds every loot table keyed by ID
+type LootTables struct {
+	tables map[string]LootTable
+}
+
+// NewLootTables creates an empty set of loot tables
+func NewLootTables() *LootTables {
+	return &LootTables{
+		tables: make(map[string]LootTable),
+	}
+}
+
+// Add registers a loot table. IDs must be unique.
+func (l *LootTables) Add(id string, table LootTable) error {
+	if id == "" {
+		return fmt.Errorf("loot table ID cannot be empty")
+	}
+	if _, exists := l.tables[id]; exists {
+		return fmt.Errorf("duplicate loot table: %s", id)
+	}
+	l.tables[id] = table
+	return nil
+}
+
+// Get returns the table with the given ID
+func (l *LootTables) Get(id string) (LootTable, bool) {
+	table, exists := l.tables[id]
+	return table, exists
+}
+
+// Validate checks every entry has a positive weight, references known items
+// and tables with quantities that fit a stack, and that no table nests itself
+func (l *LootTables) Validate(catalog *Catalog) error {
+	ids := make([]string, 0, len(l.tables))
+	for id := range l.tables {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		table := l.tables[id]
+		if table.Rolls < 1 {
+			return fmt.Errorf("loot table %s: rolls must be at least 1, got %d", id, table.Rolls)
+		}
+		if len(table.Entries) == 0 {
+			return fmt.Errorf("loot table %s: entries cannot be empty", id)
+		}
+		for i, entry := range table.Entries {
+			if err := l.validateEntry(entry, catalog); err != nil {
+				return fmt.Errorf("loot table %s entry %d: %w", id, i, err)
+			}
+		}
+		if err := l.checkNesting(id, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *LootTables) validateEntry(entry LootEntry, catalog *Catalog) error {
+	if entry.Weight <= 0 {
+		return fmt.Errorf("weight must be positive, got %d", entry.Weight)
+	}
+	if entry.Item != "" && entry.Table != "" {
+		return fmt.Errorf("cannot drop both an item and a table")
+	}
+	if entry.Table != "" {
+		if _, exists := l.tables[entry.Table]; !exists {
+			return fmt.Errorf("unknown loot table %q", entry.Table)
+		}
+	}
+	if entry.Item != "" {
+		definition, exists := catalog.Get(entry.Item)
+		if !exists {
+			return fmt.Errorf("unknown item %q", entry.Item)
+		}
+		minQuantity, maxQuantity := entry.quantityRange()
+		if minQuantity < 1 || maxQuantity < minQuantity || maxQuantity > max(definition.MaxStack, 1) {
+			return fmt.Errorf("quantity range [%d, %d] invalid for %s", minQuantity, maxQuantity, entry.Item)
+		}
+	}
+	return nil
+}
+
+// checkNesting walks nested tables from id, failing on cycles or tables
+// nested deeper than maxLootDepth
+func (l *LootTables) checkNesting(id string, path []string) error {
+	for _, visited := range path {
+		if visited == id {
+			return fmt.Errorf("loot table %s nests itself", id)
+		}
+	}
+	path = append(path, id)
+	if len(path) > maxLootDepth {
+		return fmt.Errorf("loot table %s nests deeper than %d", path[0], maxLootDepth)
+	}
+
+	for _, entry := range l.tables[id].Entries {
+		if entry.Table == "" {
+			continue
+		}
+		if err := l.checkNesting(entry.Table, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Roll rolls the table with the given ID, following nested tables, and
+// returns every drop
+func (l *LootTables) Roll(id string, rng *rand.Rand) ([]Drop, error) {
+	var drops []Drop
+	if err := l.roll(id, rng, 0, &drops); err != nil {
+		return nil, err
+	}
+	return drops, nil
+}
+
+func (l *LootTables) roll(id string, rng *rand.Rand, depth int, drops *[]Drop) error {
+	if depth >= maxLootDepth {
+		return fmt.Errorf("loot table %s nests deeper than %d", id, maxLootDepth)
+	}
+	table, exists := l.tables[id]
+	if !exists {
+		return fmt.Errorf("unknown loot table %q", id)
+	}
+
+	for range table.Rolls {
+		entry := table.pick(rng)
+		switch {
+		case entry.Table != "":
+			if err := l.roll(entry.Table, rng, depth+1, drops); err != nil {
+				return err
+			}
+		case entry.Item != "":
+			minQuantity, maxQuantity := entry.quantityRange()
+			quantity := minQuantity + rng.Intn(maxQuantity-minQuantity+1)
+			*drops = append(*drops, Drop{ItemID: entry.Item, Quantity: quantity})
+		}
+	}
+	return nil
+}
+
+// pick chooses an entry with probability proportional to its weight
+func (t LootTable) pick(rng *rand.Rand) LootEntry {
+	total := 0
+	for _, entry := range t.Entries {
+		total += entry.Weight
+	}
+
+	roll := rng.Intn(total)
+	for _, entry := range t.Entries {
+		if roll < entry.Weight {
+			return entry
+		}
+		roll -= entry.Weight
+	}
+	return t.Entries[len(t.Entries)-1]
+}
+
+func (e LootEntry) quantityRange() (int, int) {
+	minQuantity := max(e.MinQuantity, 1)
+	maxQuantity := e.MaxQuantity
+	if maxQuantity == 0 {
+		maxQuantity = minQuantity
+	}
+	return minQuantity, maxQuantity
+}
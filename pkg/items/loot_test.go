@@ -0,0 +1,132 @@
+package items
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestLootDropDistributionMatchesWeights(t *testing.T) {
+	// given
+	// ... a table dropping nothing 50%, potions 30% and nested gear 20%
+	// ... a seeded RNG
+	catalog := testGenerator(t).Catalog()
+	tables := NewLootTables()
+	if err := tables.Add("gear", LootTable{Rolls: 1, Entries: []LootEntry{
+		{Weight: 1, Item: "sword"},
+		{Weight: 1, Item: "helm"},
+	}}); err != nil {
+		t.Fatalf("Failed to add gear table: %v", err)
+	}
+	if err := tables.Add("enemy", LootTable{Rolls: 1, Entries: []LootEntry{
+		{Weight: 50},
+		{Weight: 30, Item: "potion", MinQuantity: 1, MaxQuantity: 3},
+		{Weight: 20, Table: "gear"},
+	}}); err != nil {
+		t.Fatalf("Failed to add enemy table: %v", err)
+	}
+	if err := tables.Validate(catalog); err != nil {
+		t.Fatalf("Expected valid loot tables, got %v", err)
+	}
+	rng := rand.New(rand.NewSource(42))
+	const rolls = 20000
+	// when
+	// ... the table is rolled many times
+	counts := make(map[string]int)
+	for range rolls {
+		drops, err := tables.Roll("enemy", rng)
+		if err != nil {
+			t.Fatalf("Expected roll to succeed, got %v", err)
+		}
+		if len(drops) == 0 {
+			counts["nothing"]++
+		}
+		for _, drop := range drops {
+			counts[drop.ItemID]++
+			if drop.ItemID == "potion" && (drop.Quantity < 1 || drop.Quantity > 3) {
+				t.Fatalf("Expected 1-3 potions, got %d", drop.Quantity)
+			}
+		}
+	}
+	// then
+	// ... each outcome should land within 1.5 percentage points of its weight
+	expected := map[string]float64{
+		"nothing": 0.50,
+		"potion":  0.30,
+		"sword":   0.10,
+		"helm":    0.10,
+	}
+	for outcome, probability := range expected {
+		observed := float64(counts[outcome]) / rolls
+		if math.Abs(observed-probability) > 0.015 {
+			t.Fatalf("Expected %s about %.2f of rolls, got %.3f", outcome, probability, observed)
+		}
+	}
+}
+
+func TestLootRollsAreReproducibleWithSeed(t *testing.T) {
+	// given
+	// ... two RNGs with the same seed
+	tables := NewLootTables()
+	if err := tables.Add("gear", LootTable{Rolls: 1, Entries: []LootEntry{
+		{Weight: 1, Item: "sword"},
+		{Weight: 1, Item: "helm"},
+	}}); err != nil {
+		t.Fatalf("Failed to add gear table: %v", err)
+	}
+	if err := tables.Add("enemy", LootTable{Rolls: 1, Entries: []LootEntry{
+		{Weight: 50},
+		{Weight: 30, Item: "potion", MinQuantity: 1, MaxQuantity: 3},
+		{Weight: 20, Table: "gear"},
+	}}); err != nil {
+		t.Fatalf("Failed to add enemy table: %v", err)
+	}
+	first := rand.New(rand.NewSource(7))
+	second := rand.New(rand.NewSource(7))
+	// when
+	// ... the same table is rolled with each
+	// then
+	// ... should produce identical drops
+	for range 100 {
+		a, _ := tables.Roll("enemy", first)
+		b, _ := tables.Roll("enemy", second)
+		if len(a) != len(b) || (len(a) > 0 && a[0] != b[0]) {
+			t.Fatalf("Expected identical drops, got %v and %v", a, b)
+		}
+	}
+}
+
+func TestLootValidateRejectsCycles(t *testing.T) {
+	// given
+	// ... two tables that nest each other
+	catalog := testGenerator(t).Catalog()
+	tables := NewLootTables()
+	_ = tables.Add("a", LootTable{Rolls: 1, Entries: []LootEntry{{Weight: 1, Table: "b"}}})
+	_ = tables.Add("b", LootTable{Rolls: 1, Entries: []LootEntry{{Weight: 1, Table: "a"}}})
+	// when
+	// ... the tables are validated
+	err := tables.Validate(catalog)
+	// then
+	// ... should report the cycle
+	if err == nil {
+		t.Fatal("Expected error for nested loot table cycle")
+	}
+}
+
+func TestLootValidateRejectsOversizedStacks(t *testing.T) {
+	// given
+	// ... a table dropping up to 20 potions, which stack to 10
+	catalog := testGenerator(t).Catalog()
+	tables := NewLootTables()
+	_ = tables.Add("a", LootTable{Rolls: 1, Entries: []LootEntry{
+		{Weight: 1, Item: "potion", MinQuantity: 5, MaxQuantity: 20},
+	}})
+	// when
+	// ... the tables are validated
+	err := tables.Validate(catalog)
+	// then
+	// ... should reject the quantity range
+	if err == nil {
+		t.Fatal("Expected error for quantity above max stack")
+	}
+}
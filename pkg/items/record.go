@@ -0,0 +1,100 @@
+package items
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ItemRecord is the saved form of an item. Records reference definitions,
+// affixes and uniques by ID and keep rolled values, so an item restored from
+// a record has exactly the modifiers it was saved with.
+type ItemRecord struct {
+	Item      string        `json:"item"`
+	Quantity  int           `json:"quantity"`
+	Rarity    Rarity        `json:"rarity"`
+	ItemLevel int           `json:"item_level"`
+	Name      string        `json:"name,omitempty"`   // Rolled rare name
+	Unique    string        `json:"unique,omitempty"` // Unique definition ID
+	Affixes   []AffixRecord `json:"affixes,omitempty"`
+}
+
+// AffixRecord is the saved form of a rolled affix
+type AffixRecord struct {
+	Affix string  `json:"affix"`
+	Tier  int     `json:"tier"`
+	Value float32 `json:"value"`
+}
+
+// Record returns the saved form of the item
+func (i *Item) Record() ItemRecord {
+	record := ItemRecord{
+		Item:      i.Definition.ID,
+		Quantity:  i.Quantity,
+		Rarity:    i.GetRarity(),
+		ItemLevel: i.ItemLevel,
+		Name:      i.RareName,
+	}
+	if i.Unique != nil {
+		record.Unique = i.Unique.ID
+	}
+	for _, affix := range i.Affixes {
+		record.Affixes = append(record.Affixes, AffixRecord{
+			Affix: affix.Affix.ID,
+			Tier:  affix.Tier,
+			Value: affix.Value,
+		})
+	}
+	return record
+}
+
+// Restore recreates an item from its saved form with a new UID
+func (g *Generator) Restore(record ItemRecord) (*Item, error) {
+	item, err := g.catalog.NewItem(record.Item, record.Quantity)
+	if err != nil {
+		return nil, err
+	}
+	if !IsKnownRarity(record.Rarity) {
+		return nil, fmt.Errorf("item %s: unknown rarity %q", record.Item, record.Rarity)
+	}
+	item.Rarity = record.Rarity
+	item.ItemLevel = record.ItemLevel
+	item.RareName = record.Name
+
+	if record.Unique != "" {
+		unique, exists := g.pool.Unique(record.Unique)
+		if !exists {
+			return nil, fmt.Errorf("item %s: unknown unique %q", record.Item, record.Unique)
+		}
+		item.Unique = unique
+	}
+	for _, affixRecord := range record.Affixes {
+		affix, exists := g.pool.Affix(affixRecord.Affix)
+		if !exists {
+			return nil, fmt.Errorf("item %s: unknown affix %q", record.Item, affixRecord.Affix)
+		}
+		if _, exists := affix.Tier(affixRecord.Tier); !exists {
+			return nil, fmt.Errorf("item %s: affix %s has no tier %d", record.Item, affixRecord.Affix, affixRecord.Tier)
+		}
+		item.Affixes = append(item.Affixes, RolledAffix{
+			Affix: affix,
+			Tier:  affixRecord.Tier,
+			Value: affixRecord.Value,
+		})
+	}
+	return item, nil
+}
+
+// MarshalItem serializes an item to JSON. The same item always produces the
+// same bytes.
+func MarshalItem(item *Item) ([]byte, error) {
+	return json.Marshal(item.Record())
+}
+
+// UnmarshalItem restores an item serialized with MarshalItem
+func (g *Generator) UnmarshalItem(data []byte) (*Item, error) {
+	var record ItemRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return nil, err
+	}
+	return g.Restore(record)
+}
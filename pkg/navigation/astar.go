@@ -0,0 +1,192 @@
+package navigation
+
+import (
+	"container/heap"
+	"math"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+const (
+	straightCost = 1.0
+	diagonalCost = math.Sqrt2
+
+	// snapRadius is how many cells to search for a walkable cell when the
+	// start or goal lies inside a blocked area
+	snapRadius = 4
+)
+
+var neighbourOffsets = [8]Cell{
+	{X: 1, Z: 0}, {X: -1, Z: 0}, {X: 0, Z: 1}, {X: 0, Z: -1},
+	{X: 1, Z: 1}, {X: 1, Z: -1}, {X: -1, Z: 1}, {X: -1, Z: -1},
+}
+
+// FindPath runs A* from start to goal and returns a smoothed list of world
+// waypoints ending at goal. The start position is not included. Returns
+// false if no path exists.
+func (g *Grid) FindPath(start, goal rl.Vector3) ([]rl.Vector3, bool) {
+	startCell := g.WorldToCell(start)
+	goalCell := g.WorldToCell(goal)
+
+	cells, ok := g.findCellPath(startCell, goalCell)
+	if !ok {
+		return nil, false
+	}
+
+	waypoints := make([]rl.Vector3, 0, len(cells)+1)
+	waypoints = append(waypoints, start)
+	for _, c := range cells {
+		if c != startCell {
+			waypoints = append(waypoints, g.CellToWorld(c))
+		}
+	}
+	if !g.IsBlocked(goalCell) {
+		// End exactly on the goal when it is reachable
+		if len(waypoints) > 1 && cells[len(cells)-1] == goalCell {
+			waypoints[len(waypoints)-1] = goal
+		} else {
+			waypoints = append(waypoints, goal)
+		}
+	}
+
+	smoothed := g.SmoothPath(waypoints)
+	return smoothed[1:], true
+}
+
+// SmoothPath removes waypoints that can be skipped by walking in a straight
+// line, keeping the first and last points
+func (g *Grid) SmoothPath(path []rl.Vector3) []rl.Vector3 {
+	if len(path) <= 2 {
+		return path
+	}
+
+	smoothed := []rl.Vector3{path[0]}
+	anchor := path[0]
+	for i := 2; i < len(path); i++ {
+		// Keep the previous point once the anchor can no longer see past it
+		if !g.HasLineOfSight(anchor, path[i]) {
+			anchor = path[i-1]
+			smoothed = append(smoothed, anchor)
+		}
+	}
+
+	return append(smoothed, path[len(path)-1])
+}
+
+// findCellPath runs A* over grid cells using 8-way movement without
+// cutting blocked corners
+func (g *Grid) findCellPath(start, goal Cell) ([]Cell, bool) {
+	start, ok := g.nearestWalkable(start, snapRadius)
+	if !ok {
+		return nil, false
+	}
+	goal, ok = g.nearestWalkable(goal, snapRadius)
+	if !ok {
+		return nil, false
+	}
+	if start == goal {
+		return []Cell{start}, true
+	}
+
+	size := g.Width * g.Height
+	gScore := make([]float32, size)
+	cameFrom := make([]int32, size)
+	closed := make([]bool, size)
+	for i := range gScore {
+		gScore[i] = math.MaxFloat32
+		cameFrom[i] = -1
+	}
+
+	startIndex := g.index(start)
+	goalIndex := g.index(goal)
+	gScore[startIndex] = 0
+
+	open := &nodeHeap{}
+	heap.Push(open, node{index: startIndex, f: octile(start, goal)})
+
+	for open.Len() > 0 {
+		current := heap.Pop(open).(node)
+		if current.index == goalIndex {
+			return g.reconstruct(cameFrom, goalIndex), true
+		}
+		if closed[current.index] {
+			continue
+		}
+		closed[current.index] = true
+
+		currentCell := g.cellAt(current.index)
+		for _, offset := range neighbourOffsets {
+			neighbour := Cell{X: currentCell.X + offset.X, Z: currentCell.Z + offset.Z}
+			if g.IsBlocked(neighbour) {
+				continue
+			}
+
+			cost := float32(straightCost)
+			if offset.X != 0 && offset.Z != 0 {
+				// Diagonal moves need both adjacent cells free
+				if g.IsBlocked(Cell{X: currentCell.X + offset.X, Z: currentCell.Z}) ||
+					g.IsBlocked(Cell{X: currentCell.X, Z: currentCell.Z + offset.Z}) {
+					continue
+				}
+				cost = diagonalCost
+			}
+
+			neighbourIndex := g.index(neighbour)
+			if closed[neighbourIndex] {
+				continue
+			}
+
+			tentative := gScore[current.index] + cost
+			if tentative < gScore[neighbourIndex] {
+				gScore[neighbourIndex] = tentative
+				cameFrom[neighbourIndex] = int32(current.index)
+				heap.Push(open, node{index: neighbourIndex, f: tentative + octile(neighbour, goal)})
+			}
+		}
+	}
+
+	return nil, false
+}
+
+func (g *Grid) reconstruct(cameFrom []int32, goalIndex int) []Cell {
+	path := make([]Cell, 0)
+	for index := int32(goalIndex); index != -1; index = cameFrom[index] {
+		path = append(path, g.cellAt(int(index)))
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// octile is the exact cost between two cells on an open 8-way grid
+func octile(a, b Cell) float32 {
+	dx := float32(abs(a.X - b.X))
+	dz := float32(abs(a.Z - b.Z))
+	return (dx + dz) + (diagonalCost-2*straightCost)*min(dx, dz)
+}
+
+type node struct {
+	index int
+	f     float32
+}
+
+// nodeHeap is a min-heap of open nodes ordered by f score
+type nodeHeap []node
+
+func (h nodeHeap) Len() int           { return len(h) }
+func (h nodeHeap) Less(i, j int) bool { return h[i].f < h[j].f }
+func (h nodeHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *nodeHeap) Push(x any) {
+	*h = append(*h, x.(node))
+}
+
+func (h *nodeHeap) Pop() any {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[:n-1]
+	return item
+}
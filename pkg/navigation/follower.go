@@ -0,0 +1,82 @@
+package navigation
+
+import (
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+const (
+	// DefaultReplanDistance is how far a target may move before the path is re-planned
+	DefaultReplanDistance = 1.0
+
+	arriveTolerance = 0.25
+)
+
+// PathFollower caches an agent's current path and hands out the next
+// waypoint to steer toward, re-planning when the target moves too far
+type PathFollower struct {
+	ReplanDistance float32
+
+	path    []rl.Vector3
+	index   int
+	goal    rl.Vector3
+	planned bool
+}
+
+// NewPathFollower creates a path follower with the default re-plan distance
+func NewPathFollower() *PathFollower {
+	return &PathFollower{
+		ReplanDistance: DefaultReplanDistance,
+	}
+}
+
+// NextWaypoint returns the point the agent at position should steer toward
+// to reach target. With a nil grid, or when the target is in plain sight,
+// the target itself is returned. The second result is false when no path
+// to the target exists.
+func (f *PathFollower) NextWaypoint(grid *Grid, position, target rl.Vector3) (rl.Vector3, bool) {
+	if grid == nil || grid.HasLineOfSight(position, target) {
+		f.Invalidate()
+		return target, true
+	}
+
+	if !f.planned || distanceXZ(f.goal, target) > f.ReplanDistance {
+		path, ok := grid.FindPath(position, target)
+		if !ok {
+			f.Invalidate()
+			return target, false
+		}
+		f.path = path
+		f.index = 0
+		f.goal = target
+		f.planned = true
+	}
+
+	for f.index < len(f.path)-1 && distanceXZ(position, f.path[f.index]) <= arriveTolerance {
+		f.index++
+	}
+	if f.index >= len(f.path) ||
+		(f.index == len(f.path)-1 && distanceXZ(position, f.path[f.index]) <= arriveTolerance) {
+		return target, true
+	}
+
+	return f.path[f.index], true
+}
+
+// Invalidate drops the cached path so the next query re-plans
+func (f *PathFollower) Invalidate() {
+	f.path = nil
+	f.index = 0
+	f.planned = false
+}
+
+// Path returns the remaining waypoints of the cached path
+func (f *PathFollower) Path() []rl.Vector3 {
+	if f.index >= len(f.path) {
+		return nil
+	}
+	return f.path[f.index:]
+}
+
+func distanceXZ(a, b rl.Vector3) float32 {
+	return rl.Vector2Distance(rl.Vector2{X: a.X, Y: a.Z}, rl.Vector2{X: b.X, Y: b.Z})
+}
@@ -0,0 +1,181 @@
+package navigation
+
+import (
+	"math"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// Cell addresses a single square of the navigation grid
+type Cell struct {
+	X int
+	Z int
+}
+
+// Grid is a walkability grid on the XZ plane used for pathfinding
+type Grid struct {
+	OriginX  float32 // World X of the grid's minimum corner
+	OriginZ  float32 // World Z of the grid's minimum corner
+	CellSize float32
+	Width    int
+	Height   int
+	blocked  []bool
+}
+
+// NewGrid creates a fully walkable grid
+func NewGrid(originX, originZ float32, width, height int, cellSize float32) *Grid {
+	return &Grid{
+		OriginX:  originX,
+		OriginZ:  originZ,
+		CellSize: cellSize,
+		Width:    width,
+		Height:   height,
+		blocked:  make([]bool, width*height),
+	}
+}
+
+// NewGridFromObstacles creates a square grid of the given world size centred
+// on the origin, with every obstacle box blocked. Boxes are grown by
+// clearance so agents with that radius do not clip obstacle corners.
+func NewGridFromObstacles(
+	worldSize, cellSize float32,
+	obstacles []rl.BoundingBox,
+	clearance float32,
+) *Grid {
+	cells := int(math.Ceil(float64(worldSize / cellSize)))
+	grid := NewGrid(-worldSize/2, -worldSize/2, cells, cells, cellSize)
+
+	for _, box := range obstacles {
+		grid.BlockBox(box, clearance)
+	}
+
+	return grid
+}
+
+// BlockBox marks every cell overlapped by box, grown by clearance, as blocked
+func (g *Grid) BlockBox(box rl.BoundingBox, clearance float32) {
+	g.setBox(box, clearance, true)
+}
+
+// UnblockBox clears every cell overlapped by box, grown by clearance
+func (g *Grid) UnblockBox(box rl.BoundingBox, clearance float32) {
+	g.setBox(box, clearance, false)
+}
+
+func (g *Grid) setBox(box rl.BoundingBox, clearance float32, blocked bool) {
+	minCell := g.WorldToCell(rl.Vector3{X: box.Min.X - clearance, Z: box.Min.Z - clearance})
+	maxCell := g.WorldToCell(rl.Vector3{X: box.Max.X + clearance, Z: box.Max.Z + clearance})
+
+	for z := minCell.Z; z <= maxCell.Z; z++ {
+		for x := minCell.X; x <= maxCell.X; x++ {
+			g.SetBlocked(Cell{X: x, Z: z}, blocked)
+		}
+	}
+}
+
+// InBounds reports whether the cell lies on the grid
+func (g *Grid) InBounds(c Cell) bool {
+	return c.X >= 0 && c.X < g.Width && c.Z >= 0 && c.Z < g.Height
+}
+
+// SetBlocked marks a cell as blocked or walkable. Out of bounds cells are ignored.
+func (g *Grid) SetBlocked(c Cell, blocked bool) {
+	if !g.InBounds(c) {
+		return
+	}
+	g.blocked[g.index(c)] = blocked
+}
+
+// IsBlocked reports whether a cell cannot be walked. Out of bounds cells are blocked.
+func (g *Grid) IsBlocked(c Cell) bool {
+	if !g.InBounds(c) {
+		return true
+	}
+	return g.blocked[g.index(c)]
+}
+
+// WorldToCell returns the cell containing a world position
+func (g *Grid) WorldToCell(pos rl.Vector3) Cell {
+	return Cell{
+		X: int(math.Floor(float64((pos.X - g.OriginX) / g.CellSize))),
+		Z: int(math.Floor(float64((pos.Z - g.OriginZ) / g.CellSize))),
+	}
+}
+
+// CellToWorld returns the world position of a cell's centre at Y=0
+func (g *Grid) CellToWorld(c Cell) rl.Vector3 {
+	return rl.Vector3{
+		X: g.OriginX + (float32(c.X)+0.5)*g.CellSize,
+		Y: 0,
+		Z: g.OriginZ + (float32(c.Z)+0.5)*g.CellSize,
+	}
+}
+
+// HasLineOfSight reports whether a straight walk from a to b crosses only
+// walkable cells
+func (g *Grid) HasLineOfSight(a, b rl.Vector3) bool {
+	dx := b.X - a.X
+	dz := b.Z - a.Z
+	length := float32(math.Sqrt(float64(dx*dx + dz*dz)))
+
+	step := g.CellSize / 4
+	steps := int(length/step) + 1
+	for i := 0; i <= steps; i++ {
+		t := float32(i) / float32(steps)
+		point := rl.Vector3{X: a.X + dx*t, Z: a.Z + dz*t}
+		if g.IsBlocked(g.WorldToCell(point)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// nearestWalkable returns the closest walkable cell to c, searching outward
+// in rings up to maxRadius cells
+func (g *Grid) nearestWalkable(c Cell, maxRadius int) (Cell, bool) {
+	if !g.IsBlocked(c) {
+		return c, true
+	}
+
+	for radius := 1; radius <= maxRadius; radius++ {
+		best := Cell{}
+		bestDistance := math.MaxInt
+		for z := c.Z - radius; z <= c.Z+radius; z++ {
+			for x := c.X - radius; x <= c.X+radius; x++ {
+				if abs(x-c.X) != radius && abs(z-c.Z) != radius {
+					continue // Only visit the ring's edge
+				}
+				candidate := Cell{X: x, Z: z}
+				if g.IsBlocked(candidate) {
+					continue
+				}
+				distance := (x-c.X)*(x-c.X) + (z-c.Z)*(z-c.Z)
+				if distance < bestDistance {
+					best = candidate
+					bestDistance = distance
+				}
+			}
+		}
+		if bestDistance != math.MaxInt {
+			return best, true
+		}
+	}
+
+	return Cell{}, false
+}
+
+func (g *Grid) index(c Cell) int {
+	return c.Z*g.Width + c.X
+}
+
+func (g *Grid) cellAt(index int) Cell {
+	return Cell{X: index % g.Width, Z: index / g.Width}
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
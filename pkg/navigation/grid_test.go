@@ -0,0 +1,181 @@
+package navigation
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// newWalledGrid returns a 20x20 grid with a vertical wall at X=10 that is
+// open only at the top row
+func newWalledGrid() *Grid {
+	grid := NewGrid(0, 0, 20, 20, 1.0)
+	for z := 0; z < 19; z++ {
+		grid.SetBlocked(Cell{X: 10, Z: z}, true)
+	}
+	return grid
+}
+
+func TestFindPathGoesAroundWall(t *testing.T) {
+	// given
+	// ... a grid with a wall between start and goal
+	grid := newWalledGrid()
+	start := rl.Vector3{X: 5.5, Z: 2.5}
+	goal := rl.Vector3{X: 15.5, Z: 2.5}
+	// when
+	// ... a path is found
+	path, ok := grid.FindPath(start, goal)
+	// then
+	// ... should reach the goal through the gap without crossing the wall
+	if !ok {
+		t.Fatal("Expected a path around the wall")
+	}
+	if path[len(path)-1] != goal {
+		t.Fatalf("Expected path to end at %v, got %v", goal, path[len(path)-1])
+	}
+	previous := start
+	for _, waypoint := range path {
+		if !grid.HasLineOfSight(previous, waypoint) {
+			t.Fatalf("Path segment %v -> %v crosses a blocked cell", previous, waypoint)
+		}
+		previous = waypoint
+	}
+}
+
+func TestFindPathFailsWhenGoalEnclosed(t *testing.T) {
+	// given
+	// ... a goal fully enclosed by a ring of blocked cells
+	grid := NewGrid(0, 0, 20, 20, 1.0)
+	for i := 10; i <= 16; i++ {
+		grid.SetBlocked(Cell{X: i, Z: 10}, true)
+		grid.SetBlocked(Cell{X: i, Z: 16}, true)
+		grid.SetBlocked(Cell{X: 10, Z: i}, true)
+		grid.SetBlocked(Cell{X: 16, Z: i}, true)
+	}
+	// when
+	// ... a path to the enclosed goal is requested
+	_, ok := grid.FindPath(rl.Vector3{X: 2.5, Z: 2.5}, rl.Vector3{X: 13.5, Z: 13.5})
+	// then
+	// ... should report no path
+	if ok {
+		t.Fatal("Expected no path to an enclosed goal")
+	}
+}
+
+func TestFindPathSmoothsOpenGround(t *testing.T) {
+	// given
+	// ... an empty grid
+	grid := NewGrid(0, 0, 20, 20, 1.0)
+	goal := rl.Vector3{X: 17.5, Z: 11.5}
+	// when
+	// ... a path is found across open ground
+	path, ok := grid.FindPath(rl.Vector3{X: 1.5, Z: 1.5}, goal)
+	// then
+	// ... should collapse to a single straight segment
+	if !ok || len(path) != 1 || path[0] != goal {
+		t.Fatalf("Expected a single waypoint at the goal, got %v", path)
+	}
+}
+
+func TestGridFromObstaclesBlocksWithClearance(t *testing.T) {
+	// given
+	// ... a 1x1 obstacle at the origin with 0.5 clearance
+	box := rl.BoundingBox{
+		Min: rl.Vector3{X: -0.5, Y: 0, Z: -0.5},
+		Max: rl.Vector3{X: 0.5, Y: 1, Z: 0.5},
+	}
+	// when
+	// ... the grid is built
+	grid := NewGridFromObstacles(10, 0.5, []rl.BoundingBox{box}, 0.5)
+	// then
+	// ... should block the box and its margin but not beyond
+	if !grid.IsBlocked(grid.WorldToCell(rl.Vector3{})) {
+		t.Fatal("Expected obstacle centre to be blocked")
+	}
+	if !grid.IsBlocked(grid.WorldToCell(rl.Vector3{X: 0.75})) {
+		t.Fatal("Expected clearance margin to be blocked")
+	}
+	if grid.IsBlocked(grid.WorldToCell(rl.Vector3{X: 1.75})) {
+		t.Fatal("Expected cells beyond the clearance to be walkable")
+	}
+}
+
+func TestPathFollowerCachesAndReplans(t *testing.T) {
+	// given
+	// ... a follower behind a wall from its target
+	grid := newWalledGrid()
+	follower := NewPathFollower()
+	position := rl.Vector3{X: 5.5, Z: 2.5}
+	target := rl.Vector3{X: 15.5, Z: 2.5}
+	// when
+	// ... the next waypoint is requested twice with the same target
+	first, ok := follower.NextWaypoint(grid, position, target)
+	cached := follower.Path()
+	second, _ := follower.NextWaypoint(grid, position, target)
+	// then
+	// ... should steer around the wall and reuse the cached path
+	if !ok || first == target {
+		t.Fatalf("Expected an intermediate waypoint, got %v", first)
+	}
+	if second != first || &follower.Path()[0] != &cached[0] {
+		t.Fatal("Expected the cached path to be reused")
+	}
+	// when
+	// ... the target moves further than the re-plan distance
+	follower.NextWaypoint(grid, position, rl.Vector3{X: 15.5, Z: 8.5})
+	// then
+	// ... should plan a new path
+	if &follower.Path()[0] == &cached[0] {
+		t.Fatal("Expected the path to be re-planned")
+	}
+}
+
+func TestPathFollowerSteersDirectlyInLineOfSight(t *testing.T) {
+	// given
+	// ... a follower with a clear view of its target
+	grid := newWalledGrid()
+	follower := NewPathFollower()
+	target := rl.Vector3{X: 5.5, Z: 8.5}
+	// when
+	// ... the next waypoint is requested
+	waypoint, ok := follower.NextWaypoint(grid, rl.Vector3{X: 2.5, Z: 2.5}, target)
+	// then
+	// ... should head straight for the target
+	if !ok || waypoint != target {
+		t.Fatalf("Expected to steer directly to %v, got %v", target, waypoint)
+	}
+}
+
+func BenchmarkFindPathOpen(b *testing.B) {
+	grid := NewGrid(0, 0, 100, 100, 1.0)
+	start := rl.Vector3{X: 0.5, Z: 0.5}
+	goal := rl.Vector3{X: 99.5, Z: 99.5}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		grid.FindPath(start, goal)
+	}
+}
+
+func BenchmarkFindPathMaze(b *testing.B) {
+	// Alternating walls with gaps at opposite ends force a serpentine route
+	grid := NewGrid(0, 0, 100, 100, 1.0)
+	for x := 10; x < 100; x += 10 {
+		gap := 0
+		if (x/10)%2 == 1 {
+			gap = 99
+		}
+		for z := 0; z < 100; z++ {
+			if z != gap {
+				grid.SetBlocked(Cell{X: x, Z: z}, true)
+			}
+		}
+	}
+	start := rl.Vector3{X: 0.5, Z: 50.5}
+	goal := rl.Vector3{X: 99.5, Z: 50.5}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		grid.FindPath(start, goal)
+	}
+}
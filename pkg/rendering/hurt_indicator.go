@@ -0,0 +1,81 @@
+package rendering
+
+import (
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/events"
+)
+
+// Hurt indicator tuning
+const (
+	hurtIntensityPerHit = 0.35 // Intensity added by any damaging hit
+	hurtIntensityScale  = 2.0  // Extra intensity per fraction of max health lost
+	hurtFadeRate        = 1.5  // Intensity lost per second
+	hurtVignetteWidth   = 0.15 // Fraction of the screen the vignette covers at each edge
+)
+
+// HurtIndicator draws a red vignette around the screen that flares when the
+// player takes damage and fades over time. It observes PlayerDamagedEvent.
+type HurtIndicator struct {
+	maxHealth float32
+	intensity float32
+}
+
+// NewHurtIndicator creates an indicator scaling hits against maxHealth
+func NewHurtIndicator(maxHealth float32) *HurtIndicator {
+	return &HurtIndicator{maxHealth: maxHealth}
+}
+
+// SetMaxHealth updates the health that hit sizes are measured against
+func (h *HurtIndicator) SetMaxHealth(maxHealth float32) {
+	h.maxHealth = maxHealth
+}
+
+// OnNotify flares the vignette for player damage events that dealt damage
+func (h *HurtIndicator) OnNotify(event events.Event) error {
+	if event.Type != events.EventTypePlayerDamaged {
+		return nil
+	}
+	damage, ok := event.Data.(events.PlayerDamagedEvent)
+	if !ok || damage.Damage <= 0 {
+		return nil
+	}
+
+	intensity := float32(hurtIntensityPerHit)
+	if h.maxHealth > 0 {
+		intensity += damage.Damage / h.maxHealth * hurtIntensityScale
+	}
+	h.intensity = min(h.intensity+intensity, 1)
+	return nil
+}
+
+// Update fades the vignette
+func (h *HurtIndicator) Update(deltaTime float32) {
+	h.intensity = max(h.intensity-hurtFadeRate*deltaTime, 0)
+}
+
+// Intensity returns the current strength of the vignette from 0 to 1
+func (h *HurtIndicator) Intensity() float32 {
+	return h.intensity
+}
+
+// Reset clears the vignette
+func (h *HurtIndicator) Reset() {
+	h.intensity = 0
+}
+
+// Draw paints the vignette as red gradients fading in from each screen edge
+func (h *HurtIndicator) Draw(width, height int32) {
+	if h.intensity <= 0 {
+		return
+	}
+	edge := rl.Fade(rl.Red, 0.6*h.intensity)
+	clear := rl.Fade(rl.Red, 0)
+	bandX := int32(float32(width) * hurtVignetteWidth)
+	bandY := int32(float32(height) * hurtVignetteWidth)
+
+	rl.DrawRectangleGradientV(0, 0, width, bandY, edge, clear)
+	rl.DrawRectangleGradientV(0, height-bandY, width, bandY, clear, edge)
+	rl.DrawRectangleGradientH(0, 0, bandX, height, edge, clear)
+	rl.DrawRectangleGradientH(width-bandX, 0, bandX, height, clear, edge)
+}
@@ -0,0 +1,88 @@
+package rendering
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/events"
+)
+
+// mockEventBus forwards notifications straight to its subscribers
+type mockEventBus struct {
+	observers map[string][]events.Observer
+}
+
+func (m *mockEventBus) Subscribe(eventType string, observer events.Observer) error {
+	if m.observers == nil {
+		m.observers = make(map[string][]events.Observer)
+	}
+	m.observers[eventType] = append(m.observers[eventType], observer)
+	return nil
+}
+
+func (m *mockEventBus) Unsubscribe(eventType string, observer events.Observer) error {
+	return nil
+}
+
+func (m *mockEventBus) Notify(event events.Event) error {
+	for _, observer := range m.observers[event.Type] {
+		if err := observer.OnNotify(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func playerDamaged(damage float32) events.Event {
+	return events.NewPlayerDamagedEvent(damage, "enemy_1", 100-damage, rl.Vector3{}, events.DamageBreakdown{})
+}
+
+func TestHurtIndicatorFlaresOnPlayerDamage(t *testing.T) {
+	// given
+	// ... a hurt indicator subscribed to a mock event bus
+	bus := &mockEventBus{}
+	indicator := NewHurtIndicator(100.0)
+	if err := bus.Subscribe(events.EventTypePlayerDamaged, indicator); err != nil {
+		t.Fatalf("Failed to subscribe: %v", err)
+	}
+	// when
+	// ... a small and then a large hit are reported
+	bus.Notify(playerDamaged(5.0))
+	small := indicator.Intensity()
+	bus.Notify(playerDamaged(50.0))
+	// then
+	// ... should flare for both and clamp at full intensity
+	if small <= 0 {
+		t.Fatalf("Expected the small hit to flare the indicator, got %f", small)
+	}
+	if indicator.Intensity() != 1.0 {
+		t.Fatalf("Expected full intensity after a large hit, got %f", indicator.Intensity())
+	}
+}
+
+func TestHurtIndicatorFadesAndIgnoresOtherEvents(t *testing.T) {
+	// given
+	// ... a flared hurt indicator
+	indicator := NewHurtIndicator(100.0)
+	indicator.OnNotify(playerDamaged(20.0))
+	flared := indicator.Intensity()
+	// when
+	// ... time passes and an unrelated or zero damage event arrives
+	indicator.Update(0.1)
+	faded := indicator.Intensity()
+	indicator.OnNotify(events.NewPlayerLevelUpEvent(2, 5, 110))
+	indicator.OnNotify(playerDamaged(0))
+	// then
+	// ... should fade over time, ignore the other events and fade out fully
+	if faded >= flared {
+		t.Fatalf("Expected the indicator to fade from %f, got %f", flared, faded)
+	}
+	if indicator.Intensity() != faded {
+		t.Fatalf("Expected other events to be ignored, got %f", indicator.Intensity())
+	}
+	indicator.Update(10.0)
+	if indicator.Intensity() != 0 {
+		t.Fatalf("Expected the indicator to fade out, got %f", indicator.Intensity())
+	}
+}
@@ -10,8 +10,29 @@ import (
 	"arpg/pkg/entities"
 )
 
+// statusTintStrength is how far an affected entity's colour shifts toward
+// its status effect colour
+const statusTintStrength = 0.5
+
+// damagedObstacleDarken is the brightness change of damaged obstacles
+const damagedObstacleDarken = -0.4
+
+// Pickups blink every pickupBlinkInterval seconds once they have less than
+// pickupBlinkTime seconds left before despawning
+const (
+	pickupBlinkTime     = 3.0
+	pickupBlinkInterval = 0.2
+)
+
+// Player opacity while dodging and on the hidden frames of the post-hit flash
+const (
+	dodgeFade = 0.4
+	flashFade = 0.15
+)
+
 type Renderer struct {
-	config *config.Config
+	config  *config.Config
+	terrain terrainModel
 }
 
 func NewRenderer(cfg *config.Config) *Renderer {
@@ -33,6 +54,7 @@ func (r *Renderer) Initialize() {
 }
 
 func (r *Renderer) Shutdown() {
+	r.unloadTerrain()
 	rl.CloseWindow()
 }
 
@@ -68,16 +90,107 @@ func (r *Renderer) DrawGrid() {
 	}
 }
 
-func (r *Renderer) DrawHealthPickups(healthPickups []*entities.HealthPickup) {
-	for _, pickup := range healthPickups {
-		if pickup.IsActive() {
-			rl.DrawSphere(pickup.Position, 0.3, rl.Green)
+// DrawWorld draws every entity in the world
+func (r *Renderer) DrawWorld(world *entities.World) {
+	r.DrawHazards(entities.OfType[*entities.HazardZone](world))
+	r.DrawSpawners(entities.OfType[*entities.Spawner](world))
+	r.DrawObstacles(entities.OfType[*entities.Obstacle](world))
+	if player := world.Player(); player != nil {
+		r.DrawPlayer(player)
+	}
+	r.DrawEnemies(entities.OfType[*entities.Enemy](world))
+	r.DrawBosses(entities.OfType[*entities.Boss](world))
+	r.DrawBullets(entities.OfType[*entities.Bullet](world))
+	r.DrawPickups(entities.OfType[*entities.Pickup](world))
+	r.DrawItemDrops(entities.OfType[*entities.ItemDrop](world))
+}
+
+// DrawPickups draws each pickup with a shape for its type, blinking the ones
+// about to despawn
+func (r *Renderer) DrawPickups(pickups []*entities.Pickup) {
+	for _, pickup := range pickups {
+		if !pickup.IsActive() {
+			continue
+		}
+		if pickup.Expiring(pickupBlinkTime) && int(rl.GetTime()/pickupBlinkInterval)%2 == 0 {
+			continue
+		}
+
+		pos := pickup.Position
+		radius := pickup.Radius
+		switch pickup.Definition.Type {
+		case entities.PickupAmmo:
+			rl.DrawCube(pos, radius*1.6, radius*1.6, radius*1.6, pickup.Color)
+			rl.DrawCubeWires(pos, radius*1.6, radius*1.6, radius*1.6, rl.DarkGray)
+		case entities.PickupGold:
+			base := rl.Vector3{X: pos.X, Y: pos.Y - radius*0.15, Z: pos.Z}
+			rl.DrawCylinder(base, radius, radius, radius*0.3, 12, pickup.Color)
+		case entities.PickupSpeedBuff, entities.PickupDamageBuff:
+			rl.DrawSphere(pos, radius*0.6, pickup.Color)
+			rl.DrawSphereWires(pos, radius, 6, 6, pickup.Color)
+		case entities.PickupXP:
+			rl.DrawSphere(pos, radius*0.5, pickup.Color)
+			rl.DrawSphere(pos, radius, rl.Fade(pickup.Color, 0.3))
+		default:
+			rl.DrawSphere(pos, radius, pickup.Color)
+		}
+	}
+}
+
+// DrawItemDrops draws items lying in the world as small cubes
+func (r *Renderer) DrawItemDrops(drops []*entities.ItemDrop) {
+	for _, drop := range drops {
+		if drop.IsActive() {
+			size := drop.Radius * 2
+			rl.DrawCube(drop.Position, size, size, size, drop.Color)
+			rl.DrawCubeWires(drop.Position, size, size, size, rl.DarkBrown)
+		}
+	}
+}
+
+// DrawSpawners marks each spawner with a ring on the ground, brighter once
+// it has started spawning
+func (r *Renderer) DrawSpawners(spawners []*entities.Spawner) {
+	for _, spawner := range spawners {
+		if !spawner.IsActive() {
+			continue
+		}
+		alpha := float32(0.25)
+		if spawner.IsActivated() {
+			alpha = 0.6
+		}
+		radius := max(spawner.SpawnRadius, 0.8)
+		rl.DrawCylinderWires(spawner.Position, radius, radius, 0.05, 16, rl.Fade(rl.DarkPurple, alpha))
+	}
+}
+
+// DrawHazards draws hazard zones as translucent discs on the ground
+func (r *Renderer) DrawHazards(hazards []*entities.HazardZone) {
+	for _, hazard := range hazards {
+		if hazard.IsActive() {
+			rl.DrawCylinder(hazard.Position, hazard.Radius, hazard.Radius, hazard.Height, 16, rl.Fade(hazard.Color, 0.4))
 		}
 	}
 }
 
+// statusTint blends base toward the colour of the most recent status effect
+func statusTint(status *entities.StatusComponent, base rl.Color) rl.Color {
+	if len(status.Effects) == 0 {
+		return base
+	}
+	latest := status.Effects[len(status.Effects)-1]
+	return rl.ColorLerp(base, entities.StatusColor(latest.Type), statusTintStrength)
+}
+
 func (r *Renderer) DrawPlayer(player *entities.Player) {
-	rl.DrawCylinder(player.Position, player.Radius, player.Radius, player.Height, 8, rl.Blue)
+	color := statusTint(&player.StatusComponent, player.Color)
+	switch {
+	case player.IsFlashing():
+		color = rl.Fade(color, flashFade)
+	case player.IsDodging():
+		color = rl.Fade(color, dodgeFade)
+	}
+	rl.DrawCylinder(player.Position, player.Radius, player.Radius, player.Height, 8, color)
 
 	headPos := rl.Vector3{
 		X: player.Position.X,
@@ -112,7 +225,7 @@ func (r *Renderer) drawGun(player *entities.Player) {
 func (r *Renderer) DrawBullets(bullets []*entities.Bullet) {
 	for _, bullet := range bullets {
 		if bullet.Active {
-			rl.DrawSphere(bullet.Position, bullet.Radius, rl.Yellow)
+			rl.DrawSphere(bullet.Position, bullet.Radius, bullet.Color)
 		}
 	}
 }
@@ -120,7 +233,8 @@ func (r *Renderer) DrawBullets(bullets []*entities.Bullet) {
 func (r *Renderer) DrawEnemies(enemies []*entities.Enemy) {
 	for _, enemy := range enemies {
 		if enemy.IsAlive() {
-			rl.DrawCylinder(enemy.Position, enemy.Radius, enemy.Radius, enemy.Height, 8, rl.Red)
+			color := statusTint(&enemy.StatusComponent, enemy.Color)
+			rl.DrawCylinder(enemy.Position, enemy.Radius, enemy.Radius, enemy.Height, 8, color)
 
 			headPos := enemy.GetHeadPosition()
 			rl.DrawSphere(headPos, 0.3, rl.Black)
@@ -128,11 +242,72 @@ func (r *Renderer) DrawEnemies(enemies []*entities.Enemy) {
 	}
 }
 
+// telegraphStep is the spacing of the discs marking a charge's path
+const telegraphStep = 0.5
+
+// DrawBosses draws each boss and the ground warning for its next attack,
+// which fills in as the attack approaches
+func (r *Renderer) DrawBosses(bosses []*entities.Boss) {
+	for _, boss := range bosses {
+		if !boss.IsAlive() {
+			continue
+		}
+		if telegraph := boss.Telegraph(); telegraph != nil {
+			r.drawTelegraph(telegraph)
+		}
+
+		color := statusTint(&boss.StatusComponent, boss.Color)
+		if boss.IsCharging() {
+			color = rl.ColorBrightness(color, 0.3)
+		}
+		rl.DrawCylinder(boss.Position, boss.Radius, boss.Radius, boss.Height, 12, color)
+		rl.DrawCylinderWires(boss.Position, boss.Radius, boss.Radius, boss.Height, 12, rl.Black)
+		rl.DrawSphere(boss.GetHeadPosition(), 0.4, rl.Black)
+	}
+}
+
+func (r *Renderer) drawTelegraph(telegraph *entities.BossTelegraph) {
+	progress := telegraph.Progress()
+	warning := rl.Fade(rl.Red, 0.2+0.4*progress)
+	base := telegraph.Origin
+	base.Y += 0.02
+
+	switch telegraph.Attack {
+	case entities.BossAttackCharge:
+		for distance := float32(0); distance <= telegraph.Length; distance += telegraphStep {
+			center := rl.Vector3Add(base, rl.Vector3Scale(telegraph.Direction, distance))
+			rl.DrawCylinder(center, telegraph.Radius, telegraph.Radius, 0.02, 12, rl.Fade(rl.Red, 0.15))
+		}
+		filled := telegraph.Length * progress
+		end := rl.Vector3Add(base, rl.Vector3Scale(telegraph.Direction, filled))
+		rl.DrawCylinder(end, telegraph.Radius, telegraph.Radius, 0.04, 12, warning)
+	case entities.BossAttackBurst:
+		rl.DrawCylinderWires(base, telegraph.Radius, telegraph.Radius, 0.05, 24, rl.Red)
+		inner := telegraph.Radius * progress
+		rl.DrawCylinder(base, inner, inner, 0.03, 24, warning)
+	case entities.BossAttackSummon:
+		for _, point := range telegraph.Points {
+			point.Y += 0.02
+			rl.DrawCylinderWires(point, telegraph.Radius, telegraph.Radius, 0.05, 12, rl.DarkPurple)
+			inner := telegraph.Radius * progress
+			rl.DrawCylinder(point, inner, inner, 0.03, 12, rl.Fade(rl.Purple, 0.5))
+		}
+	}
+}
+
+// DrawObstacles draws obstacles, darkening damaged destructible ones and
+// outlining them so the damage reads even without wireframes
 func (r *Renderer) DrawObstacles(obstacles []*entities.Obstacle) {
 	for _, obstacle := range obstacles {
 		if !obstacle.Active {
 			continue
 		}
+		color := obstacle.Color
+		damaged := obstacle.IsDamaged()
+		if damaged {
+			color = rl.ColorBrightness(color, damagedObstacleDarken)
+		}
+
 		switch obstacle.Type {
 		case entities.ObstacleTypeBox:
 			rl.DrawCube(
@@ -140,9 +315,9 @@ func (r *Renderer) DrawObstacles(obstacles []*entities.Obstacle) {
 				obstacle.Size.X,
 				obstacle.Size.Y,
 				obstacle.Size.Z,
-				obstacle.Color,
+				color,
 			)
-			if r.config.Graphics.DrawWires {
+			if r.config.Graphics.DrawWires || damaged {
 				rl.DrawCubeWires(
 					obstacle.Position,
 					obstacle.Size.X,
@@ -158,9 +333,9 @@ func (r *Renderer) DrawObstacles(obstacles []*entities.Obstacle) {
 				obstacle.Radius,
 				obstacle.Height,
 				8,
-				obstacle.Color,
+				color,
 			)
-			if r.config.Graphics.DrawWires {
+			if r.config.Graphics.DrawWires || damaged {
 				rl.DrawCylinderWires(
 					obstacle.Position,
 					obstacle.Radius,
@@ -217,6 +392,10 @@ func (r *Renderer) DrawEnemyHealthBars(enemies []*entities.Enemy, cam *camera.Ca
 				int32(barHeight),
 				rl.Black,
 			)
+
+			if enemy.State == entities.AIStateAlert {
+				rl.DrawText("!", int32(screenPos.X)-3, int32(screenPos.Y)-24, 20, rl.Orange)
+			}
 		}
 	}
 }
@@ -224,7 +403,7 @@ func (r *Renderer) DrawEnemyHealthBars(enemies []*entities.Enemy, cam *camera.Ca
 func (r *Renderer) DrawUI() {
 	rl.DrawText("WASD to move", 10, 10, 20, rl.DarkGray)
 	rl.DrawText("Mouse to aim", 10, 35, 20, rl.DarkGray)
-	rl.DrawText("Left click to shoot", 10, 60, 20, rl.DarkGray)
+	rl.DrawText("Hold left click to shoot", 10, 60, 20, rl.DarkGray)
 
 	if r.config.Debug.ShowFPS {
 		rl.DrawFPS(10, 90)
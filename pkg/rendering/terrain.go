@@ -0,0 +1,57 @@
+package rendering
+
+import (
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/terrain"
+)
+
+// Terrain colours; the wireframe over the surface shows its relief
+var (
+	terrainColor     = rl.NewColor(196, 186, 150, 255)
+	terrainWireColor = rl.NewColor(127, 106, 79, 64)
+)
+
+// terrainModel is the mesh uploaded for a heightmap, rebuilt when the
+// heightmap changes
+type terrainModel struct {
+	source *terrain.Heightmap
+	model  rl.Model
+}
+
+// DrawTerrain draws heightmap terrain in place of the flat ground. The mesh
+// is generated from the heightmap's samples the first time it is drawn.
+func (r *Renderer) DrawTerrain(heightmap *terrain.Heightmap) {
+	if r.terrain.source != heightmap {
+		r.unloadTerrain()
+		r.terrain = terrainModel{source: heightmap, model: buildTerrainModel(heightmap)}
+	}
+
+	// Raylib lays the mesh out from its minimum corner
+	position := rl.Vector3{X: -heightmap.Size.X / 2, Z: -heightmap.Size.Z / 2}
+	rl.DrawModel(r.terrain.model, position, 1, terrainColor)
+	rl.DrawModelWires(r.terrain.model, position, 1, terrainWireColor)
+}
+
+// unloadTerrain frees the uploaded terrain mesh, if any
+func (r *Renderer) unloadTerrain() {
+	if r.terrain.source == nil {
+		return
+	}
+	rl.UnloadModel(r.terrain.model)
+	r.terrain = terrainModel{}
+}
+
+// buildTerrainModel uploads a mesh for the heightmap via a grayscale image
+// of its samples
+func buildTerrainModel(heightmap *terrain.Heightmap) rl.Model {
+	columns, rows := heightmap.Resolution()
+	pixels := make([]byte, columns*rows)
+	for row := range rows {
+		for column := range columns {
+			pixels[row*columns+column] = byte(heightmap.Sample(column, row)*255 + 0.5)
+		}
+	}
+	image := rl.NewImage(pixels, int32(columns), int32(rows), 1, rl.UncompressedGrayscale)
+	return rl.LoadModelFromMesh(rl.GenMeshHeightmap(*image, heightmap.Size))
+}
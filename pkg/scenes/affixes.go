@@ -0,0 +1,207 @@
+package scenes
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"arpg/pkg/entities"
+	"arpg/pkg/items"
+)
+
+// AffixTierData describes one strength band of an affix in JSON
+type AffixTierData struct {
+	Tier         int     `json:"tier"`
+	MinItemLevel int     `json:"min_item_level,omitempty"`
+	Min          float32 `json:"min"`
+	Max          float32 `json:"max"`
+	Weight       int     `json:"weight"`
+}
+
+// AffixData describes a random item modifier in JSON
+type AffixData struct {
+	Name  string          `json:"name"`
+	Type  string          `json:"type"`            // "prefix" or "suffix"
+	Group string          `json:"group,omitempty"` // Defaults to the affix ID
+	Stat  string          `json:"stat"`
+	Kind  string          `json:"kind,omitempty"`  // "flat" (default), "increased" or "more"
+	Slots []string        `json:"slots,omitempty"` // Empty allows every slot
+	Tiers []AffixTierData `json:"tiers"`
+}
+
+// UniqueData describes a unique item with fixed modifiers in JSON
+type UniqueData struct {
+	Name         string             `json:"name"`
+	Base         string             `json:"base"` // Item definition ID
+	MinItemLevel int                `json:"min_item_level,omitempty"`
+	Modifiers    []ItemModifierData `json:"modifiers"`
+}
+
+// AffixPoolData holds every affix, unique and rarity weight in JSON
+type AffixPoolData struct {
+	Affixes       map[string]AffixData  `json:"affixes"`
+	Uniques       map[string]UniqueData `json:"uniques,omitempty"`
+	RarityWeights map[string]int        `json:"rarity_weights,omitempty"` // Defaults to items.DefaultRarityWeights
+}
+
+// LoadItemGeneratorFromJSON loads an affix file and creates a generator
+// rolling items from catalog
+func LoadItemGeneratorFromJSON(filename string, catalog *items.Catalog) (*items.Generator, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var poolData AffixPoolData
+	if err := json.Unmarshal(data, &poolData); err != nil {
+		return nil, err
+	}
+
+	return poolData.ToGenerator(catalog)
+}
+
+// ToGenerator validates the affixes, uniques and weights and builds a generator
+func (d AffixPoolData) ToGenerator(catalog *items.Catalog) (*items.Generator, error) {
+	pool := items.NewAffixPool()
+
+	for _, id := range sortedKeys(d.Affixes) {
+		affixData := d.Affixes[id]
+		if err := affixData.Validate(); err != nil {
+			return nil, fmt.Errorf("affix %s: %w", id, err)
+		}
+		if err := pool.AddAffix(affixData.ToAffix(id)); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, id := range sortedKeys(d.Uniques) {
+		uniqueData := d.Uniques[id]
+		if err := uniqueData.Validate(catalog); err != nil {
+			return nil, fmt.Errorf("unique %s: %w", id, err)
+		}
+		if err := pool.AddUnique(uniqueData.ToUnique(id)); err != nil {
+			return nil, err
+		}
+	}
+
+	generator := items.NewGenerator(catalog, pool)
+	if len(d.RarityWeights) > 0 {
+		weights := make(map[items.Rarity]int, len(d.RarityWeights))
+		for rarity, weight := range d.RarityWeights {
+			if !items.IsKnownRarity(items.Rarity(rarity)) {
+				return nil, fmt.Errorf("unknown rarity %q", rarity)
+			}
+			if weight < 0 {
+				return nil, fmt.Errorf("rarity %s: weight cannot be negative, got %d", rarity, weight)
+			}
+			weights[items.Rarity(rarity)] = weight
+		}
+		generator.RarityWeights = weights
+	}
+	return generator, nil
+}
+
+// ToAffix converts affix data to an affix definition
+func (d AffixData) ToAffix(id string) items.AffixDefinition {
+	affix := items.AffixDefinition{
+		ID:    id,
+		Name:  d.Name,
+		Type:  items.AffixType(d.Type),
+		Group: d.Group,
+		Stat:  d.Stat,
+		Kind:  modifierKindOrFlat(d.Kind),
+	}
+	for _, slot := range d.Slots {
+		affix.Slots = append(affix.Slots, items.Slot(slot))
+	}
+	for _, tier := range d.Tiers {
+		affix.Tiers = append(affix.Tiers, items.AffixTier{
+			Tier:         tier.Tier,
+			MinItemLevel: tier.MinItemLevel,
+			Min:          tier.Min,
+			Max:          tier.Max,
+			Weight:       tier.Weight,
+		})
+	}
+	return affix
+}
+
+// Validate checks the affix names a known stat, kind and equipment slots
+func (d AffixData) Validate() error {
+	if d.Name == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if !entities.IsKnownStat(entities.StatType(d.Stat)) {
+		return fmt.Errorf("unknown stat %q", d.Stat)
+	}
+	if d.Kind != "" && !items.IsKnownModifierKind(items.ModifierKind(d.Kind)) {
+		return fmt.Errorf("unknown modifier kind %q", d.Kind)
+	}
+	for _, slot := range d.Slots {
+		if slot == "" || !items.IsKnownSlot(items.Slot(slot)) {
+			return fmt.Errorf("unknown slot %q", slot)
+		}
+	}
+	return nil
+}
+
+// ToUnique converts unique data to a unique definition
+func (d UniqueData) ToUnique(id string) items.UniqueDefinition {
+	unique := items.UniqueDefinition{
+		ID:           id,
+		Name:         d.Name,
+		Base:         d.Base,
+		MinItemLevel: d.MinItemLevel,
+	}
+	for _, modifier := range d.Modifiers {
+		unique.Modifiers = append(unique.Modifiers, items.StatModifier{
+			Stat:  modifier.Stat,
+			Kind:  modifierKindOrFlat(modifier.Kind),
+			Value: modifier.Value,
+		})
+	}
+	return unique
+}
+
+// Validate checks the unique is built on an equippable item and grants
+// known stats
+func (d UniqueData) Validate(catalog *items.Catalog) error {
+	if d.Name == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	base, exists := catalog.Get(d.Base)
+	if !exists {
+		return fmt.Errorf("unknown base item %q", d.Base)
+	}
+	if !base.Equippable() {
+		return fmt.Errorf("base item %s cannot be equipped", d.Base)
+	}
+	for _, modifier := range d.Modifiers {
+		if !entities.IsKnownStat(entities.StatType(modifier.Stat)) {
+			return fmt.Errorf("unknown stat %q", modifier.Stat)
+		}
+		if modifier.Kind != "" && !items.IsKnownModifierKind(items.ModifierKind(modifier.Kind)) {
+			return fmt.Errorf("unknown modifier kind %q", modifier.Kind)
+		}
+	}
+	return nil
+}
+
+// modifierKindOrFlat converts a JSON modifier kind, defaulting to flat
+func modifierKindOrFlat(kind string) items.ModifierKind {
+	if kind == "" {
+		return items.ModifierFlat
+	}
+	return items.ModifierKind(kind)
+}
+
+// sortedKeys returns the keys of m in sorted order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
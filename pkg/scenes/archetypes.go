@@ -0,0 +1,225 @@
+package scenes
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+
+	"arpg/pkg/entities"
+)
+
+// EnemyArchetype describes a reusable enemy template in JSON
+type EnemyArchetype struct {
+	Health         float32 `json:"health"`
+	Speed          float32 `json:"speed"`
+	Radius         float32 `json:"radius"`
+	Height         float32 `json:"height"`
+	Color          string  `json:"color"`
+	Damage         float32 `json:"damage"`
+	AttackCooldown float32 `json:"attack_cooldown"`
+	Behavior       string  `json:"behavior"`
+	XPValue        float32 `json:"xp_value"`
+	LootTable      string  `json:"loot_table,omitempty"` // Rolled when the enemy dies
+
+	AggroRadius       float32 `json:"aggro_radius"`
+	LeashDistance     float32 `json:"leash_distance"`
+	FleeHealthPercent float32 `json:"flee_health_percent"`
+
+	AttackRange     float32 `json:"attack_range,omitempty"`
+	ProjectileSpeed float32 `json:"projectile_speed,omitempty"`
+
+	DamageType string             `json:"damage_type,omitempty"`
+	OnHit      []StatusEffectData `json:"on_hit,omitempty"` // Status effects applied by attacks
+	DefenseData
+}
+
+// ArchetypeCatalog holds every enemy archetype keyed by name
+type ArchetypeCatalog struct {
+	Archetypes map[string]EnemyArchetype `json:"archetypes"`
+}
+
+// NewArchetypeCatalog creates an empty archetype catalog
+func NewArchetypeCatalog() *ArchetypeCatalog {
+	return &ArchetypeCatalog{
+		Archetypes: make(map[string]EnemyArchetype),
+	}
+}
+
+// LoadArchetypesFromJSON loads and validates an archetype catalog file
+func LoadArchetypesFromJSON(filename string) (*ArchetypeCatalog, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	catalog := NewArchetypeCatalog()
+	if err := json.Unmarshal(data, catalog); err != nil {
+		return nil, err
+	}
+
+	if err := catalog.Validate(); err != nil {
+		return nil, err
+	}
+
+	return catalog, nil
+}
+
+// Get returns the archetype with the given name
+func (c *ArchetypeCatalog) Get(name string) (EnemyArchetype, bool) {
+	archetype, exists := c.Archetypes[name]
+	return archetype, exists
+}
+
+// Validate checks every archetype in the catalog for correctness
+func (c *ArchetypeCatalog) Validate() error {
+	for name, archetype := range c.Archetypes {
+		if archetype.Health <= 0 {
+			return fmt.Errorf("archetype %s: health must be positive, got %f", name, archetype.Health)
+		}
+		if archetype.Speed < 0 {
+			return fmt.Errorf("archetype %s: speed cannot be negative, got %f", name, archetype.Speed)
+		}
+		if archetype.Radius <= 0 {
+			return fmt.Errorf("archetype %s: radius must be positive, got %f", name, archetype.Radius)
+		}
+		if archetype.Height <= 0 {
+			return fmt.Errorf("archetype %s: height must be positive, got %f", name, archetype.Height)
+		}
+		if archetype.Damage < 0 {
+			return fmt.Errorf("archetype %s: damage cannot be negative, got %f", name, archetype.Damage)
+		}
+		if archetype.AttackCooldown < 0 {
+			return fmt.Errorf("archetype %s: attack cooldown cannot be negative, got %f",
+				name, archetype.AttackCooldown)
+		}
+		if !entities.IsKnownBehavior(archetype.Behavior) {
+			return fmt.Errorf("archetype %s: unknown behavior %q", name, archetype.Behavior)
+		}
+		if archetype.XPValue < 0 {
+			return fmt.Errorf("archetype %s: xp value cannot be negative, got %f", name, archetype.XPValue)
+		}
+		if archetype.AggroRadius <= 0 {
+			return fmt.Errorf("archetype %s: aggro radius must be positive, got %f", name, archetype.AggroRadius)
+		}
+		if archetype.LeashDistance < 0 {
+			return fmt.Errorf("archetype %s: leash distance cannot be negative, got %f",
+				name, archetype.LeashDistance)
+		}
+		if archetype.FleeHealthPercent < 0 || archetype.FleeHealthPercent >= 1 {
+			return fmt.Errorf("archetype %s: flee health percent must be in [0, 1), got %f",
+				name, archetype.FleeHealthPercent)
+		}
+		if archetype.AttackRange < 0 {
+			return fmt.Errorf("archetype %s: attack range cannot be negative, got %f", name, archetype.AttackRange)
+		}
+		if archetype.Behavior == entities.BehaviorRanged && archetype.ProjectileSpeed <= 0 {
+			return fmt.Errorf("archetype %s: ranged behavior requires a positive projectile speed", name)
+		}
+		if archetype.DamageType != "" && !entities.IsKnownDamageType(entities.DamageType(archetype.DamageType)) {
+			return fmt.Errorf("archetype %s: unknown damage type %q", name, archetype.DamageType)
+		}
+		if err := archetype.DefenseData.Validate(); err != nil {
+			return fmt.Errorf("archetype %s: %w", name, err)
+		}
+		for _, effect := range archetype.OnHit {
+			if err := effect.Validate(); err != nil {
+				return fmt.Errorf("archetype %s: %w", name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// defaultArchetype mirrors the stats of an enemy built with entities.NewEnemy
+func defaultArchetype() EnemyArchetype {
+	return EnemyArchetype{
+		Radius:         0.6,
+		Height:         1.5,
+		Color:          "red",
+		Damage:         10.0,
+		AttackCooldown: 1.0,
+		Behavior:       entities.BehaviorChase,
+		AggroRadius:    entities.DefaultAggroRadius,
+		LeashDistance:  entities.DefaultLeashDistance,
+		AttackRange:    entities.DefaultAttackRange,
+		DamageType:     string(entities.DamagePhysical),
+	}
+}
+
+// Resolve combines an enemy's archetype with its per-instance overrides.
+// Zero-valued fields on the enemy data inherit from the archetype.
+func (c *ArchetypeCatalog) Resolve(data EnemyData) (EnemyArchetype, error) {
+	resolved := defaultArchetype()
+	if data.Archetype != "" {
+		archetype, exists := c.Get(data.Archetype)
+		if !exists {
+			return EnemyArchetype{}, fmt.Errorf("enemy %s: unknown archetype %q", data.ID, data.Archetype)
+		}
+		resolved = archetype
+	}
+
+	if data.Health > 0 {
+		resolved.Health = data.Health
+	}
+	if data.Speed > 0 {
+		resolved.Speed = data.Speed
+	}
+	if data.Radius > 0 {
+		resolved.Radius = data.Radius
+	}
+	if data.Height > 0 {
+		resolved.Height = data.Height
+	}
+	if data.Color != "" {
+		resolved.Color = data.Color
+	}
+	if data.Damage > 0 {
+		resolved.Damage = data.Damage
+	}
+	if data.AttackCooldown > 0 {
+		resolved.AttackCooldown = data.AttackCooldown
+	}
+	if data.Behavior != "" {
+		resolved.Behavior = data.Behavior
+	}
+	if data.XPValue > 0 {
+		resolved.XPValue = data.XPValue
+	}
+	if data.LootTable != "" {
+		resolved.LootTable = data.LootTable
+	}
+	if data.AggroRadius > 0 {
+		resolved.AggroRadius = data.AggroRadius
+	}
+	if data.LeashDistance > 0 {
+		resolved.LeashDistance = data.LeashDistance
+	}
+	if data.AttackRange > 0 {
+		resolved.AttackRange = data.AttackRange
+	}
+	if resolved.AttackRange == 0 {
+		resolved.AttackRange = entities.DefaultAttackRange
+	}
+	if data.DamageType != "" {
+		resolved.DamageType = data.DamageType
+	}
+	if resolved.DamageType == "" {
+		resolved.DamageType = string(entities.DamagePhysical)
+	}
+	if len(data.OnHit) > 0 {
+		resolved.OnHit = data.OnHit
+	}
+	if data.Armor > 0 {
+		resolved.Armor = data.Armor
+	}
+	if len(data.Resistances) > 0 {
+		resistances := make(map[string]float32, len(resolved.Resistances)+len(data.Resistances))
+		maps.Copy(resistances, resolved.Resistances)
+		maps.Copy(resistances, data.Resistances)
+		resolved.Resistances = resistances
+	}
+
+	return resolved, nil
+}
@@ -0,0 +1,227 @@
+package scenes
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/entities"
+)
+
+// testArchetypes returns a catalog holding a single grunt archetype
+func testArchetypes() *ArchetypeCatalog {
+	catalog := NewArchetypeCatalog()
+	catalog.Archetypes["grunt"] = EnemyArchetype{
+		Health:         50.0,
+		Speed:          2.0,
+		Radius:         0.6,
+		Height:         1.5,
+		Color:          "red",
+		Damage:         10.0,
+		AttackCooldown: 1.0,
+		Behavior:       entities.BehaviorChase,
+		XPValue:        10.0,
+		AggroRadius:    8.0,
+		LeashDistance:  15.0,
+		DefenseData: DefenseData{
+			Armor:       20.0,
+			Resistances: map[string]float32{"fire": 0.5},
+		},
+	}
+	return catalog
+}
+
+func TestValidateSceneDataRejectsUnknownArchetype(t *testing.T) {
+	// given
+	// ... a scene builder with a catalog containing only "grunt"
+	// ... a scene referencing a "dragon" archetype
+	builder := NewSceneBuilder()
+	builder.SetArchetypes(testArchetypes())
+	data := &SceneData{Player: PlayerData{Speed: 5, Health: 100, MaxHealth: 100}}
+	data.Entities.Enemies = []EnemyData{{ID: "enemy_1", Archetype: "dragon"}}
+	// when
+	// ... the scene data is validated
+	err := builder.ValidateSceneData(data)
+	// then
+	// ... should fail because the archetype does not exist
+	if err == nil {
+		t.Fatal("Expected error for unknown archetype")
+	}
+}
+
+func TestBuildEnemiesAppliesArchetypeAndOverrides(t *testing.T) {
+	// given
+	// ... a scene builder with a "grunt" archetype
+	// ... an enemy that overrides health and colour
+	builder := NewSceneBuilder()
+	builder.SetArchetypes(testArchetypes())
+	data := []EnemyData{{
+		ID:        "enemy_1",
+		Archetype: "grunt",
+		Position:  Vector3Data{X: 3, Y: 0, Z: 4},
+		Health:    80.0,
+		Color:     "blue",
+	}}
+	// when
+	// ... the enemies are built
+	enemies := builder.BuildEnemies(data, nil)
+	// then
+	// ... should inherit archetype stats
+	// ... and should apply the per-instance overrides
+	if len(enemies) != 1 {
+		t.Fatalf("Expected 1 enemy, got %d", len(enemies))
+	}
+	enemy := enemies[0]
+	if enemy.ID != "enemy_1" || enemy.Archetype != "grunt" {
+		t.Fatalf("Expected enemy_1 of archetype grunt, got %s/%s", enemy.ID, enemy.Archetype)
+	}
+	if enemy.Health != 80.0 || enemy.MaxHealth != 80.0 {
+		t.Fatalf("Expected overridden health 80.0, got %f/%f", enemy.Health, enemy.MaxHealth)
+	}
+	if enemy.Speed != 2.0 || enemy.Damage != 10.0 || enemy.AttackInterval != 1.0 || enemy.XPValue != 10.0 {
+		t.Fatal("Expected speed, damage, cooldown and XP from archetype")
+	}
+	if enemy.Color != rl.Blue {
+		t.Fatal("Expected overridden colour blue")
+	}
+}
+
+func TestEnemyWithoutArchetypeRequiresStats(t *testing.T) {
+	// given
+	// ... a scene builder
+	// ... an enemy without an archetype or health
+	builder := NewSceneBuilder()
+	data := &SceneData{Player: PlayerData{Speed: 5, Health: 100, MaxHealth: 100}}
+	data.Entities.Enemies = []EnemyData{{ID: "enemy_1", Speed: 2.0}}
+	// when
+	// ... the scene data is validated
+	err := builder.ValidateSceneData(data)
+	// then
+	// ... should fail because health is missing
+	if err == nil {
+		t.Fatal("Expected error for enemy without health")
+	}
+}
+
+func TestRangedArchetypeRequiresProjectileSpeed(t *testing.T) {
+	// given
+	// ... a catalog with a ranged archetype missing its projectile speed
+	catalog := NewArchetypeCatalog()
+	archer := defaultArchetype()
+	archer.Health = 40
+	archer.Speed = 2
+	archer.Behavior = entities.BehaviorRanged
+	catalog.Archetypes["archer"] = archer
+	// when
+	// ... the catalog is validated
+	err := catalog.Validate()
+	// then
+	// ... should reject the archetype
+	if err == nil {
+		t.Fatal("Expected error for ranged archetype without projectile speed")
+	}
+}
+
+func TestEnemyResistancesMergeOverArchetype(t *testing.T) {
+	// given
+	// ... a "grunt" archetype with fire resistance
+	// ... an enemy adding cold resistance and a fire damage type
+	builder := NewSceneBuilder()
+	builder.SetArchetypes(testArchetypes())
+	data := []EnemyData{{
+		ID:          "enemy_1",
+		Archetype:   "grunt",
+		DamageType:  "fire",
+		DefenseData: DefenseData{Resistances: map[string]float32{"cold": 0.25}},
+	}}
+	// when
+	// ... the enemies are built
+	enemy := builder.BuildEnemies(data, nil)[0]
+	// then
+	// ... should keep the archetype's armor and fire resistance
+	// ... and should add the instance's cold resistance and damage type
+	if enemy.Armor != 20.0 {
+		t.Fatalf("Expected armor 20.0, got %f", enemy.Armor)
+	}
+	if enemy.Resistances[entities.DamageFire] != 0.5 || enemy.Resistances[entities.DamageCold] != 0.25 {
+		t.Fatalf("Expected merged resistances, got %v", enemy.Resistances)
+	}
+	if enemy.DamageType != entities.DamageFire {
+		t.Fatalf("Expected fire damage type, got %s", enemy.DamageType)
+	}
+}
+
+func TestValidateSceneDataRejectsUnknownResistance(t *testing.T) {
+	// given
+	// ... a player resisting an unknown damage type
+	builder := NewSceneBuilder()
+	data := &SceneData{Player: PlayerData{Speed: 5, Health: 100, MaxHealth: 100}}
+	data.Player.Resistances = map[string]float32{"lightning": 0.5}
+	// when
+	// ... the scene data is validated
+	err := builder.ValidateSceneData(data)
+	// then
+	// ... should reject the resistance
+	if err == nil {
+		t.Fatal("Expected error for unknown resistance type")
+	}
+}
+
+func TestShippedSceneReferencesKnownArchetypes(t *testing.T) {
+	// given
+	// ... the archetype, item, loot and weapon data and game scene shipped with the game
+	catalog, err := LoadArchetypesFromJSON("../../scenes/enemies.json")
+	if err != nil {
+		t.Fatalf("Failed to load archetypes: %v", err)
+	}
+	data, err := LoadSceneFromJSON("../../scenes/game_scene.json")
+	if err != nil {
+		t.Fatalf("Failed to load scene: %v", err)
+	}
+	itemCatalog, err := LoadItemCatalogFromJSON("../../scenes/items.json")
+	if err != nil {
+		t.Fatalf("Failed to load items: %v", err)
+	}
+	lootTables, err := LoadLootTablesFromJSON("../../scenes/loot.json", itemCatalog)
+	if err != nil {
+		t.Fatalf("Failed to load loot tables: %v", err)
+	}
+	weapons, err := LoadWeaponsFromJSON("../../scenes/weapons.json")
+	if err != nil {
+		t.Fatalf("Failed to load weapons: %v", err)
+	}
+	builder := NewSceneBuilder()
+	builder.SetArchetypes(catalog)
+	builder.SetItems(itemCatalog)
+	builder.SetLootTables(lootTables)
+	builder.SetWeapons(weapons)
+	// when
+	// ... the scene is validated
+	err = builder.ValidateSceneData(data)
+	// then
+	// ... should be valid
+	if err != nil {
+		t.Fatalf("Expected shipped scene to be valid, got %v", err)
+	}
+}
+
+func TestValidateSceneDataRejectsLootOnIndestructibleObstacle(t *testing.T) {
+	// given
+	// ... a scene with an obstacle that drops loot but has no health
+	builder := NewSceneBuilder()
+	data := &SceneData{Player: PlayerData{Speed: 5, Health: 100, MaxHealth: 100}}
+	data.Entities.Obstacles = []ObstacleData{{
+		ID:        "box_1",
+		Type:      "box",
+		Size:      Vector3Data{X: 1, Y: 1, Z: 1},
+		LootTable: "crate",
+	}}
+	// when
+	// ... the scene data is validated
+	err := builder.ValidateSceneData(data)
+	// then
+	// ... should fail because only destructible obstacles drop loot
+	if err == nil {
+		t.Fatal("Expected error for loot on an indestructible obstacle")
+	}
+}
@@ -0,0 +1,102 @@
+package scenes
+
+import (
+	"fmt"
+
+	"arpg/pkg/entities"
+	"arpg/pkg/events"
+)
+
+// BossAttackData describes an attack in a boss phase in JSON
+type BossAttackData struct {
+	Type      string  `json:"type"`      // "charge", "burst" or "summon"
+	Telegraph float32 `json:"telegraph"` // Seconds of ground warning
+	Cooldown  float32 `json:"cooldown"`
+	Damage    float32 `json:"damage,omitempty"` // 0 uses the boss's damage
+	Speed     float32 `json:"speed,omitempty"`  // Charge or projectile speed
+	Range     float32 `json:"range,omitempty"`  // Charge distance or projectile reach
+	Radius    float32 `json:"radius,omitempty"` // Charge width, burst warning or summon spread
+	Count     int     `json:"count,omitempty"`  // Projectiles or adds
+	Archetype string  `json:"archetype,omitempty"`
+}
+
+// ToAttack converts attack data to a boss attack
+func (d BossAttackData) ToAttack() entities.BossAttack {
+	return entities.BossAttack{
+		Type:      entities.BossAttackType(d.Type),
+		Telegraph: d.Telegraph,
+		Cooldown:  d.Cooldown,
+		Damage:    d.Damage,
+		Speed:     d.Speed,
+		Range:     d.Range,
+		Radius:    d.Radius,
+		Count:     d.Count,
+		Archetype: d.Archetype,
+	}
+}
+
+// BossPhaseData describes a boss phase in JSON
+type BossPhaseData struct {
+	Name            string           `json:"name"`
+	HealthThreshold float32          `json:"health_threshold"` // Fraction of max health the phase starts at
+	Attacks         []BossAttackData `json:"attacks"`
+}
+
+// BossData represents a boss in JSON: an enemy with a name and phases
+type BossData struct {
+	EnemyData
+	Name   string          `json:"name"`
+	Phases []BossPhaseData `json:"phases"`
+}
+
+// ToPhases converts the boss's phase data to boss phases
+func (d BossData) ToPhases() []entities.BossPhase {
+	phases := make([]entities.BossPhase, 0, len(d.Phases))
+	for _, phaseData := range d.Phases {
+		phase := entities.BossPhase{
+			Name:            phaseData.Name,
+			HealthThreshold: phaseData.HealthThreshold,
+		}
+		for _, attack := range phaseData.Attacks {
+			phase.Attacks = append(phase.Attacks, attack.ToAttack())
+		}
+		phases = append(phases, phase)
+	}
+	return phases
+}
+
+// Validate checks the boss's phases and that summoned archetypes are known
+func (d BossData) Validate(archetypes *ArchetypeCatalog) error {
+	if d.Name == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	for _, phase := range d.Phases {
+		for _, attack := range phase.Attacks {
+			if attack.Archetype == "" {
+				continue
+			}
+			if _, exists := archetypes.Get(attack.Archetype); !exists {
+				return fmt.Errorf("phase %s: unknown archetype %q", phase.Name, attack.Archetype)
+			}
+		}
+	}
+	return entities.ValidateBossPhases(d.ToPhases())
+}
+
+// BuildBosses creates boss entities, summoning adds with factory
+func (sb *SceneBuilder) BuildBosses(data []BossData, eventBus events.Subject, factory entities.EnemyFactory) []*entities.Boss {
+	bosses := make([]*entities.Boss, 0, len(data))
+	for _, bossData := range data {
+		archetype, err := sb.archetypes.Resolve(bossData.EnemyData)
+		if err != nil {
+			fmt.Printf("%v, skipping\n", err)
+			continue
+		}
+		bossData.Position = sb.onGround(bossData.Position)
+		enemy := sb.buildEnemy(bossData.EnemyData, archetype, eventBus)
+		boss := entities.NewBoss(enemy, bossData.Name, bossData.ToPhases())
+		boss.SetSummoner(factory)
+		bosses = append(bosses, boss)
+	}
+	return bosses
+}
@@ -0,0 +1,75 @@
+package scenes
+
+import (
+	"testing"
+
+	"arpg/pkg/entities"
+)
+
+func TestBuildBossesFromArchetype(t *testing.T) {
+	// given
+	// ... a 500 health boss built on the grunt archetype with two phases
+	builder := NewSceneBuilder()
+	builder.SetArchetypes(testArchetypes())
+	data := BossData{
+		EnemyData: EnemyData{ID: "boss_1", Archetype: "grunt", Health: 500},
+		Name:      "Warden",
+		Phases: []BossPhaseData{
+			{Name: "one", HealthThreshold: 1, Attacks: []BossAttackData{
+				{Type: "charge", Telegraph: 1, Speed: 12, Range: 8},
+			}},
+			{Name: "two", HealthThreshold: 0.5, Attacks: []BossAttackData{
+				{Type: "summon", Telegraph: 1, Count: 2, Archetype: "grunt"},
+			}},
+		},
+	}
+	// when
+	// ... the boss is validated and built
+	err := data.Validate(builder.archetypes)
+	bosses := builder.BuildBosses([]BossData{data}, nil, builder.EnemyFactory(nil))
+	// then
+	// ... should keep the grunt's stats with the boss's overrides and phases
+	if err != nil {
+		t.Fatalf("Expected boss to be valid, got %v", err)
+	}
+	if len(bosses) != 1 {
+		t.Fatalf("Expected 1 boss, got %d", len(bosses))
+	}
+	boss := bosses[0]
+	if boss.ID != "boss_1" || boss.Name != "Warden" || boss.MaxHealth != 500 || boss.Armor != 20 {
+		t.Fatalf("Expected 500 health Warden with grunt armor, got %s %s %f %f",
+			boss.ID, boss.Name, boss.MaxHealth, boss.Armor)
+	}
+	if len(boss.Phases) != 2 || boss.Phases[1].Attacks[0].Type != entities.BossAttackSummon {
+		t.Fatalf("Expected two phases ending in a summon, got %+v", boss.Phases)
+	}
+	if boss.LeashDistance != 0 {
+		t.Fatalf("Expected bosses never to leash, got %f", boss.LeashDistance)
+	}
+}
+
+func TestBossValidationRejectsUnknownSummon(t *testing.T) {
+	// given
+	// ... a boss summoning an unknown archetype
+	data := BossData{
+		EnemyData: EnemyData{ID: "boss_1", Archetype: "grunt", Health: 500},
+		Name:      "Warden",
+		Phases: []BossPhaseData{
+			{Name: "one", HealthThreshold: 1, Attacks: []BossAttackData{
+				{Type: "charge", Telegraph: 1, Speed: 12, Range: 8},
+			}},
+			{Name: "two", HealthThreshold: 0.5, Attacks: []BossAttackData{
+				{Type: "summon", Telegraph: 1, Count: 2, Archetype: "grunt"},
+			}},
+		},
+	}
+	data.Phases[1].Attacks[0].Archetype = "dragon"
+	// when
+	// ... it is validated
+	err := data.Validate(testArchetypes())
+	// then
+	// ... should fail
+	if err == nil {
+		t.Fatal("Expected error for an unknown summoned archetype")
+	}
+}
@@ -0,0 +1,129 @@
+package scenes
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"arpg/pkg/entities"
+	"arpg/pkg/items"
+)
+
+// maxItemSize is the largest width or height an item can take in the
+// inventory grid
+const maxItemSize = entities.PlayerInventoryHeight
+
+// ItemModifierData describes a stat modifier granted by an item in JSON
+type ItemModifierData struct {
+	Stat  string  `json:"stat"`           // e.g. "damage", "max_health"
+	Kind  string  `json:"kind,omitempty"` // "flat" (default), "increased" or "more"
+	Value float32 `json:"value"`
+}
+
+// ItemDefinitionData describes an item base type in JSON
+type ItemDefinitionData struct {
+	Name      string             `json:"name"`
+	BaseType  string             `json:"base_type"`
+	Slot      string             `json:"slot,omitempty"`      // Empty for items that cannot be equipped
+	Width     int                `json:"width,omitempty"`     // Defaults to 1
+	Height    int                `json:"height,omitempty"`    // Defaults to 1
+	MaxStack  int                `json:"max_stack,omitempty"` // Defaults to 1
+	Modifiers []ItemModifierData `json:"modifiers,omitempty"`
+}
+
+// ItemCatalogData holds every item definition keyed by ID in JSON
+type ItemCatalogData struct {
+	Items map[string]ItemDefinitionData `json:"items"`
+}
+
+// ItemStackData names an item and how many of it, e.g. starting items
+type ItemStackData struct {
+	Item     string `json:"item"`
+	Quantity int    `json:"quantity,omitempty"` // Defaults to 1 when omitted or 0
+}
+
+// LoadItemCatalogFromJSON loads and validates an item definition file
+func LoadItemCatalogFromJSON(filename string) (*items.Catalog, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var catalogData ItemCatalogData
+	if err := json.Unmarshal(data, &catalogData); err != nil {
+		return nil, err
+	}
+
+	return catalogData.ToCatalog()
+}
+
+// ToCatalog validates every definition and builds the item catalog
+func (d ItemCatalogData) ToCatalog() (*items.Catalog, error) {
+	ids := make([]string, 0, len(d.Items))
+	for id := range d.Items {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	catalog := items.NewCatalog()
+	for _, id := range ids {
+		definitionData := d.Items[id]
+		if err := definitionData.Validate(); err != nil {
+			return nil, fmt.Errorf("item %s: %w", id, err)
+		}
+		if err := catalog.Add(definitionData.ToDefinition(id)); err != nil {
+			return nil, err
+		}
+	}
+	return catalog, nil
+}
+
+// ToDefinition converts item data to an item definition, filling defaults
+func (d ItemDefinitionData) ToDefinition(id string) items.Definition {
+	definition := items.Definition{
+		ID:       id,
+		Name:     d.Name,
+		BaseType: d.BaseType,
+		Slot:     items.Slot(d.Slot),
+		Width:    max(d.Width, 1),
+		Height:   max(d.Height, 1),
+		MaxStack: max(d.MaxStack, 1),
+	}
+	for _, modifier := range d.Modifiers {
+		definition.Modifiers = append(definition.Modifiers, items.StatModifier{
+			Stat:  modifier.Stat,
+			Kind:  modifierKindOrFlat(modifier.Kind),
+			Value: modifier.Value,
+		})
+	}
+	return definition
+}
+
+// Validate checks the slot, size, stacking and modifiers are supported
+func (d ItemDefinitionData) Validate() error {
+	if d.Name == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if !items.IsKnownSlot(items.Slot(d.Slot)) {
+		return fmt.Errorf("unknown slot %q", d.Slot)
+	}
+	if d.Width < 0 || d.Width > maxItemSize || d.Height < 0 || d.Height > maxItemSize {
+		return fmt.Errorf("size must be at most %dx%d, got %dx%d", maxItemSize, maxItemSize, d.Width, d.Height)
+	}
+	if d.MaxStack < 0 {
+		return fmt.Errorf("max stack cannot be negative, got %d", d.MaxStack)
+	}
+	if d.MaxStack > 1 && d.Slot != "" {
+		return fmt.Errorf("equippable items cannot stack")
+	}
+	for _, modifier := range d.Modifiers {
+		if !entities.IsKnownStat(entities.StatType(modifier.Stat)) {
+			return fmt.Errorf("unknown stat %q", modifier.Stat)
+		}
+		if modifier.Kind != "" && !items.IsKnownModifierKind(items.ModifierKind(modifier.Kind)) {
+			return fmt.Errorf("unknown modifier kind %q", modifier.Kind)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package scenes
+
+import (
+	"math/rand"
+	"testing"
+
+	"arpg/pkg/items"
+)
+
+func TestItemCatalogRejectsStackableEquipment(t *testing.T) {
+	// given
+	// ... a ring definition that stacks
+	data := ItemCatalogData{Items: map[string]ItemDefinitionData{
+		"ring": {Name: "Ring", BaseType: "ring", Slot: "ring", MaxStack: 5},
+	}}
+	// when
+	// ... the catalog is built
+	_, err := data.ToCatalog()
+	// then
+	// ... should reject the definition
+	if err == nil {
+		t.Fatal("Expected error for stackable equipment")
+	}
+}
+
+func TestItemCatalogRejectsUnknownStat(t *testing.T) {
+	// given
+	// ... an amulet granting an unknown stat
+	data := ItemCatalogData{Items: map[string]ItemDefinitionData{
+		"amulet": {
+			Name:      "Amulet",
+			Slot:      "amulet",
+			Modifiers: []ItemModifierData{{Stat: "luck", Value: 1}},
+		},
+	}}
+	// when
+	// ... the catalog is built
+	_, err := data.ToCatalog()
+	// then
+	// ... should reject the modifier
+	if err == nil {
+		t.Fatal("Expected error for unknown stat")
+	}
+}
+
+func TestBuildPlayerGivesStartingItems(t *testing.T) {
+	// given
+	// ... the shipped item catalog
+	// ... a player starting with a sword and three potions
+	catalog, err := LoadItemCatalogFromJSON("../../scenes/items.json")
+	if err != nil {
+		t.Fatalf("Failed to load items: %v", err)
+	}
+	builder := NewSceneBuilder()
+	builder.SetItems(catalog)
+	data := PlayerData{
+		Speed:     5,
+		Health:    100,
+		MaxHealth: 100,
+		StartingItems: []ItemStackData{
+			{Item: "rusty_sword"},
+			{Item: "health_potion", Quantity: 3},
+		},
+	}
+	// when
+	// ... the player is built
+	player := builder.BuildPlayer(data, nil)
+	// then
+	// ... should carry both items
+	if player.Inventory.Len() != 2 || player.Inventory.Count("health_potion") != 3 {
+		t.Fatalf("Expected a sword and 3 potions, got %d items", player.Inventory.Len())
+	}
+}
+
+func TestValidateStartingItemQuantities(t *testing.T) {
+	// given
+	// ... the shipped items, a player with an omitted quantity and one
+	// ... carrying more potions than fit a stack
+	catalog, err := LoadItemCatalogFromJSON("../../scenes/items.json")
+	if err != nil {
+		t.Fatalf("Failed to load items: %v", err)
+	}
+	builder := NewSceneBuilder()
+	builder.SetItems(catalog)
+	definition, _ := catalog.Get("health_potion")
+	omitted := &SceneData{Player: PlayerData{Speed: 5, Health: 100, MaxHealth: 100,
+		StartingItems: []ItemStackData{{Item: "health_potion"}}}}
+	overfull := &SceneData{Player: PlayerData{Speed: 5, Health: 100, MaxHealth: 100,
+		StartingItems: []ItemStackData{{Item: "health_potion", Quantity: definition.MaxStack + 1}}}}
+	// when
+	// ... both scenes are validated
+	omittedErr := builder.ValidateSceneData(omitted)
+	overfullErr := builder.ValidateSceneData(overfull)
+	// then
+	// ... should accept the omitted quantity and reject the overfull stack
+	if omittedErr != nil {
+		t.Fatalf("Expected an omitted quantity to be valid, got %v", omittedErr)
+	}
+	if overfullErr == nil {
+		t.Fatal("Expected error for a quantity above the max stack")
+	}
+}
+
+func TestShippedAffixesLoad(t *testing.T) {
+	// given
+	// ... the shipped item catalog
+	catalog, err := LoadItemCatalogFromJSON("../../scenes/items.json")
+	if err != nil {
+		t.Fatalf("Failed to load items: %v", err)
+	}
+	// when
+	// ... the shipped affixes are loaded
+	generator, err := LoadItemGeneratorFromJSON("../../scenes/affixes.json", catalog)
+	// then
+	// ... should load and roll rares on every equippable item
+	if err != nil {
+		t.Fatalf("Failed to load affixes: %v", err)
+	}
+	rng := rand.New(rand.NewSource(1))
+	for _, id := range catalog.IDs() {
+		item, err := generator.GenerateWithRarity(id, 1, 10, items.RarityRare, rng)
+		if err != nil {
+			t.Fatalf("Expected %s to generate, got %v", id, err)
+		}
+		if item.Definition.Equippable() && len(item.Affixes) < 3 {
+			t.Fatalf("Expected rare %s to roll at least 3 affixes, got %d", id, len(item.Affixes))
+		}
+	}
+}
+
+func TestUniqueRejectsUnequippableBase(t *testing.T) {
+	// given
+	// ... a unique built on a potion
+	catalog, err := ItemCatalogData{Items: map[string]ItemDefinitionData{
+		"potion": {Name: "Potion", BaseType: "potion", MaxStack: 5},
+	}}.ToCatalog()
+	if err != nil {
+		t.Fatalf("Failed to build catalog: %v", err)
+	}
+	data := AffixPoolData{Uniques: map[string]UniqueData{
+		"elixir": {Name: "Elixir", Base: "potion"},
+	}}
+	// when
+	// ... the generator is built
+	_, err = data.ToGenerator(catalog)
+	// then
+	// ... should reject the unique
+	if err == nil {
+		t.Fatal("Expected error for unequippable unique base")
+	}
+}
@@ -0,0 +1,82 @@
+package scenes
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+
+	"arpg/pkg/items"
+)
+
+// LootEntryData describes one weighted loot table outcome in JSON. An entry
+// with neither an item nor a table drops nothing.
+type LootEntryData struct {
+	Weight      int    `json:"weight"`
+	Item        string `json:"item,omitempty"`
+	Table       string `json:"table,omitempty"`        // Nested table rolled instead
+	MinQuantity int    `json:"min_quantity,omitempty"` // Defaults to 1
+	MaxQuantity int    `json:"max_quantity,omitempty"` // Defaults to min_quantity
+}
+
+// LootTableData describes a loot table in JSON
+type LootTableData struct {
+	Rolls   int             `json:"rolls,omitempty"` // Defaults to 1
+	Entries []LootEntryData `json:"entries"`
+}
+
+// LootTablesData holds every loot table keyed by ID in JSON
+type LootTablesData struct {
+	Tables map[string]LootTableData `json:"tables"`
+}
+
+// LoadLootTablesFromJSON loads loot tables and validates them against the
+// item catalog
+func LoadLootTablesFromJSON(filename string, catalog *items.Catalog) (*items.LootTables, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var tablesData LootTablesData
+	if err := json.Unmarshal(data, &tablesData); err != nil {
+		return nil, err
+	}
+
+	return tablesData.ToLootTables(catalog)
+}
+
+// ToLootTables builds and validates the loot tables
+func (d LootTablesData) ToLootTables(catalog *items.Catalog) (*items.LootTables, error) {
+	ids := make([]string, 0, len(d.Tables))
+	for id := range d.Tables {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	tables := items.NewLootTables()
+	for _, id := range ids {
+		if err := tables.Add(id, d.Tables[id].ToLootTable()); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tables.Validate(catalog); err != nil {
+		return nil, err
+	}
+	return tables, nil
+}
+
+// ToLootTable converts loot table data to a loot table
+func (d LootTableData) ToLootTable() items.LootTable {
+	table := items.LootTable{Rolls: max(d.Rolls, 1)}
+	for _, entry := range d.Entries {
+		table.Entries = append(table.Entries, items.LootEntry{
+			Weight:      entry.Weight,
+			Item:        entry.Item,
+			Table:       entry.Table,
+			MinQuantity: entry.MinQuantity,
+			MaxQuantity: entry.MaxQuantity,
+		})
+	}
+	return table
+}
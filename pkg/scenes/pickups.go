@@ -0,0 +1,114 @@
+package scenes
+
+import (
+	"fmt"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/entities"
+)
+
+// defaultPickupColors is used for pickup types declared without a colour
+var defaultPickupColors = map[entities.PickupType]rl.Color{
+	entities.PickupHealth:     rl.Green,
+	entities.PickupMana:       rl.Blue,
+	entities.PickupAmmo:       rl.Orange,
+	entities.PickupGold:       rl.Gold,
+	entities.PickupSpeedBuff:  rl.SkyBlue,
+	entities.PickupDamageBuff: rl.Red,
+	entities.PickupXP:         rl.Purple,
+}
+
+// PickupTypeData describes one kind of pickup in JSON
+type PickupTypeData struct {
+	Type          string  `json:"type"`               // "health", "mana", "ammo", "gold", "speed_buff", "damage_buff" or "xp"
+	Amount        float32 `json:"amount"`             // Increased fraction for buffs; 0 fills the magazine for ammo
+	Duration      float32 `json:"duration,omitempty"` // Buff seconds
+	Radius        float32 `json:"radius,omitempty"`
+	MagnetRadius  float32 `json:"magnet_radius,omitempty"`
+	MagnetSpeed   float32 `json:"magnet_speed,omitempty"`
+	CollectRadius float32 `json:"collect_radius,omitempty"`
+	Lifetime      float32 `json:"lifetime,omitempty"` // 0 never despawns
+	Color         string  `json:"color,omitempty"`
+}
+
+// PickupTypesData holds every pickup type keyed by ID in JSON
+type PickupTypesData map[string]PickupTypeData
+
+// PickupData places a pickup of a declared type in the scene
+type PickupData struct {
+	ID       string      `json:"id"`
+	Type     string      `json:"type"` // Key into the scene's pickup types
+	Position Vector3Data `json:"position"`
+}
+
+// ToRegistry validates every pickup type and registers its definition
+func (d PickupTypesData) ToRegistry() (*entities.PickupRegistry, error) {
+	registry := entities.NewPickupRegistry()
+	for _, id := range sortedKeys(d) {
+		typeData := d[id]
+		if err := typeData.Validate(); err != nil {
+			return nil, fmt.Errorf("pickup type %s: %w", id, err)
+		}
+		if err := registry.Register(typeData.ToDefinition(id)); err != nil {
+			return nil, err
+		}
+	}
+	return registry, nil
+}
+
+// ToDefinition converts pickup type data to a pickup definition
+func (d PickupTypeData) ToDefinition(id string) *entities.PickupDefinition {
+	pickupType := entities.PickupType(d.Type)
+	color := defaultPickupColors[pickupType]
+	if d.Color != "" {
+		color = ParseColor(d.Color)
+	}
+	return &entities.PickupDefinition{
+		ID:            id,
+		Type:          pickupType,
+		Amount:        d.Amount,
+		Duration:      d.Duration,
+		Radius:        d.Radius,
+		MagnetRadius:  d.MagnetRadius,
+		MagnetSpeed:   d.MagnetSpeed,
+		CollectRadius: d.CollectRadius,
+		Lifetime:      d.Lifetime,
+		Color:         color,
+	}
+}
+
+// Validate checks the type is known and its values are in range
+func (d PickupTypeData) Validate() error {
+	pickupType := entities.PickupType(d.Type)
+	if !entities.IsKnownPickupType(pickupType) {
+		return fmt.Errorf("unknown type %q", d.Type)
+	}
+	if d.Amount < 0 || (d.Amount == 0 && pickupType != entities.PickupAmmo) {
+		return fmt.Errorf("amount must be positive, got %f", d.Amount)
+	}
+	if entities.IsBuffPickup(pickupType) && d.Duration <= 0 {
+		return fmt.Errorf("buff duration must be positive, got %f", d.Duration)
+	}
+	if d.Duration < 0 || d.Radius < 0 || d.MagnetRadius < 0 || d.MagnetSpeed < 0 ||
+		d.CollectRadius < 0 || d.Lifetime < 0 {
+		return fmt.Errorf("duration, radii, magnet speed and lifetime cannot be negative")
+	}
+	return nil
+}
+
+// BuildPickups creates pickup entities of the registered types. Scenes are
+// validated first, so an unregistered type is an error rather than skipped.
+func (sb *SceneBuilder) BuildPickups(data []PickupData, registry *entities.PickupRegistry) ([]*entities.Pickup, error) {
+	pickups := make([]*entities.Pickup, 0, len(data))
+	for _, pickupData := range data {
+		definition, exists := registry.Get(pickupData.Type)
+		if !exists {
+			return nil, fmt.Errorf("pickup %s: unknown pickup type %q", pickupData.ID, pickupData.Type)
+		}
+		pickup := entities.NewPickup(sb.onGround(pickupData.Position).ToVector3(), definition)
+		pickup.ID = pickupData.ID
+		pickups = append(pickups, pickup)
+	}
+	return pickups, nil
+}
@@ -0,0 +1,94 @@
+package scenes
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/entities"
+)
+
+func TestValidateSceneDataRejectsUndeclaredPickupType(t *testing.T) {
+	// given
+	// ... a scene declaring a gold pickup type
+	// ... and placing a pickup of an undeclared type
+	builder := NewSceneBuilder()
+	data := &SceneData{Player: PlayerData{Speed: 5, Health: 100, MaxHealth: 100}}
+	data.PickupTypes = PickupTypesData{"gold_pile": {Type: "gold", Amount: 10}}
+	data.Entities.Pickups = []PickupData{{ID: "pickup_1", Type: "mana_orb"}}
+	// when
+	// ... the scene data is validated
+	err := builder.ValidateSceneData(data)
+	// then
+	// ... should fail because the pickup type is not declared
+	if err == nil {
+		t.Fatal("Expected error for undeclared pickup type")
+	}
+}
+
+func TestPickupTypeValidation(t *testing.T) {
+	// given
+	// ... pickup types with an unknown type, a buff without a duration and
+	// ... an ammo box refilling the whole magazine
+	unknown := PickupTypeData{Type: "shield", Amount: 10}
+	buff := PickupTypeData{Type: "speed_buff", Amount: 0.3}
+	ammo := PickupTypeData{Type: "ammo"}
+	// when
+	// ... each is validated
+	unknownErr := unknown.Validate()
+	buffErr := buff.Validate()
+	ammoErr := ammo.Validate()
+	// then
+	// ... only the ammo box should be valid
+	if unknownErr == nil || buffErr == nil {
+		t.Fatal("Expected errors for an unknown type and a buff without a duration")
+	}
+	if ammoErr != nil {
+		t.Fatalf("Expected ammo with no amount to be valid, got %v", ammoErr)
+	}
+}
+
+func TestBuildPickupsUsesDeclaredTypes(t *testing.T) {
+	// given
+	// ... a registry built from an XP orb type with no colour
+	registry, err := PickupTypesData{
+		"xp_orb": {Type: "xp", Amount: 20, MagnetRadius: 4, Lifetime: 30},
+	}.ToRegistry()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	data := []PickupData{{ID: "xp_orb_1", Type: "xp_orb", Position: Vector3Data{X: 1, Y: 0.3, Z: 2}}}
+	// when
+	// ... the pickups are built
+	pickups, err := NewSceneBuilder().BuildPickups(data, registry)
+	// then
+	// ... should share the type's definition and take its default colour and lifetime
+	if err != nil {
+		t.Fatalf("Unexpected build error: %v", err)
+	}
+	if len(pickups) != 1 {
+		t.Fatalf("Expected 1 pickup, got %d", len(pickups))
+	}
+	pickup := pickups[0]
+	if pickup.ID != "xp_orb_1" || pickup.Definition.Type != entities.PickupXP {
+		t.Fatalf("Expected xp_orb_1 of type xp, got %s/%s", pickup.ID, pickup.Definition.Type)
+	}
+	if pickup.Color != rl.Purple || pickup.Remaining != 30 {
+		t.Fatalf("Expected purple with 30s to live, got %v and %f", pickup.Color, pickup.Remaining)
+	}
+}
+
+func TestBuildPickupsRejectsUnregisteredType(t *testing.T) {
+	// given
+	// ... an empty registry and a pickup of an undeclared type
+	registry := entities.NewPickupRegistry()
+	data := []PickupData{{ID: "mystery_1", Type: "mystery"}}
+	// when
+	// ... the pickups are built
+	pickups, err := NewSceneBuilder().BuildPickups(data, registry)
+	// then
+	// ... should fail rather than drop the pickup
+	if err == nil || pickups != nil {
+		t.Fatalf("Expected an error and no pickups, got %v and %d pickups", err, len(pickups))
+	}
+}
@@ -0,0 +1,64 @@
+package scenes
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"arpg/pkg/entities"
+)
+
+// LevelCurveData describes player levelling in JSON. xp_to_next[i] is the
+// XP needed to advance from level i+1 to level i+2.
+type LevelCurveData struct {
+	XPToNext          []float32 `json:"xp_to_next"`
+	PointsPerLevel    int       `json:"points_per_level"`
+	EnemyLevelXPScale float32   `json:"enemy_level_xp_scale"`
+}
+
+// LoadLevelCurveFromJSON loads and validates a level curve file
+func LoadLevelCurveFromJSON(filename string) (*entities.LevelCurve, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var curveData LevelCurveData
+	if err := json.Unmarshal(data, &curveData); err != nil {
+		return nil, err
+	}
+
+	if err := curveData.Validate(); err != nil {
+		return nil, err
+	}
+
+	return curveData.ToLevelCurve(), nil
+}
+
+// ToLevelCurve converts level curve data to the curve used by the player
+func (d LevelCurveData) ToLevelCurve() *entities.LevelCurve {
+	return &entities.LevelCurve{
+		XPToNext:          append([]float32(nil), d.XPToNext...),
+		PointsPerLevel:    d.PointsPerLevel,
+		EnemyLevelXPScale: d.EnemyLevelXPScale,
+	}
+}
+
+// Validate checks the curve has at least one level and every threshold is positive
+func (d LevelCurveData) Validate() error {
+	if len(d.XPToNext) == 0 {
+		return fmt.Errorf("level curve: xp_to_next cannot be empty")
+	}
+	for i, xp := range d.XPToNext {
+		if xp <= 0 {
+			return fmt.Errorf("level curve: xp to reach level %d must be positive, got %f", i+2, xp)
+		}
+	}
+	if d.PointsPerLevel < 0 {
+		return fmt.Errorf("level curve: points per level cannot be negative, got %d", d.PointsPerLevel)
+	}
+	if d.EnemyLevelXPScale < 0 {
+		return fmt.Errorf("level curve: enemy level xp scale cannot be negative, got %f", d.EnemyLevelXPScale)
+	}
+	return nil
+}
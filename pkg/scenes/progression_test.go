@@ -0,0 +1,33 @@
+package scenes
+
+import "testing"
+
+func TestLevelCurveRejectsNonPositiveThreshold(t *testing.T) {
+	// given
+	// ... a curve whose second threshold is zero
+	data := LevelCurveData{XPToNext: []float32{100, 0}, PointsPerLevel: 5}
+	// when
+	// ... the curve is validated
+	err := data.Validate()
+	// then
+	// ... should reject the threshold
+	if err == nil {
+		t.Fatal("Expected error for zero XP threshold")
+	}
+}
+
+func TestShippedLevelCurveIsValid(t *testing.T) {
+	// given
+	// ... the level curve shipped with the game
+	// when
+	// ... it is loaded
+	curve, err := LoadLevelCurveFromJSON("../../scenes/progression.json")
+	// then
+	// ... should load with at least one level to gain
+	if err != nil {
+		t.Fatalf("Expected shipped level curve to be valid, got %v", err)
+	}
+	if curve.MaxLevel() < 2 {
+		t.Fatalf("Expected a max level of at least 2, got %d", curve.MaxLevel())
+	}
+}
@@ -5,41 +5,148 @@ import (
 
 	"arpg/pkg/entities"
 	"arpg/pkg/events"
+	"arpg/pkg/globals"
+	"arpg/pkg/items"
 )
 
 // SceneBuilder handles converting JSON scene data to game entities
-type SceneBuilder struct{}
+type SceneBuilder struct {
+	archetypes *ArchetypeCatalog
+	items      *items.Catalog
+	loot       *items.LootTables
+	weapons    map[string]*entities.WeaponDefinition
+	ground     globals.Ground
+}
 
 // NewSceneBuilder creates a new scene builder
 func NewSceneBuilder() *SceneBuilder {
-	return &SceneBuilder{}
+	return &SceneBuilder{
+		archetypes: NewArchetypeCatalog(),
+		items:      items.NewCatalog(),
+		loot:       items.NewLootTables(),
+		weapons:    make(map[string]*entities.WeaponDefinition),
+	}
+}
+
+// SetArchetypes sets the enemy archetype catalog used to build enemies
+func (sb *SceneBuilder) SetArchetypes(catalog *ArchetypeCatalog) {
+	sb.archetypes = catalog
+}
+
+// SetItems sets the item catalog used to create starting items
+func (sb *SceneBuilder) SetItems(catalog *items.Catalog) {
+	sb.items = catalog
+}
+
+// SetLootTables sets the loot tables enemies may reference
+func (sb *SceneBuilder) SetLootTables(tables *items.LootTables) {
+	sb.loot = tables
+}
+
+// SetWeapons sets the weapon definitions players can be given
+func (sb *SceneBuilder) SetWeapons(weapons map[string]*entities.WeaponDefinition) {
+	sb.weapons = weapons
+}
+
+// SetGround sets the terrain scene positions are measured from, or nil for
+// flat ground at zero height
+func (sb *SceneBuilder) SetGround(ground globals.Ground) {
+	sb.ground = ground
+}
+
+// onGround lifts a scene position by the height of the ground under it, so
+// heights in the scene are measured from the terrain
+func (sb *SceneBuilder) onGround(position Vector3Data) Vector3Data {
+	if sb.ground != nil {
+		position.Y += sb.ground.HeightAt(position.X, position.Z)
+	}
+	return position
 }
 
 // BuildPlayer creates a player entity from JSON data
 func (sb *SceneBuilder) BuildPlayer(data PlayerData, eventBus events.Subject) *entities.Player {
 	player := entities.NewPlayer(data.Speed, eventBus)
-	player.Position = data.SpawnPoint.ToVector3()
+	player.Position = sb.onGround(data.SpawnPoint).ToVector3()
+	player.DefenseComponent = data.DefenseData.ToComponent()
+	player.SetBaseStat(entities.StatMaxHealth, data.MaxHealth)
+	player.SetBaseStat(entities.StatArmor, data.Armor)
 	player.Health = data.Health
-	player.MaxHealth = data.MaxHealth
+	for _, stack := range data.StartingItems {
+		item, err := sb.items.NewItem(stack.Item, max(stack.Quantity, 1))
+		if err != nil {
+			fmt.Printf("%v, skipping\n", err)
+			continue
+		}
+		if err := player.GiveItem(item); err != nil {
+			fmt.Printf("Cannot give starting item %s: %v\n", stack.Item, err)
+		}
+	}
+	weapons := make([]*entities.WeaponDefinition, 0, len(data.Weapons))
+	for _, id := range data.Weapons {
+		if weapon, exists := sb.weapons[id]; exists {
+			weapons = append(weapons, weapon)
+		}
+	}
+	player.SetWeapons(weapons)
 	return player
 }
 
 // BuildEnemies creates enemy entities from JSON data
-func (sb *SceneBuilder) BuildEnemies(data []EnemyData) []*entities.Enemy {
+func (sb *SceneBuilder) BuildEnemies(data []EnemyData, eventBus events.Subject) []*entities.Enemy {
 	enemies := make([]*entities.Enemy, 0, len(data))
 	
 	for _, enemyData := range data {
-		enemy := entities.NewEnemy(
-			enemyData.Position.ToVector3(),
-			enemyData.Health,
-			enemyData.Speed,
-		)
-		enemies = append(enemies, enemy)
+		archetype, err := sb.archetypes.Resolve(enemyData)
+		if err != nil {
+			fmt.Printf("%v, skipping\n", err)
+			continue
+		}
+
+		enemyData.Position = sb.onGround(enemyData.Position)
+		enemies = append(enemies, sb.buildEnemy(enemyData, archetype, eventBus))
 	}
 	
 	return enemies
 }
 
+// buildEnemy creates an enemy from its data and resolved archetype
+func (sb *SceneBuilder) buildEnemy(enemyData EnemyData, archetype EnemyArchetype, eventBus events.Subject) *entities.Enemy {
+	enemy := entities.NewEnemy(
+		enemyData.Position.ToVector3(),
+		archetype.Health,
+		archetype.Speed,
+	)
+	enemy.ID = enemyData.ID
+	enemy.Archetype = enemyData.Archetype
+	enemy.Radius = archetype.Radius
+	enemy.Height = archetype.Height
+	enemy.Color = ParseColor(archetype.Color)
+	enemy.SetBaseStat(entities.StatDamage, archetype.Damage)
+	enemy.AttackInterval = archetype.AttackCooldown
+	enemy.Behavior = archetype.Behavior
+	enemy.XPValue = archetype.XPValue
+	enemy.LootTable = archetype.LootTable
+	if enemyData.Level > 0 {
+		enemy.Level = enemyData.Level
+	}
+	enemy.AggroRadius = archetype.AggroRadius
+	enemy.LeashDistance = archetype.LeashDistance
+	enemy.FleeHealthPercent = archetype.FleeHealthPercent
+	enemy.AttackRange = archetype.AttackRange
+	enemy.ProjectileSpeed = archetype.ProjectileSpeed
+	enemy.DamageType = entities.DamageType(archetype.DamageType)
+	enemy.DefenseComponent = archetype.DefenseData.ToComponent()
+	enemy.SetBaseStat(entities.StatArmor, archetype.Armor)
+	for _, effect := range archetype.OnHit {
+		enemy.OnHitEffects = append(enemy.OnHitEffects, effect.ToStatusEffect())
+	}
+	for _, waypoint := range enemyData.Patrol {
+		enemy.Waypoints = append(enemy.Waypoints, waypoint.ToVector3())
+	}
+	enemy.SetEventBus(eventBus)
+	return enemy
+}
+
 // BuildObstacles creates obstacle entities from JSON data
 func (sb *SceneBuilder) BuildObstacles(data []ObstacleData) []*entities.Obstacle {
 	obstacles := make([]*entities.Obstacle, 0, len(data))
@@ -51,13 +158,13 @@ func (sb *SceneBuilder) BuildObstacles(data []ObstacleData) []*entities.Obstacle
 		switch obstacleData.Type {
 		case "box":
 			obstacle = entities.NewBoxObstacle(
-				obstacleData.Position.ToVector3(),
+				sb.onGround(obstacleData.Position).ToVector3(),
 				obstacleData.Size.ToVector3(),
 				color,
 			)
 		case "cylinder":
 			obstacle = entities.NewCylinderObstacle(
-				obstacleData.Position.ToVector3(),
+				sb.onGround(obstacleData.Position).ToVector3(),
 				obstacleData.Radius,
 				obstacleData.Height,
 				color,
@@ -67,6 +174,11 @@ func (sb *SceneBuilder) BuildObstacles(data []ObstacleData) []*entities.Obstacle
 			continue
 		}
 		
+		obstacle.ID = obstacleData.ID
+		if obstacleData.Health > 0 {
+			obstacle.MakeDestructible(obstacleData.Health)
+			obstacle.LootTable = obstacleData.LootTable
+		}
 		obstacles = append(obstacles, obstacle)
 	}
 	
@@ -74,12 +186,13 @@ func (sb *SceneBuilder) BuildObstacles(data []ObstacleData) []*entities.Obstacle
 }
 
 // BuildHealthPickups creates health pickup entities from JSON data
-func (sb *SceneBuilder) BuildHealthPickups(data []HealthPickupData) []*entities.HealthPickup {
-	pickups := make([]*entities.HealthPickup, 0, len(data))
+func (sb *SceneBuilder) BuildHealthPickups(data []HealthPickupData) []*entities.Pickup {
+	pickups := make([]*entities.Pickup, 0, len(data))
 	
 	for _, pickupData := range data {
-		pickup := entities.NewHealthPickup(pickupData.Position.ToVector3())
-		pickup.HealAmount = pickupData.HealAmount
+		pickup := entities.NewHealthPickup(sb.onGround(pickupData.Position).ToVector3())
+		pickup.ID = pickupData.ID
+		pickup.Definition.Amount = pickupData.HealAmount
 		pickup.Radius = pickupData.Radius
 		pickups = append(pickups, pickup)
 	}
@@ -87,6 +200,26 @@ func (sb *SceneBuilder) BuildHealthPickups(data []HealthPickupData) []*entities.
 	return pickups
 }
 
+// BuildHazards creates hazard zone entities from JSON data
+func (sb *SceneBuilder) BuildHazards(data []HazardData) []*entities.HazardZone {
+	hazards := make([]*entities.HazardZone, 0, len(data))
+
+	for _, hazardData := range data {
+		hazard := entities.NewHazardZone(
+			sb.onGround(hazardData.Position).ToVector3(),
+			hazardData.Radius,
+			hazardData.Effect.ToStatusEffect(),
+		)
+		hazard.ID = hazardData.ID
+		if hazardData.ApplyInterval > 0 {
+			hazard.ApplyInterval = hazardData.ApplyInterval
+		}
+		hazards = append(hazards, hazard)
+	}
+
+	return hazards
+}
+
 // ValidateSceneData validates the JSON scene data for correctness
 func (sb *SceneBuilder) ValidateSceneData(data *SceneData) error {
 	// Validate player data
@@ -103,17 +236,49 @@ func (sb *SceneBuilder) ValidateSceneData(data *SceneData) error {
 		return fmt.Errorf("player health (%f) cannot exceed max health (%f)", 
 			data.Player.Health, data.Player.MaxHealth)
 	}
+	if err := data.Player.DefenseData.Validate(); err != nil {
+		return fmt.Errorf("player: %w", err)
+	}
+	for _, stack := range data.Player.StartingItems {
+		definition, exists := sb.items.Get(stack.Item)
+		if !exists {
+			return fmt.Errorf("player: unknown starting item %q", stack.Item)
+		}
+		// An omitted quantity reads as 0 and gives a single item
+		if stack.Quantity < 0 || stack.Quantity > definition.MaxStack {
+			return fmt.Errorf("player: starting item %s quantity must be in [0, %d], got %d",
+				stack.Item, definition.MaxStack, stack.Quantity)
+		}
+	}
+	if len(data.Player.Weapons) > 9 {
+		return fmt.Errorf("player: at most 9 weapons fit the number keys, got %d", len(data.Player.Weapons))
+	}
+	for _, id := range data.Player.Weapons {
+		if _, exists := sb.weapons[id]; !exists {
+			return fmt.Errorf("player: unknown weapon %q", id)
+		}
+	}
 
 	// Validate enemies
 	for i, enemy := range data.Entities.Enemies {
 		if enemy.ID == "" {
 			return fmt.Errorf("enemy %d: ID cannot be empty", i)
 		}
-		if enemy.Health <= 0 {
-			return fmt.Errorf("enemy %s: health must be positive, got %f", enemy.ID, enemy.Health)
+		if err := sb.validateEnemy(enemy); err != nil {
+			return err
+		}
+	}
+
+	// Validate bosses
+	for i, boss := range data.Entities.Bosses {
+		if boss.ID == "" {
+			return fmt.Errorf("boss %d: ID cannot be empty", i)
 		}
-		if enemy.Speed <= 0 {
-			return fmt.Errorf("enemy %s: speed must be positive, got %f", enemy.ID, enemy.Speed)
+		if err := sb.validateEnemy(boss.EnemyData); err != nil {
+			return err
+		}
+		if err := boss.Validate(sb.archetypes); err != nil {
+			return fmt.Errorf("boss %s: %w", boss.ID, err)
 		}
 	}
 
@@ -126,6 +291,17 @@ func (sb *SceneBuilder) ValidateSceneData(data *SceneData) error {
 			return fmt.Errorf("obstacle %s: type must be 'box' or 'cylinder', got %s", 
 				obstacle.ID, obstacle.Type)
 		}
+		if obstacle.Health < 0 {
+			return fmt.Errorf("obstacle %s: health cannot be negative, got %f", obstacle.ID, obstacle.Health)
+		}
+		if obstacle.LootTable != "" {
+			if obstacle.Health == 0 {
+				return fmt.Errorf("obstacle %s: only destructible obstacles can drop loot", obstacle.ID)
+			}
+			if _, exists := sb.loot.Get(obstacle.LootTable); !exists {
+				return fmt.Errorf("obstacle %s: unknown loot table %q", obstacle.ID, obstacle.LootTable)
+			}
+		}
 		if obstacle.Type == "box" {
 			if obstacle.Size.X <= 0 || obstacle.Size.Y <= 0 || obstacle.Size.Z <= 0 {
 				return fmt.Errorf("obstacle %s: box size must be positive, got (%f, %f, %f)", 
@@ -159,12 +335,117 @@ func (sb *SceneBuilder) ValidateSceneData(data *SceneData) error {
 		}
 	}
 
+	// Validate pickups
+	registry, err := data.PickupTypes.ToRegistry()
+	if err != nil {
+		return err
+	}
+	for i, pickup := range data.Entities.Pickups {
+		if pickup.ID == "" {
+			return fmt.Errorf("pickup %d: ID cannot be empty", i)
+		}
+		if _, exists := registry.Get(pickup.Type); !exists {
+			return fmt.Errorf("pickup %s: unknown pickup type %q", pickup.ID, pickup.Type)
+		}
+	}
+
+	// Validate hazards
+	for i, hazard := range data.Entities.Hazards {
+		if hazard.ID == "" {
+			return fmt.Errorf("hazard %d: ID cannot be empty", i)
+		}
+		if hazard.Radius <= 0 {
+			return fmt.Errorf("hazard %s: radius must be positive, got %f", hazard.ID, hazard.Radius)
+		}
+		if hazard.ApplyInterval < 0 {
+			return fmt.Errorf("hazard %s: apply interval cannot be negative, got %f",
+				hazard.ID, hazard.ApplyInterval)
+		}
+		if err := hazard.Effect.Validate(); err != nil {
+			return fmt.Errorf("hazard %s: %w", hazard.ID, err)
+		}
+	}
+
+	// Validate spawners
+	for i, spawner := range data.Entities.Spawners {
+		if spawner.ID == "" {
+			return fmt.Errorf("spawner %d: ID cannot be empty", i)
+		}
+		if err := spawner.Validate(sb.archetypes); err != nil {
+			return fmt.Errorf("spawner %s: %w", spawner.ID, err)
+		}
+		if spawner.Activation.Type == string(entities.ActivationWave) && data.Waves == nil {
+			return fmt.Errorf("spawner %s: wave activation requires the scene to have waves", spawner.ID)
+		}
+	}
+
+	// Validate waves
+	if data.Waves != nil {
+		if err := data.Waves.Validate(sb.archetypes); err != nil {
+			return fmt.Errorf("waves: %w", err)
+		}
+	}
+
+	// Validate terrain
+	if data.Terrain != nil {
+		if err := data.Terrain.Validate(); err != nil {
+			return fmt.Errorf("terrain: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// validateEnemy checks an enemy's resolved archetype and overrides
+func (sb *SceneBuilder) validateEnemy(enemy EnemyData) error {
+	resolved, err := sb.archetypes.Resolve(enemy)
+	if err != nil {
+		return err
+	}
+	if resolved.Health <= 0 {
+		return fmt.Errorf("enemy %s: health must be positive, got %f", enemy.ID, resolved.Health)
+	}
+	if resolved.Speed <= 0 && resolved.Behavior != entities.BehaviorStationary {
+		return fmt.Errorf("enemy %s: speed must be positive, got %f", enemy.ID, resolved.Speed)
+	}
+	if !entities.IsKnownBehavior(resolved.Behavior) {
+		return fmt.Errorf("enemy %s: unknown behavior %q", enemy.ID, resolved.Behavior)
+	}
+	if enemy.AggroRadius < 0 || enemy.LeashDistance < 0 {
+		return fmt.Errorf("enemy %s: aggro radius and leash distance cannot be negative", enemy.ID)
+	}
+	if enemy.Level < 0 {
+		return fmt.Errorf("enemy %s: level cannot be negative, got %d", enemy.ID, enemy.Level)
+	}
+	if enemy.AttackRange < 0 {
+		return fmt.Errorf("enemy %s: attack range cannot be negative, got %f", enemy.ID, enemy.AttackRange)
+	}
+	if resolved.Behavior == entities.BehaviorRanged && resolved.ProjectileSpeed <= 0 {
+		return fmt.Errorf("enemy %s: ranged behavior requires a projectile speed", enemy.ID)
+	}
+	if resolved.LootTable != "" {
+		if _, exists := sb.loot.Get(resolved.LootTable); !exists {
+			return fmt.Errorf("enemy %s: unknown loot table %q", enemy.ID, resolved.LootTable)
+		}
+	}
+	if !entities.IsKnownDamageType(entities.DamageType(resolved.DamageType)) {
+		return fmt.Errorf("enemy %s: unknown damage type %q", enemy.ID, resolved.DamageType)
+	}
+	if err := resolved.DefenseData.Validate(); err != nil {
+		return fmt.Errorf("enemy %s: %w", enemy.ID, err)
+	}
+	for _, effect := range resolved.OnHit {
+		if err := effect.Validate(); err != nil {
+			return fmt.Errorf("enemy %s: %w", enemy.ID, err)
+		}
+	}
 	return nil
 }
 
 // CheckIDUniqueness ensures all entity IDs are unique within the scene
 func (sb *SceneBuilder) CheckIDUniqueness(data *SceneData) error {
 	ids := make(map[string]bool)
+	ids[entities.PlayerID] = true // Reserved for the player entity
 	
 	// Check enemy IDs
 	for _, enemy := range data.Entities.Enemies {
@@ -174,6 +455,14 @@ func (sb *SceneBuilder) CheckIDUniqueness(data *SceneData) error {
 		ids[enemy.ID] = true
 	}
 	
+	// Check boss IDs
+	for _, boss := range data.Entities.Bosses {
+		if ids[boss.ID] {
+			return fmt.Errorf("duplicate ID found: %s", boss.ID)
+		}
+		ids[boss.ID] = true
+	}
+
 	// Check obstacle IDs
 	for _, obstacle := range data.Entities.Obstacles {
 		if ids[obstacle.ID] {
@@ -189,6 +478,30 @@ func (sb *SceneBuilder) CheckIDUniqueness(data *SceneData) error {
 		}
 		ids[pickup.ID] = true
 	}
+
+	// Check pickup IDs
+	for _, pickup := range data.Entities.Pickups {
+		if ids[pickup.ID] {
+			return fmt.Errorf("duplicate ID found: %s", pickup.ID)
+		}
+		ids[pickup.ID] = true
+	}
+
+	// Check hazard IDs
+	for _, hazard := range data.Entities.Hazards {
+		if ids[hazard.ID] {
+			return fmt.Errorf("duplicate ID found: %s", hazard.ID)
+		}
+		ids[hazard.ID] = true
+	}
+
+	// Check spawner IDs
+	for _, spawner := range data.Entities.Spawners {
+		if ids[spawner.ID] {
+			return fmt.Errorf("duplicate ID found: %s", spawner.ID)
+		}
+		ids[spawner.ID] = true
+	}
 	
 	return nil
 }
\ No newline at end of file
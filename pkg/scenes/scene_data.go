@@ -2,9 +2,12 @@ package scenes
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
 
 	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/entities"
 )
 
 // Vector3Data represents a 3D vector in JSON
@@ -30,28 +33,90 @@ type PlayerData struct {
 	Speed      float32     `json:"speed"`
 	Health     float32     `json:"health"`
 	MaxHealth  float32     `json:"max_health"`
+	DefenseData
+	StartingItems []ItemStackData `json:"starting_items,omitempty"` // Placed in the inventory
+	Weapons       []string        `json:"weapons,omitempty"`        // Weapon IDs bound to number keys in order
+}
+
+// DefenseData holds armor and per-type resistances in JSON, e.g.
+// "resistances": {"fire": 0.5, "cold": -0.25}
+type DefenseData struct {
+	Armor       float32            `json:"armor,omitempty"`
+	Resistances map[string]float32 `json:"resistances,omitempty"`
 }
 
-// EnemyData represents enemy configuration in JSON
+// ToComponent converts defence data to an entity defence component
+func (d DefenseData) ToComponent() entities.DefenseComponent {
+	defense := entities.DefenseComponent{Armor: d.Armor}
+	if len(d.Resistances) > 0 {
+		defense.Resistances = make(map[entities.DamageType]float32, len(d.Resistances))
+		for damageType, resistance := range d.Resistances {
+			defense.Resistances[entities.DamageType(damageType)] = resistance
+		}
+	}
+	return defense
+}
+
+// Validate checks armor is non-negative and every resistance names a known
+// damage type within the allowed range
+func (d DefenseData) Validate() error {
+	if d.Armor < 0 {
+		return fmt.Errorf("armor cannot be negative, got %f", d.Armor)
+	}
+	for damageType, resistance := range d.Resistances {
+		if !entities.IsKnownDamageType(entities.DamageType(damageType)) {
+			return fmt.Errorf("unknown resistance type %q", damageType)
+		}
+		if resistance < entities.MinResistance || resistance > entities.MaxResistance {
+			return fmt.Errorf("%s resistance must be in [%.2f, %.2f], got %f",
+				damageType, entities.MinResistance, entities.MaxResistance, resistance)
+		}
+	}
+	return nil
+}
+
+// EnemyData represents enemy configuration in JSON. Stats left at zero are
+// taken from the referenced archetype.
 type EnemyData struct {
-	ID       string      `json:"id"`
-	Position Vector3Data `json:"position"`
-	Health   float32     `json:"health"`
-	Speed    float32     `json:"speed"`
+	ID             string      `json:"id"`
+	Archetype      string      `json:"archetype,omitempty"`
+	Position       Vector3Data `json:"position"`
+	Health         float32     `json:"health,omitempty"`
+	Speed          float32     `json:"speed,omitempty"`
+	Radius         float32     `json:"radius,omitempty"`
+	Height         float32     `json:"height,omitempty"`
+	Color          string      `json:"color,omitempty"`
+	Damage         float32     `json:"damage,omitempty"`
+	AttackCooldown float32     `json:"attack_cooldown,omitempty"`
+	Behavior       string      `json:"behavior,omitempty"`
+	XPValue        float32     `json:"xp_value,omitempty"`
+	Level          int         `json:"level,omitempty"` // Scales kill XP, defaults to 1
+	LootTable      string      `json:"loot_table,omitempty"`
+
+	AggroRadius   float32            `json:"aggro_radius,omitempty"`
+	LeashDistance float32            `json:"leash_distance,omitempty"`
+	AttackRange   float32            `json:"attack_range,omitempty"`
+	DamageType    string             `json:"damage_type,omitempty"`
+	OnHit         []StatusEffectData `json:"on_hit,omitempty"` // Replaces the archetype's effects
+	DefenseData                      // Armor and resistances merged over the archetype's
+	Patrol        []Vector3Data      `json:"patrol,omitempty"` // Waypoints visited in a loop
 }
 
 // ObstacleData represents obstacle configuration in JSON
 type ObstacleData struct {
-	ID       string      `json:"id"`
-	Type     string      `json:"type"` // "box" or "cylinder"
-	Position Vector3Data `json:"position"`
-	Size     Vector3Data `json:"size,omitempty"`     // For box obstacles
-	Radius   float32     `json:"radius,omitempty"`   // For cylinder obstacles
-	Height   float32     `json:"height,omitempty"`   // For cylinder obstacles
-	Color    string      `json:"color"`              // "brown", "green", etc.
+	ID        string      `json:"id"`
+	Type      string      `json:"type"` // "box" or "cylinder"
+	Position  Vector3Data `json:"position"`
+	Size      Vector3Data `json:"size,omitempty"`       // For box obstacles
+	Radius    float32     `json:"radius,omitempty"`     // For cylinder obstacles
+	Height    float32     `json:"height,omitempty"`     // For cylinder obstacles
+	Color     string      `json:"color"`                // "brown", "green", etc.
+	Health    float32     `json:"health,omitempty"`     // Makes the obstacle destructible; 0 is indestructible
+	LootTable string      `json:"loot_table,omitempty"` // Rolled when a destructible obstacle is destroyed
 }
 
-// HealthPickupData represents health pickup configuration in JSON
+// HealthPickupData represents health pickup configuration in JSON. Scenes
+// can also declare pickup types and place them as pickups.
 type HealthPickupData struct {
 	ID         string      `json:"id"`
 	Position   Vector3Data `json:"position"`
@@ -59,6 +124,44 @@ type HealthPickupData struct {
 	Radius     float32     `json:"radius"`
 }
 
+// StatusEffectData describes a status effect in JSON
+type StatusEffectData struct {
+	Type      string  `json:"type"` // "burn", "poison", "slow" or "stun"
+	Duration  float32 `json:"duration"`
+	Magnitude float32 `json:"magnitude,omitempty"` // Damage per tick, or fraction of speed removed
+}
+
+// ToStatusEffect converts status effect data to an entity status effect
+func (d StatusEffectData) ToStatusEffect() entities.StatusEffect {
+	return entities.NewStatusEffect(entities.StatusEffectType(d.Type), d.Duration, d.Magnitude, "")
+}
+
+// Validate checks the effect type is known and its values are in range
+func (d StatusEffectData) Validate() error {
+	if !entities.IsKnownStatusEffect(entities.StatusEffectType(d.Type)) {
+		return fmt.Errorf("unknown status effect %q", d.Type)
+	}
+	if d.Duration <= 0 {
+		return fmt.Errorf("%s duration must be positive, got %f", d.Type, d.Duration)
+	}
+	if d.Magnitude < 0 {
+		return fmt.Errorf("%s magnitude cannot be negative, got %f", d.Type, d.Magnitude)
+	}
+	if d.Type == string(entities.StatusSlow) && d.Magnitude > 1 {
+		return fmt.Errorf("slow magnitude must be at most 1, got %f", d.Magnitude)
+	}
+	return nil
+}
+
+// HazardData represents a status effect hazard zone in JSON
+type HazardData struct {
+	ID            string           `json:"id"`
+	Position      Vector3Data      `json:"position"`
+	Radius        float32          `json:"radius"`
+	Effect        StatusEffectData `json:"effect"`
+	ApplyInterval float32          `json:"apply_interval,omitempty"` // Defaults to one second
+}
+
 // SceneData represents the complete scene configuration
 type SceneData struct {
 	Metadata struct {
@@ -69,10 +172,20 @@ type SceneData struct {
 
 	Player PlayerData `json:"player"`
 
+	PickupTypes PickupTypesData `json:"pickup_types,omitempty"`
+
+	Waves *WavesData `json:"waves,omitempty"` // Enemy waves sent by the wave director
+
+	Terrain *TerrainData `json:"terrain,omitempty"` // Heightmap ground, flat when absent
+
 	Entities struct {
 		Enemies       []EnemyData        `json:"enemies"`
+		Bosses        []BossData         `json:"bosses,omitempty"`
 		Obstacles     []ObstacleData     `json:"obstacles"`
 		HealthPickups []HealthPickupData `json:"health_pickups"`
+		Pickups       []PickupData       `json:"pickups,omitempty"`
+		Hazards       []HazardData       `json:"hazards,omitempty"`
+		Spawners      []SpawnerData      `json:"spawners,omitempty"`
 	} `json:"entities"`
 }
 
@@ -123,6 +236,16 @@ func ParseColor(colorStr string) rl.Color {
 		return rl.Gray
 	case "black":
 		return rl.Black
+	case "orange":
+		return rl.Orange
+	case "purple":
+		return rl.Purple
+	case "maroon":
+		return rl.Maroon
+	case "gold":
+		return rl.Gold
+	case "skyblue":
+		return rl.SkyBlue
 	default:
 		return rl.Gray // Default color
 	}
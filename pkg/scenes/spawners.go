@@ -0,0 +1,162 @@
+package scenes
+
+import (
+	"fmt"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/entities"
+	"arpg/pkg/events"
+)
+
+// SpawnerActivationData describes when a spawner starts in JSON
+type SpawnerActivationData struct {
+	Type   string  `json:"type,omitempty"`   // "always", "proximity", "delay" or "wave"; defaults to always
+	Radius float32 `json:"radius,omitempty"` // Player distance for proximity
+	Delay  float32 `json:"delay,omitempty"`  // Seconds for delay
+	Wave   int     `json:"wave,omitempty"`   // Wave number for wave
+}
+
+// SpawnerData represents an enemy spawner in JSON
+type SpawnerData struct {
+	ID          string                `json:"id"`
+	Position    Vector3Data           `json:"position"`
+	Archetypes  []string              `json:"archetypes"` // Spawned in turn
+	SpawnRate   float32               `json:"spawn_rate"` // Enemies per second
+	MaxAlive    int                   `json:"max_alive,omitempty"`
+	Budget      int                   `json:"budget,omitempty"` // Total enemies, 0 is unlimited
+	SpawnRadius float32               `json:"spawn_radius,omitempty"`
+	Activation  SpawnerActivationData `json:"activation,omitempty"`
+}
+
+// activation returns the spawner's activation type, defaulting to always
+func (d SpawnerData) activation() entities.SpawnerActivation {
+	if d.Activation.Type == "" {
+		return entities.ActivationAlways
+	}
+	return entities.SpawnerActivation(d.Activation.Type)
+}
+
+// Validate checks the spawner's archetypes are known and its values are in range
+func (d SpawnerData) Validate(archetypes *ArchetypeCatalog) error {
+	if len(d.Archetypes) == 0 {
+		return fmt.Errorf("archetypes cannot be empty")
+	}
+	for _, name := range d.Archetypes {
+		if _, exists := archetypes.Get(name); !exists {
+			return fmt.Errorf("unknown archetype %q", name)
+		}
+	}
+	if d.SpawnRate <= 0 {
+		return fmt.Errorf("spawn rate must be positive, got %f", d.SpawnRate)
+	}
+	if d.MaxAlive < 0 || d.Budget < 0 || d.SpawnRadius < 0 {
+		return fmt.Errorf("max alive, budget and spawn radius cannot be negative")
+	}
+
+	switch activation := d.activation(); activation {
+	case entities.ActivationProximity:
+		if d.Activation.Radius <= 0 {
+			return fmt.Errorf("proximity activation radius must be positive, got %f", d.Activation.Radius)
+		}
+	case entities.ActivationDelay:
+		if d.Activation.Delay < 0 {
+			return fmt.Errorf("activation delay cannot be negative, got %f", d.Activation.Delay)
+		}
+	case entities.ActivationWave:
+		if d.Activation.Wave <= 0 {
+			return fmt.Errorf("activation wave must be positive, got %d", d.Activation.Wave)
+		}
+	default:
+		if !entities.IsKnownActivation(activation) {
+			return fmt.Errorf("unknown activation %q", d.Activation.Type)
+		}
+	}
+	return nil
+}
+
+// WaveEntryData describes an archetype in the wave composition in JSON
+type WaveEntryData struct {
+	Archetype    string  `json:"archetype"`
+	Weight       float32 `json:"weight"`
+	WeightGrowth float32 `json:"weight_growth,omitempty"` // Added to the weight each wave after it unlocks
+	FromWave     int     `json:"from_wave,omitempty"`     // First wave it can appear in
+}
+
+// WavesData configures the wave director in JSON
+type WavesData struct {
+	Waves         int             `json:"waves"` // 0 is endless
+	FirstDelay    float32         `json:"first_delay"`
+	Interval      float32         `json:"interval"`
+	BaseCount     int             `json:"base_count"`
+	CountGrowth   int             `json:"count_growth"`
+	SpawnInterval float32         `json:"spawn_interval"`
+	SpawnPoints   []Vector3Data   `json:"spawn_points"`
+	Composition   []WaveEntryData `json:"composition"`
+}
+
+// ToConfig converts wave data to a wave director config
+func (d WavesData) ToConfig() entities.WaveConfig {
+	config := entities.WaveConfig{
+		Waves:         d.Waves,
+		FirstDelay:    d.FirstDelay,
+		Interval:      d.Interval,
+		BaseCount:     d.BaseCount,
+		CountGrowth:   d.CountGrowth,
+		SpawnInterval: d.SpawnInterval,
+	}
+	for _, point := range d.SpawnPoints {
+		config.SpawnPoints = append(config.SpawnPoints, point.ToVector3())
+	}
+	for _, entry := range d.Composition {
+		config.Composition = append(config.Composition, entities.WaveEntry{
+			Archetype:    entry.Archetype,
+			Weight:       entry.Weight,
+			WeightGrowth: entry.WeightGrowth,
+			FromWave:     entry.FromWave,
+		})
+	}
+	return config
+}
+
+// Validate checks the wave config and that every archetype is known
+func (d WavesData) Validate(archetypes *ArchetypeCatalog) error {
+	for _, entry := range d.Composition {
+		if _, exists := archetypes.Get(entry.Archetype); !exists {
+			return fmt.Errorf("unknown archetype %q", entry.Archetype)
+		}
+	}
+	return d.ToConfig().Validate()
+}
+
+// EnemyFactory returns a factory building enemies from the builder's
+// archetypes, for spawners and the wave director
+func (sb *SceneBuilder) EnemyFactory(eventBus events.Subject) entities.EnemyFactory {
+	return func(archetype string, position rl.Vector3) (*entities.Enemy, error) {
+		data := EnemyData{Archetype: archetype, Position: FromVector3(position)}
+		resolved, err := sb.archetypes.Resolve(data)
+		if err != nil {
+			return nil, err
+		}
+		return sb.buildEnemy(data, resolved, eventBus), nil
+	}
+}
+
+// BuildSpawners creates spawner entities producing enemies with factory
+func (sb *SceneBuilder) BuildSpawners(data []SpawnerData, factory entities.EnemyFactory) []*entities.Spawner {
+	spawners := make([]*entities.Spawner, 0, len(data))
+	for _, spawnerData := range data {
+		spawner := entities.NewSpawner(sb.onGround(spawnerData.Position).ToVector3(), spawnerData.Archetypes,
+			spawnerData.SpawnRate, factory)
+		spawner.ID = spawnerData.ID
+		spawner.MaxAlive = spawnerData.MaxAlive
+		spawner.Budget = spawnerData.Budget
+		spawner.SpawnRadius = spawnerData.SpawnRadius
+		spawner.Activation = spawnerData.activation()
+		spawner.ActivationRadius = spawnerData.Activation.Radius
+		spawner.ActivationDelay = spawnerData.Activation.Delay
+		spawner.ActivationWave = spawnerData.Activation.Wave
+		spawners = append(spawners, spawner)
+	}
+	return spawners
+}
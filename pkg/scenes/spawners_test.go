@@ -0,0 +1,94 @@
+package scenes
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/entities"
+)
+
+func TestValidateSceneDataChecksSpawners(t *testing.T) {
+	// given
+	// ... a spawner of an unknown archetype and one waiting for a wave in a
+	// ... scene without waves
+	builder := NewSceneBuilder()
+	builder.SetArchetypes(testArchetypes())
+	unknown := &SceneData{Player: PlayerData{Speed: 5, Health: 100, MaxHealth: 100}}
+	unknown.Entities.Spawners = []SpawnerData{{ID: "spawner_1", Archetypes: []string{"dragon"}, SpawnRate: 1}}
+	noWaves := &SceneData{Player: PlayerData{Speed: 5, Health: 100, MaxHealth: 100}}
+	noWaves.Entities.Spawners = []SpawnerData{{
+		ID:         "spawner_1",
+		Archetypes: []string{"grunt"},
+		SpawnRate:  1,
+		Activation: SpawnerActivationData{Type: "wave", Wave: 2},
+	}}
+	// when
+	// ... both scenes are validated
+	unknownErr := builder.ValidateSceneData(unknown)
+	noWavesErr := builder.ValidateSceneData(noWaves)
+	// then
+	// ... should reject both
+	if unknownErr == nil {
+		t.Fatal("Expected error for a spawner of an unknown archetype")
+	}
+	if noWavesErr == nil {
+		t.Fatal("Expected error for wave activation without waves")
+	}
+}
+
+func TestBuildSpawnersUsesArchetypeFactory(t *testing.T) {
+	// given
+	// ... a proximity spawner of grunts and a factory using the test catalog
+	builder := NewSceneBuilder()
+	builder.SetArchetypes(testArchetypes())
+	data := []SpawnerData{{
+		ID:         "spawner_1",
+		Position:   Vector3Data{X: 4},
+		Archetypes: []string{"grunt"},
+		SpawnRate:  2,
+		MaxAlive:   3,
+		Budget:     5,
+		Activation: SpawnerActivationData{Type: "proximity", Radius: 6},
+	}}
+	factory := builder.EnemyFactory(nil)
+	// when
+	// ... the spawners are built and the factory makes a grunt
+	spawners := builder.BuildSpawners(data, factory)
+	enemy, err := factory("grunt", rl.Vector3{X: 1})
+	// then
+	// ... should carry the spawner's settings and the grunt's stats
+	if len(spawners) != 1 {
+		t.Fatalf("Expected 1 spawner, got %d", len(spawners))
+	}
+	spawner := spawners[0]
+	if spawner.ID != "spawner_1" || spawner.Activation != entities.ActivationProximity ||
+		spawner.ActivationRadius != 6 || spawner.MaxAlive != 3 || spawner.Budget != 5 {
+		t.Fatalf("Expected spawner settings from data, got %+v", spawner)
+	}
+	if err != nil {
+		t.Fatalf("Unexpected factory error: %v", err)
+	}
+	if enemy.Archetype != "grunt" || enemy.MaxHealth != 50 || enemy.Position.X != 1 {
+		t.Fatalf("Expected a 50 health grunt at x 1, got %s with %f at %f",
+			enemy.Archetype, enemy.MaxHealth, enemy.Position.X)
+	}
+}
+
+func TestWavesValidateComposition(t *testing.T) {
+	// given
+	// ... waves sending an unknown archetype
+	waves := WavesData{
+		BaseCount:   3,
+		SpawnPoints: []Vector3Data{{X: 10}},
+		Composition: []WaveEntryData{{Archetype: "dragon", Weight: 1}},
+	}
+	// when
+	// ... they are validated
+	err := waves.Validate(testArchetypes())
+	// then
+	// ... should fail
+	if err == nil {
+		t.Fatal("Expected error for an unknown wave archetype")
+	}
+}
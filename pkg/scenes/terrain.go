@@ -0,0 +1,50 @@
+package scenes
+
+import (
+	"fmt"
+	"math"
+
+	"arpg/pkg/terrain"
+)
+
+// TerrainData describes heightmap terrain in JSON
+type TerrainData struct {
+	Heightmap string      `json:"heightmap"`           // Grayscale image, white is the full height
+	Size      Vector3Data `json:"size"`                // Extent along X and Z, and the full height
+	MaxSlope  float32     `json:"max_slope,omitempty"` // Steepest walkable incline in degrees, 0 allows any
+}
+
+// Validate checks the terrain references an image and its values are in range
+func (d TerrainData) Validate() error {
+	if d.Heightmap == "" {
+		return fmt.Errorf("heightmap cannot be empty")
+	}
+	if d.Size.X <= 0 || d.Size.Z <= 0 {
+		return fmt.Errorf("size must be positive along X and Z, got (%f, %f)", d.Size.X, d.Size.Z)
+	}
+	if d.Size.Y < 0 {
+		return fmt.Errorf("height cannot be negative, got %f", d.Size.Y)
+	}
+	if d.MaxSlope < 0 || d.MaxSlope >= 90 {
+		return fmt.Errorf("max slope must be in [0, 90) degrees, got %f", d.MaxSlope)
+	}
+	return nil
+}
+
+// Load reads the heightmap image and applies the walkable slope
+func (d TerrainData) Load() (*terrain.Heightmap, error) {
+	heightmap, err := terrain.Load(d.Heightmap, d.Size.ToVector3())
+	if err != nil {
+		return nil, err
+	}
+	heightmap.MaxSlope = d.maxSlope()
+	return heightmap, nil
+}
+
+// maxSlope converts the walkable incline to rise over run
+func (d TerrainData) maxSlope() float32 {
+	if d.MaxSlope <= 0 {
+		return 0
+	}
+	return float32(math.Tan(float64(d.MaxSlope) * math.Pi / 180))
+}
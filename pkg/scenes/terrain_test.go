@@ -0,0 +1,101 @@
+package scenes
+
+import (
+	"math"
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"arpg/pkg/terrain"
+)
+
+func TestValidateSceneDataChecksTerrain(t *testing.T) {
+	// given
+	// ... a terrain without an image and one too steep to make sense
+	builder := NewSceneBuilder()
+	noImage := &SceneData{Player: PlayerData{Speed: 5, Health: 100, MaxHealth: 100}}
+	noImage.Terrain = &TerrainData{Size: Vector3Data{X: 50, Y: 4, Z: 50}}
+	vertical := &SceneData{Player: PlayerData{Speed: 5, Health: 100, MaxHealth: 100}}
+	vertical.Terrain = &TerrainData{Heightmap: "terrain.png", Size: Vector3Data{X: 50, Y: 4, Z: 50}, MaxSlope: 90}
+	// when
+	// ... both scenes are validated
+	noImageErr := builder.ValidateSceneData(noImage)
+	verticalErr := builder.ValidateSceneData(vertical)
+	// then
+	// ... should reject both
+	if noImageErr == nil {
+		t.Fatal("Expected error for terrain without a heightmap")
+	}
+	if verticalErr == nil {
+		t.Fatal("Expected error for a 90 degree max slope")
+	}
+}
+
+func TestTerrainMaxSlopeIsInDegrees(t *testing.T) {
+	// given
+	// ... a terrain allowing 45 degree inclines
+	data := TerrainData{Heightmap: "terrain.png", Size: Vector3Data{X: 50, Y: 4, Z: 50}, MaxSlope: 45}
+	// when
+	// ... the slope is converted
+	slope := data.maxSlope()
+	// then
+	// ... should allow a rise of one per unit of run
+	if math.Abs(float64(slope-1)) > 0.0001 {
+		t.Fatalf("Expected max slope 1, got %f", slope)
+	}
+}
+
+func TestBuildersPlaceEntitiesOnTheGround(t *testing.T) {
+	// given
+	// ... a builder on a slope and a player, obstacle and pickup at x 0
+	builder := NewSceneBuilder()
+	slope, err := terrain.New(2, 2, []float32{0, 1, 0, 1}, rl.Vector3{X: 10, Y: 10, Z: 10})
+	if err != nil {
+		t.Fatalf("Unexpected heightmap error: %v", err)
+	}
+	builder.SetGround(slope)
+	player := PlayerData{Speed: 5, Health: 100, MaxHealth: 100}
+	obstacles := []ObstacleData{{ID: "rock", Type: "box", Position: Vector3Data{Y: 0.5}, Size: Vector3Data{X: 1, Y: 1, Z: 1}}}
+	pickups := []HealthPickupData{{ID: "potion", Position: Vector3Data{Y: 0.3}, HealAmount: 10, Radius: 0.5}}
+	// when
+	// ... they are built
+	builtPlayer := builder.BuildPlayer(player, nil)
+	builtObstacles := builder.BuildObstacles(obstacles)
+	builtPickups := builder.BuildHealthPickups(pickups)
+	// then
+	// ... should stand on the ground halfway up the slope
+	// ... keeping their heights above it
+	if builtPlayer.Position.Y != 5 {
+		t.Fatalf("Expected player at height 5, got %f", builtPlayer.Position.Y)
+	}
+	if builtObstacles[0].Position.Y != 5.5 {
+		t.Fatalf("Expected obstacle at height 5.5, got %f", builtObstacles[0].Position.Y)
+	}
+	if builtPickups[0].Position.Y != 5.3 {
+		t.Fatalf("Expected pickup at height 5.3, got %f", builtPickups[0].Position.Y)
+	}
+}
+
+func TestEnemyFactoryDoesNotLiftTwice(t *testing.T) {
+	// given
+	// ... a builder on a slope and a spawn point already on the ground
+	builder := NewSceneBuilder()
+	builder.SetArchetypes(testArchetypes())
+	slope, err := terrain.New(2, 2, []float32{0, 1, 0, 1}, rl.Vector3{X: 10, Y: 10, Z: 10})
+	if err != nil {
+		t.Fatalf("Unexpected heightmap error: %v", err)
+	}
+	builder.SetGround(slope)
+	factory := builder.EnemyFactory(nil)
+	// when
+	// ... the factory makes a grunt there
+	enemy, err := factory("grunt", rl.Vector3{Y: 5})
+	// then
+	// ... should keep the spawn point's height
+	if err != nil {
+		t.Fatalf("Unexpected factory error: %v", err)
+	}
+	if enemy.Position.Y != 5 {
+		t.Fatalf("Expected enemy at height 5, got %f", enemy.Position.Y)
+	}
+}
@@ -0,0 +1,104 @@
+package scenes
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"arpg/pkg/entities"
+)
+
+// WeaponData describes a weapon in JSON
+type WeaponData struct {
+	Name             string                       `json:"name"`
+	FireRate         float32                      `json:"fire_rate"`                  // Shots per second
+	ProjectileCount  int                          `json:"projectile_count,omitempty"` // Defaults to 1
+	SpreadAngle      float32                      `json:"spread_angle,omitempty"`     // Degrees
+	MagazineSize     int                          `json:"magazine_size,omitempty"`    // 0 never reloads
+	ReloadTime       float32                      `json:"reload_time,omitempty"`
+	DamageMultiplier float32                      `json:"damage_multiplier,omitempty"` // Defaults to 1
+	SpeedMultiplier  float32                      `json:"speed_multiplier,omitempty"`  // Defaults to 1
+	Projectile       entities.ProjectileModifiers `json:"projectile"`
+}
+
+// WeaponCatalogData holds every weapon keyed by ID in JSON
+type WeaponCatalogData struct {
+	Weapons map[string]WeaponData `json:"weapons"`
+}
+
+// LoadWeaponsFromJSON loads and validates a weapon definition file
+func LoadWeaponsFromJSON(filename string) (map[string]*entities.WeaponDefinition, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var catalogData WeaponCatalogData
+	if err := json.Unmarshal(data, &catalogData); err != nil {
+		return nil, err
+	}
+
+	return catalogData.ToWeapons()
+}
+
+// ToWeapons validates every weapon and converts it to a weapon definition
+func (d WeaponCatalogData) ToWeapons() (map[string]*entities.WeaponDefinition, error) {
+	weapons := make(map[string]*entities.WeaponDefinition, len(d.Weapons))
+	for _, id := range sortedKeys(d.Weapons) {
+		weaponData := d.Weapons[id]
+		if err := weaponData.Validate(); err != nil {
+			return nil, fmt.Errorf("weapon %s: %w", id, err)
+		}
+		weapons[id] = weaponData.ToDefinition(id)
+	}
+	return weapons, nil
+}
+
+// ToDefinition converts weapon data to a weapon definition, filling defaults
+func (d WeaponData) ToDefinition(id string) *entities.WeaponDefinition {
+	definition := &entities.WeaponDefinition{
+		ID:               id,
+		Name:             d.Name,
+		FireRate:         d.FireRate,
+		ProjectileCount:  max(d.ProjectileCount, 1),
+		SpreadAngle:      d.SpreadAngle,
+		MagazineSize:     d.MagazineSize,
+		ReloadTime:       d.ReloadTime,
+		DamageMultiplier: d.DamageMultiplier,
+		SpeedMultiplier:  d.SpeedMultiplier,
+		Projectile:       d.Projectile,
+	}
+	if definition.DamageMultiplier == 0 {
+		definition.DamageMultiplier = 1
+	}
+	if definition.SpeedMultiplier == 0 {
+		definition.SpeedMultiplier = 1
+	}
+	return definition
+}
+
+// Validate checks fire rate, spread, magazine and multipliers are in range
+func (d WeaponData) Validate() error {
+	if d.Name == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if d.FireRate <= 0 {
+		return fmt.Errorf("fire rate must be positive, got %f", d.FireRate)
+	}
+	if d.ProjectileCount < 0 {
+		return fmt.Errorf("projectile count cannot be negative, got %d", d.ProjectileCount)
+	}
+	if d.SpreadAngle < 0 || d.SpreadAngle > 360 {
+		return fmt.Errorf("spread angle must be in [0, 360], got %f", d.SpreadAngle)
+	}
+	if d.MagazineSize < 0 || d.ReloadTime < 0 {
+		return fmt.Errorf("magazine size and reload time cannot be negative")
+	}
+	if d.DamageMultiplier < 0 || d.SpeedMultiplier < 0 {
+		return fmt.Errorf("multipliers cannot be negative")
+	}
+	if err := entities.ValidateProjectileModifiers(d.Projectile); err != nil {
+		return fmt.Errorf("projectile: %w", err)
+	}
+	return nil
+}
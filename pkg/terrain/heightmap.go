@@ -0,0 +1,175 @@
+package terrain
+
+import (
+	"fmt"
+	"math"
+	"os"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// raycastRefinements is how many times a ray hit is bisected after marching
+// finds the sample interval it falls in
+const raycastRefinements = 8
+
+// Heightmap is terrain described by a grid of height samples spread evenly
+// over a rectangle centred on the origin. Heights between samples are
+// interpolated, and outside the rectangle the edge heights extend outward.
+type Heightmap struct {
+	Size     rl.Vector3 // Extent along X and Z, and the height of a full sample
+	MaxSlope float32    // Steepest walkable rise over run, zero allows any slope
+
+	columns int       // Samples along X
+	rows    int       // Samples along Z
+	samples []float32 // Row-major heights from 0 to 1
+}
+
+// New creates a heightmap from columns x rows samples in row-major order,
+// each from 0 for the ground floor to 1 for the full height of size
+func New(columns, rows int, samples []float32, size rl.Vector3) (*Heightmap, error) {
+	if columns < 2 || rows < 2 {
+		return nil, fmt.Errorf("heightmap needs at least 2x2 samples, got %dx%d", columns, rows)
+	}
+	if len(samples) != columns*rows {
+		return nil, fmt.Errorf("heightmap of %dx%d needs %d samples, got %d", columns, rows, columns*rows, len(samples))
+	}
+	if size.X <= 0 || size.Z <= 0 || size.Y < 0 {
+		return nil, fmt.Errorf("heightmap size must be positive, got %v", size)
+	}
+	return &Heightmap{
+		Size:    size,
+		columns: columns,
+		rows:    rows,
+		samples: samples,
+	}, nil
+}
+
+// Load creates a heightmap from a grayscale image, white being the full
+// height of size. Pixels map onto the terrain the way raylib's heightmap
+// mesh lays them out, so the drawn mesh matches the sampled heights.
+func Load(path string, size rl.Vector3) (*Heightmap, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("failed to load heightmap: %w", err)
+	}
+	image := rl.LoadImage(path)
+	if image == nil || image.Data == nil {
+		return nil, fmt.Errorf("failed to load heightmap %s", path)
+	}
+	defer rl.UnloadImage(image)
+
+	colors := rl.LoadImageColors(image)
+	defer rl.UnloadImageColors(colors)
+	samples := make([]float32, len(colors))
+	for i, color := range colors {
+		samples[i] = (float32(color.R) + float32(color.G) + float32(color.B)) / 3 / 255
+	}
+	return New(int(image.Width), int(image.Height), samples, size)
+}
+
+// Resolution returns the number of samples along X and Z
+func (h *Heightmap) Resolution() (columns, rows int) {
+	return h.columns, h.rows
+}
+
+// Sample returns the raw 0 to 1 height of the sample at column and row
+func (h *Heightmap) Sample(column, row int) float32 {
+	return h.samples[row*h.columns+column]
+}
+
+// HeightAt returns the ground height at x, z
+func (h *Heightmap) HeightAt(x, z float32) float32 {
+	u := h.gridCoordinate(x, h.Size.X, h.columns)
+	v := h.gridCoordinate(z, h.Size.Z, h.rows)
+
+	column := min(int(u), h.columns-2)
+	row := min(int(v), h.rows-2)
+	fu := u - float32(column)
+	fv := v - float32(row)
+
+	top := lerp(h.Sample(column, row), h.Sample(column+1, row), fu)
+	bottom := lerp(h.Sample(column, row+1), h.Sample(column+1, row+1), fu)
+	return lerp(top, bottom, fv) * h.Size.Y
+}
+
+// SlopeAt returns the steepest rise over run of the ground at x, z
+func (h *Heightmap) SlopeAt(x, z float32) float32 {
+	dx := h.Size.X / float32(h.columns-1)
+	dz := h.Size.Z / float32(h.rows-1)
+	gradX := (h.HeightAt(x+dx, z) - h.HeightAt(x-dx, z)) / (2 * dx)
+	gradZ := (h.HeightAt(x, z+dz) - h.HeightAt(x, z-dz)) / (2 * dz)
+	return float32(math.Hypot(float64(gradX), float64(gradZ)))
+}
+
+// CanClimb reports whether a walker can step from one position to another
+// without climbing steeper than MaxSlope. Only X and Z are used; going
+// downhill is always allowed so nothing gets stranded on a steep face.
+func (h *Heightmap) CanClimb(from, to rl.Vector3) bool {
+	if h.MaxSlope <= 0 {
+		return true
+	}
+	run := math.Hypot(float64(to.X-from.X), float64(to.Z-from.Z))
+	if run == 0 {
+		return true
+	}
+	rise := h.HeightAt(to.X, to.Z) - h.HeightAt(from.X, from.Z)
+	return rise <= 0 || float64(rise)/run <= float64(h.MaxSlope)
+}
+
+// Raycast finds where a downward ray first meets the ground. It marches the
+// ray through the height range in steps of half a sample and bisects the
+// step that crosses the surface.
+func (h *Heightmap) Raycast(ray rl.Ray) (rl.Vector3, bool) {
+	direction := rl.Vector3Normalize(ray.Direction)
+	if direction.Y >= 0 {
+		return rl.Vector3{}, false
+	}
+
+	// Heights lie between 0 and Size.Y, so only that slab can hold a hit
+	start := max((ray.Position.Y-h.Size.Y)/-direction.Y, 0)
+	end := ray.Position.Y / -direction.Y
+	if end < start {
+		return rl.Vector3{}, false
+	}
+	step := min(h.Size.X/float32(h.columns-1), h.Size.Z/float32(h.rows-1)) / 2
+
+	above := func(t float32) bool {
+		point := rl.Vector3Add(ray.Position, rl.Vector3Scale(direction, t))
+		return point.Y > h.HeightAt(point.X, point.Z)
+	}
+	if !above(start) {
+		return rl.Vector3Add(ray.Position, rl.Vector3Scale(direction, start)), true
+	}
+
+	// The ray reaches height zero at end, which is never above the ground,
+	// so the march always finds a crossing
+	near := start
+	for {
+		far := min(near+step, end)
+		if far < end && above(far) {
+			near = far
+			continue
+		}
+		for range raycastRefinements {
+			mid := (near + far) / 2
+			if above(mid) {
+				near = mid
+			} else {
+				far = mid
+			}
+		}
+		point := rl.Vector3Add(ray.Position, rl.Vector3Scale(direction, far))
+		point.Y = h.HeightAt(point.X, point.Z)
+		return point, true
+	}
+}
+
+// gridCoordinate maps a world coordinate onto the fractional sample index
+// along an axis of the given extent and sample count, clamped to the grid
+func (h *Heightmap) gridCoordinate(value, extent float32, samples int) float32 {
+	index := (value + extent/2) / extent * float32(samples-1)
+	return min(max(index, 0), float32(samples-1))
+}
+
+func lerp(a, b, t float32) float32 {
+	return a + (b-a)*t
+}
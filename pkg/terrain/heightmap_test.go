@@ -0,0 +1,135 @@
+package terrain
+
+import (
+	"math"
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+func TestNewRejectsMismatchedSamples(t *testing.T) {
+	// given
+	// ... a 3x3 map given only four samples
+	samples := []float32{0, 0, 0, 0}
+	// when
+	// ... the heightmap is created
+	_, err := New(3, 3, samples, rl.Vector3{X: 10, Y: 4, Z: 10})
+	// then
+	// ... should be rejected
+	if err == nil {
+		t.Fatal("Expected error for a sample count that does not match the size")
+	}
+}
+
+func TestHeightAtInterpolatesSamples(t *testing.T) {
+	// given
+	// ... a map with a peak in the middle
+	heightmap, err := New(3, 3, []float32{
+		0, 0, 0,
+		0, 1, 0,
+		0, 0, 0,
+	}, rl.Vector3{X: 10, Y: 4, Z: 10})
+	if err != nil {
+		t.Fatalf("Unexpected heightmap error: %v", err)
+	}
+	// when
+	// ... heights are sampled on the peak, halfway down and past the edge
+	peak := heightmap.HeightAt(0, 0)
+	halfway := heightmap.HeightAt(2.5, 0)
+	outside := heightmap.HeightAt(40, 0)
+	// then
+	// ... should match the peak, interpolate the slope and clamp to the edge
+	if peak != 4 {
+		t.Fatalf("Expected peak height 4, got %f", peak)
+	}
+	if halfway != 2 {
+		t.Fatalf("Expected halfway height 2, got %f", halfway)
+	}
+	if outside != 0 {
+		t.Fatalf("Expected edge height 0 outside the map, got %f", outside)
+	}
+}
+
+func TestCanClimbBlocksSteepSlopes(t *testing.T) {
+	// given
+	// ... a peak rising 4 units over 5, and walkers allowed a rise of 0.5
+	heightmap, err := New(3, 3, []float32{
+		0, 0, 0,
+		0, 1, 0,
+		0, 0, 0,
+	}, rl.Vector3{X: 10, Y: 4, Z: 10})
+	if err != nil {
+		t.Fatalf("Unexpected heightmap error: %v", err)
+	}
+	heightmap.MaxSlope = 0.5
+	foot := rl.Vector3{X: 4}
+	step := rl.Vector3{X: 3.9}
+	// when
+	// ... a walker steps up the slope, back down it and across its foot
+	up := heightmap.CanClimb(foot, step)
+	down := heightmap.CanClimb(step, foot)
+	across := heightmap.CanClimb(rl.Vector3{X: 5, Z: -1}, rl.Vector3{X: 5, Z: 1})
+	// then
+	// ... should block only the climb
+	if up {
+		t.Fatal("Expected the steep climb to be blocked")
+	}
+	if !down {
+		t.Fatal("Expected walking downhill to be allowed")
+	}
+	if !across {
+		t.Fatal("Expected walking across level ground to be allowed")
+	}
+}
+
+func TestRaycastHitsTheGround(t *testing.T) {
+	// given
+	// ... a map with a peak and rays looking straight down from above
+	heightmap, err := New(3, 3, []float32{
+		0, 0, 0,
+		0, 1, 0,
+		0, 0, 0,
+	}, rl.Vector3{X: 10, Y: 4, Z: 10})
+	if err != nil {
+		t.Fatalf("Unexpected heightmap error: %v", err)
+	}
+	down := rl.Vector3{Y: -1}
+	// when
+	// ... one ray is cast onto the peak and one onto the flat edge
+	peak, peakHit := heightmap.Raycast(rl.Ray{Position: rl.Vector3{Y: 20}, Direction: down})
+	edge, edgeHit := heightmap.Raycast(rl.Ray{Position: rl.Vector3{X: 5, Y: 20, Z: 5}, Direction: down})
+	// then
+	// ... should land on the surface under each ray
+	if !peakHit || math.Abs(float64(peak.Y-4)) > 0.01 {
+		t.Fatalf("Expected to hit the peak at height 4, got %v (hit %t)", peak, peakHit)
+	}
+	if !edgeHit || math.Abs(float64(edge.Y)) > 0.01 || edge.X != 5 || edge.Z != 5 {
+		t.Fatalf("Expected to hit the edge at (5, 0, 5), got %v (hit %t)", edge, edgeHit)
+	}
+}
+
+func TestRaycastFindsSlopeBeforeFloor(t *testing.T) {
+	// given
+	// ... a map with a peak and a slanted ray that passes over the slope
+	heightmap, err := New(3, 3, []float32{
+		0, 0, 0,
+		0, 1, 0,
+		0, 0, 0,
+	}, rl.Vector3{X: 10, Y: 4, Z: 10})
+	if err != nil {
+		t.Fatalf("Unexpected heightmap error: %v", err)
+	}
+	ray := rl.Ray{Position: rl.Vector3{X: -10, Y: 6}, Direction: rl.Vector3{X: 1, Y: -0.5}}
+	// when
+	// ... the ray is cast
+	point, hit := heightmap.Raycast(ray)
+	// then
+	// ... should stop on the near face of the peak, where a flat ground
+	// ... plane at zero would have been 2 units further on
+	if !hit {
+		t.Fatal("Expected the ray to hit the ground")
+	}
+	if point.X >= 2 || math.Abs(float64(point.Y-heightmap.HeightAt(point.X, point.Z))) > 0.01 {
+		t.Fatalf("Expected a hit on the near face of the peak, got %v", point)
+	}
+}